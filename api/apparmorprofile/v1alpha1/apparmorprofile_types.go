@@ -33,12 +33,104 @@ var (
 
 // AppArmorProfileSpec defines the desired state of AppArmorProfile.
 type AppArmorProfileSpec struct {
+	// Policy is the raw AppArmor policy text. The profile name declared
+	// in the policy must match the name of the AppArmorProfile. If set,
+	// the abstract rules are ignored.
+	// +optional
 	Policy string `json:"policy,omitempty"`
+	// Abstract describes the rules of the profile, which are translated
+	// into the AppArmor policy syntax if no raw policy is provided.
+	// +optional
+	Abstract *AppArmorAbstract `json:"abstract,omitempty"`
+	// ComplainMode, when true will cause the translated profile to only
+	// log violations instead of enforcing them.
+	// +optional
+	ComplainMode bool `json:"complainMode,omitempty"`
+	// NodeSelector restricts the nodes on which the profile gets
+	// installed to the ones matching the labels. The profile is installed
+	// on all nodes if the selector is empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// AppArmorAbstract defines the rules of an AppArmor profile.
+type AppArmorAbstract struct {
+	// Executable rules, which define what can be executed or mapped.
+	// +optional
+	Executable *AppArmorExecutablesRules `json:"executable,omitempty"`
+	// Filesystem rules, which define the accessible paths.
+	// +optional
+	Filesystem *AppArmorFsRules `json:"filesystem,omitempty"`
+	// Network rules, which define the allowed network families.
+	// +optional
+	Network *AppArmorNetworkRules `json:"network,omitempty"`
+	// Capability rules, which define the allowed Linux capabilities.
+	// +optional
+	Capability *AppArmorCapabilityRules `json:"capability,omitempty"`
+}
+
+// AppArmorExecutablesRules defines the executables and libraries
+// allowed by the profile.
+type AppArmorExecutablesRules struct {
+	// AllowedExecutables are the paths which can be executed and
+	// inherit the profile.
+	// +optional
+	AllowedExecutables []string `json:"allowedExecutables,omitempty"`
+	// AllowedLibraries are the paths which can be memory mapped and read.
+	// +optional
+	AllowedLibraries []string `json:"allowedLibraries,omitempty"`
+}
+
+// AppArmorFsRules defines the filesystem paths accessible by the profile.
+type AppArmorFsRules struct {
+	// ReadOnlyPaths are the paths which can only be read.
+	// +optional
+	ReadOnlyPaths []string `json:"readOnlyPaths,omitempty"`
+	// WriteOnlyPaths are the paths which can only be written.
+	// +optional
+	WriteOnlyPaths []string `json:"writeOnlyPaths,omitempty"`
+	// ReadWritePaths are the paths which can be read and written.
+	// +optional
+	ReadWritePaths []string `json:"readWritePaths,omitempty"`
+}
+
+// AppArmorNetworkRules defines the network access allowed by the profile.
+type AppArmorNetworkRules struct {
+	// AllowRaw allows raw network access.
+	// +optional
+	AllowRaw *bool `json:"allowRaw,omitempty"`
+	// Protocols are the allowed network protocols for the inet and inet6
+	// families.
+	// +optional
+	Protocols *AppArmorAllowedProtocols `json:"protocols,omitempty"`
+	// Families are additional network address families which are allowed
+	// entirely, for example "unix" or "netlink".
+	// +optional
+	Families []string `json:"families,omitempty"`
+}
+
+// AppArmorAllowedProtocols defines the allowed network protocols.
+type AppArmorAllowedProtocols struct {
+	// AllowTCP allows the TCP protocol.
+	// +optional
+	AllowTCP *bool `json:"allowTcp,omitempty"`
+	// AllowUDP allows the UDP protocol.
+	// +optional
+	AllowUDP *bool `json:"allowUdp,omitempty"`
+}
+
+// AppArmorCapabilityRules defines the capabilities allowed by the profile.
+type AppArmorCapabilityRules struct {
+	// AllowedCapabilities are the Linux capabilities, without the CAP_
+	// prefix and in lower case, for example "net_bind_service".
+	// +optional
+	AllowedCapabilities []string `json:"allowedCapabilities,omitempty"`
 }
 
 // AppArmorProfileStatus defines the observed state of AppArmorProfile.
 type AppArmorProfileStatus struct {
 	profilebasev1alpha1.StatusBase `json:",inline"`
+	ActiveWorkloads                []string `json:"activeWorkloads,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -78,6 +170,10 @@ func (sp *AppArmorProfile) IsPartial() bool {
 	return profilebasev1alpha1.IsPartial(sp)
 }
 
+func (sp *AppArmorProfile) GetNodeSelector() map[string]string {
+	return sp.Spec.NodeSelector
+}
+
 // +kubebuilder:object:root=true
 
 // AppArmorProfileList contains a list of AppArmorProfile.
@@ -25,12 +25,177 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorAbstract) DeepCopyInto(out *AppArmorAbstract) {
+	*out = *in
+	if in.Executable != nil {
+		in, out := &in.Executable, &out.Executable
+		*out = new(AppArmorExecutablesRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filesystem != nil {
+		in, out := &in.Filesystem, &out.Filesystem
+		*out = new(AppArmorFsRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(AppArmorNetworkRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capability != nil {
+		in, out := &in.Capability, &out.Capability
+		*out = new(AppArmorCapabilityRules)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorAbstract.
+func (in *AppArmorAbstract) DeepCopy() *AppArmorAbstract {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorAbstract)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorAllowedProtocols) DeepCopyInto(out *AppArmorAllowedProtocols) {
+	*out = *in
+	if in.AllowTCP != nil {
+		in, out := &in.AllowTCP, &out.AllowTCP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowUDP != nil {
+		in, out := &in.AllowUDP, &out.AllowUDP
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorAllowedProtocols.
+func (in *AppArmorAllowedProtocols) DeepCopy() *AppArmorAllowedProtocols {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorAllowedProtocols)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorCapabilityRules) DeepCopyInto(out *AppArmorCapabilityRules) {
+	*out = *in
+	if in.AllowedCapabilities != nil {
+		in, out := &in.AllowedCapabilities, &out.AllowedCapabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorCapabilityRules.
+func (in *AppArmorCapabilityRules) DeepCopy() *AppArmorCapabilityRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorCapabilityRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorExecutablesRules) DeepCopyInto(out *AppArmorExecutablesRules) {
+	*out = *in
+	if in.AllowedExecutables != nil {
+		in, out := &in.AllowedExecutables, &out.AllowedExecutables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedLibraries != nil {
+		in, out := &in.AllowedLibraries, &out.AllowedLibraries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorExecutablesRules.
+func (in *AppArmorExecutablesRules) DeepCopy() *AppArmorExecutablesRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorExecutablesRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorFsRules) DeepCopyInto(out *AppArmorFsRules) {
+	*out = *in
+	if in.ReadOnlyPaths != nil {
+		in, out := &in.ReadOnlyPaths, &out.ReadOnlyPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WriteOnlyPaths != nil {
+		in, out := &in.WriteOnlyPaths, &out.WriteOnlyPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadWritePaths != nil {
+		in, out := &in.ReadWritePaths, &out.ReadWritePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorFsRules.
+func (in *AppArmorFsRules) DeepCopy() *AppArmorFsRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorFsRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorNetworkRules) DeepCopyInto(out *AppArmorNetworkRules) {
+	*out = *in
+	if in.AllowRaw != nil {
+		in, out := &in.AllowRaw, &out.AllowRaw
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = new(AppArmorAllowedProtocols)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Families != nil {
+		in, out := &in.Families, &out.Families
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorNetworkRules.
+func (in *AppArmorNetworkRules) DeepCopy() *AppArmorNetworkRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorNetworkRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorProfile) DeepCopyInto(out *AppArmorProfile) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -87,6 +252,18 @@ func (in *AppArmorProfileList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorProfileSpec) DeepCopyInto(out *AppArmorProfileSpec) {
 	*out = *in
+	if in.Abstract != nil {
+		in, out := &in.Abstract, &out.Abstract
+		*out = new(AppArmorAbstract)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorProfileSpec.
@@ -103,6 +280,11 @@ func (in *AppArmorProfileSpec) DeepCopy() *AppArmorProfileSpec {
 func (in *AppArmorProfileStatus) DeepCopyInto(out *AppArmorProfileStatus) {
 	*out = *in
 	in.StatusBase.DeepCopyInto(&out.StatusBase)
+	if in.ActiveWorkloads != nil {
+		in, out := &in.ActiveWorkloads, &out.ActiveWorkloads
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorProfileStatus.
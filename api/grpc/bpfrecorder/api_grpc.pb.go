@@ -34,9 +34,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BpfRecorder_Start_FullMethodName              = "/api_bpfrecorder.BpfRecorder/Start"
-	BpfRecorder_Stop_FullMethodName               = "/api_bpfrecorder.BpfRecorder/Stop"
-	BpfRecorder_SyscallsForProfile_FullMethodName = "/api_bpfrecorder.BpfRecorder/SyscallsForProfile"
+	BpfRecorder_Start_FullMethodName                  = "/api_bpfrecorder.BpfRecorder/Start"
+	BpfRecorder_Stop_FullMethodName                   = "/api_bpfrecorder.BpfRecorder/Stop"
+	BpfRecorder_StartSession_FullMethodName           = "/api_bpfrecorder.BpfRecorder/StartSession"
+	BpfRecorder_StopSession_FullMethodName            = "/api_bpfrecorder.BpfRecorder/StopSession"
+	BpfRecorder_SyscallsForProfile_FullMethodName     = "/api_bpfrecorder.BpfRecorder/SyscallsForProfile"
+	BpfRecorder_CapabilitiesForProfile_FullMethodName = "/api_bpfrecorder.BpfRecorder/CapabilitiesForProfile"
+	BpfRecorder_WatchSyscalls_FullMethodName          = "/api_bpfrecorder.BpfRecorder/WatchSyscalls"
 )
 
 // BpfRecorderClient is the client API for BpfRecorder service.
@@ -45,7 +49,11 @@ const (
 type BpfRecorderClient interface {
 	Start(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	Stop(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	StartSession(ctx context.Context, in *SessionRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	StopSession(ctx context.Context, in *SessionRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	SyscallsForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SyscallsResponse, error)
+	CapabilitiesForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
+	WatchSyscalls(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (BpfRecorder_WatchSyscallsClient, error)
 }
 
 type bpfRecorderClient struct {
@@ -74,6 +82,24 @@ func (c *bpfRecorderClient) Stop(ctx context.Context, in *EmptyRequest, opts ...
 	return out, nil
 }
 
+func (c *bpfRecorderClient) StartSession(ctx context.Context, in *SessionRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, BpfRecorder_StartSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bpfRecorderClient) StopSession(ctx context.Context, in *SessionRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, BpfRecorder_StopSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bpfRecorderClient) SyscallsForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SyscallsResponse, error) {
 	out := new(SyscallsResponse)
 	err := c.cc.Invoke(ctx, BpfRecorder_SyscallsForProfile_FullMethodName, in, out, opts...)
@@ -83,13 +109,58 @@ func (c *bpfRecorderClient) SyscallsForProfile(ctx context.Context, in *ProfileR
 	return out, nil
 }
 
+func (c *bpfRecorderClient) CapabilitiesForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, BpfRecorder_CapabilitiesForProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bpfRecorderClient) WatchSyscalls(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (BpfRecorder_WatchSyscallsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BpfRecorder_ServiceDesc.Streams[0], BpfRecorder_WatchSyscalls_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bpfRecorderWatchSyscallsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BpfRecorder_WatchSyscallsClient interface {
+	Recv() (*SyscallEvent, error)
+	grpc.ClientStream
+}
+
+type bpfRecorderWatchSyscallsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bpfRecorderWatchSyscallsClient) Recv() (*SyscallEvent, error) {
+	m := new(SyscallEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BpfRecorderServer is the server API for BpfRecorder service.
 // All implementations must embed UnimplementedBpfRecorderServer
 // for forward compatibility
 type BpfRecorderServer interface {
 	Start(context.Context, *EmptyRequest) (*EmptyResponse, error)
 	Stop(context.Context, *EmptyRequest) (*EmptyResponse, error)
+	StartSession(context.Context, *SessionRequest) (*EmptyResponse, error)
+	StopSession(context.Context, *SessionRequest) (*EmptyResponse, error)
 	SyscallsForProfile(context.Context, *ProfileRequest) (*SyscallsResponse, error)
+	CapabilitiesForProfile(context.Context, *ProfileRequest) (*CapabilitiesResponse, error)
+	WatchSyscalls(*WatchRequest, BpfRecorder_WatchSyscallsServer) error
 	mustEmbedUnimplementedBpfRecorderServer()
 }
 
@@ -103,9 +174,21 @@ func (UnimplementedBpfRecorderServer) Start(context.Context, *EmptyRequest) (*Em
 func (UnimplementedBpfRecorderServer) Stop(context.Context, *EmptyRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
+func (UnimplementedBpfRecorderServer) StartSession(context.Context, *SessionRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSession not implemented")
+}
+func (UnimplementedBpfRecorderServer) StopSession(context.Context, *SessionRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopSession not implemented")
+}
 func (UnimplementedBpfRecorderServer) SyscallsForProfile(context.Context, *ProfileRequest) (*SyscallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyscallsForProfile not implemented")
 }
+func (UnimplementedBpfRecorderServer) CapabilitiesForProfile(context.Context, *ProfileRequest) (*CapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapabilitiesForProfile not implemented")
+}
+func (UnimplementedBpfRecorderServer) WatchSyscalls(*WatchRequest, BpfRecorder_WatchSyscallsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSyscalls not implemented")
+}
 func (UnimplementedBpfRecorderServer) mustEmbedUnimplementedBpfRecorderServer() {}
 
 // UnsafeBpfRecorderServer may be embedded to opt out of forward compatibility for this service.
@@ -155,6 +238,42 @@ func _BpfRecorder_Stop_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BpfRecorder_StartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BpfRecorderServer).StartSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BpfRecorder_StartSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BpfRecorderServer).StartSession(ctx, req.(*SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BpfRecorder_StopSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BpfRecorderServer).StopSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BpfRecorder_StopSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BpfRecorderServer).StopSession(ctx, req.(*SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BpfRecorder_SyscallsForProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProfileRequest)
 	if err := dec(in); err != nil {
@@ -173,6 +292,45 @@ func _BpfRecorder_SyscallsForProfile_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BpfRecorder_CapabilitiesForProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BpfRecorderServer).CapabilitiesForProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BpfRecorder_CapabilitiesForProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BpfRecorderServer).CapabilitiesForProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BpfRecorder_WatchSyscalls_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BpfRecorderServer).WatchSyscalls(m, &bpfRecorderWatchSyscallsServer{stream})
+}
+
+type BpfRecorder_WatchSyscallsServer interface {
+	Send(*SyscallEvent) error
+	grpc.ServerStream
+}
+
+type bpfRecorderWatchSyscallsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bpfRecorderWatchSyscallsServer) Send(m *SyscallEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // BpfRecorder_ServiceDesc is the grpc.ServiceDesc for BpfRecorder service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -188,11 +346,29 @@ var BpfRecorder_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Stop",
 			Handler:    _BpfRecorder_Stop_Handler,
 		},
+		{
+			MethodName: "StartSession",
+			Handler:    _BpfRecorder_StartSession_Handler,
+		},
+		{
+			MethodName: "StopSession",
+			Handler:    _BpfRecorder_StopSession_Handler,
+		},
 		{
 			MethodName: "SyscallsForProfile",
 			Handler:    _BpfRecorder_SyscallsForProfile_Handler,
 		},
+		{
+			MethodName: "CapabilitiesForProfile",
+			Handler:    _BpfRecorder_CapabilitiesForProfile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSyscalls",
+			Handler:       _BpfRecorder_WatchSyscalls_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/bpfrecorder/api.proto",
 }
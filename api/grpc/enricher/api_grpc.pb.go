@@ -38,6 +38,10 @@ const (
 	Enricher_ResetSyscalls_FullMethodName = "/api_enricher.Enricher/ResetSyscalls"
 	Enricher_Avcs_FullMethodName          = "/api_enricher.Enricher/Avcs"
 	Enricher_ResetAvcs_FullMethodName     = "/api_enricher.Enricher/ResetAvcs"
+	Enricher_Apparmor_FullMethodName      = "/api_enricher.Enricher/Apparmor"
+	Enricher_ResetApparmor_FullMethodName = "/api_enricher.Enricher/ResetApparmor"
+	Enricher_StopRecording_FullMethodName = "/api_enricher.Enricher/StopRecording"
+	Enricher_Subscribe_FullMethodName     = "/api_enricher.Enricher/Subscribe"
 )
 
 // EnricherClient is the client API for Enricher service.
@@ -48,6 +52,10 @@ type EnricherClient interface {
 	ResetSyscalls(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	Avcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*AvcResponse, error)
 	ResetAvcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Apparmor(ctx context.Context, in *ApparmorRequest, opts ...grpc.CallOption) (*ApparmorResponse, error)
+	ResetApparmor(ctx context.Context, in *ApparmorRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Enricher_SubscribeClient, error)
 }
 
 type enricherClient struct {
@@ -94,6 +102,65 @@ func (c *enricherClient) ResetAvcs(ctx context.Context, in *AvcRequest, opts ...
 	return out, nil
 }
 
+func (c *enricherClient) Apparmor(ctx context.Context, in *ApparmorRequest, opts ...grpc.CallOption) (*ApparmorResponse, error) {
+	out := new(ApparmorResponse)
+	err := c.cc.Invoke(ctx, Enricher_Apparmor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) ResetApparmor(ctx context.Context, in *ApparmorRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, Enricher_ResetApparmor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, Enricher_StopRecording_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Enricher_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Enricher_ServiceDesc.Streams[0], Enricher_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enricherSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Enricher_SubscribeClient interface {
+	Recv() (*AuditEvent, error)
+	grpc.ClientStream
+}
+
+type enricherSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *enricherSubscribeClient) Recv() (*AuditEvent, error) {
+	m := new(AuditEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // EnricherServer is the server API for Enricher service.
 // All implementations must embed UnimplementedEnricherServer
 // for forward compatibility
@@ -102,6 +169,10 @@ type EnricherServer interface {
 	ResetSyscalls(context.Context, *SyscallsRequest) (*EmptyResponse, error)
 	Avcs(context.Context, *AvcRequest) (*AvcResponse, error)
 	ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error)
+	Apparmor(context.Context, *ApparmorRequest) (*ApparmorResponse, error)
+	ResetApparmor(context.Context, *ApparmorRequest) (*EmptyResponse, error)
+	StopRecording(context.Context, *StopRecordingRequest) (*EmptyResponse, error)
+	Subscribe(*SubscribeRequest, Enricher_SubscribeServer) error
 	mustEmbedUnimplementedEnricherServer()
 }
 
@@ -121,6 +192,18 @@ func (UnimplementedEnricherServer) Avcs(context.Context, *AvcRequest) (*AvcRespo
 func (UnimplementedEnricherServer) ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetAvcs not implemented")
 }
+func (UnimplementedEnricherServer) Apparmor(context.Context, *ApparmorRequest) (*ApparmorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apparmor not implemented")
+}
+func (UnimplementedEnricherServer) ResetApparmor(context.Context, *ApparmorRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetApparmor not implemented")
+}
+func (UnimplementedEnricherServer) StopRecording(context.Context, *StopRecordingRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecording not implemented")
+}
+func (UnimplementedEnricherServer) Subscribe(*SubscribeRequest, Enricher_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
 func (UnimplementedEnricherServer) mustEmbedUnimplementedEnricherServer() {}
 
 // UnsafeEnricherServer may be embedded to opt out of forward compatibility for this service.
@@ -206,6 +289,81 @@ func _Enricher_ResetAvcs_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Enricher_Apparmor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApparmorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).Apparmor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_Apparmor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).Apparmor(ctx, req.(*ApparmorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_ResetApparmor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApparmorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).ResetApparmor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_ResetApparmor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).ResetApparmor(ctx, req.(*ApparmorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).StopRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_StopRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).StopRecording(ctx, req.(*StopRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnricherServer).Subscribe(m, &enricherSubscribeServer{stream})
+}
+
+type Enricher_SubscribeServer interface {
+	Send(*AuditEvent) error
+	grpc.ServerStream
+}
+
+type enricherSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *enricherSubscribeServer) Send(m *AuditEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Enricher_ServiceDesc is the grpc.ServiceDesc for Enricher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,7 +387,25 @@ var Enricher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetAvcs",
 			Handler:    _Enricher_ResetAvcs_Handler,
 		},
+		{
+			MethodName: "Apparmor",
+			Handler:    _Enricher_Apparmor_Handler,
+		},
+		{
+			MethodName: "ResetApparmor",
+			Handler:    _Enricher_ResetApparmor_Handler,
+		},
+		{
+			MethodName: "StopRecording",
+			Handler:    _Enricher_StopRecording_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Enricher_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/enricher/api.proto",
 }
@@ -31,3 +31,19 @@ func (in *StatusBase) DeepCopy() *StatusBase {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ViolationSummary) DeepCopyInto(out *ViolationSummary) {
+	*out = *in
+	in.LastSeen.DeepCopyInto(&out.LastSeen)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ViolationSummary.
+func (in *ViolationSummary) DeepCopy() *ViolationSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ViolationSummary)
+	in.DeepCopyInto(out)
+	return out
+}
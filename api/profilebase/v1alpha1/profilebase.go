@@ -37,6 +37,9 @@ type SecurityProfileBase interface {
 
 	ListProfilesByRecording(ctx context.Context, cli client.Client, recording string) ([]metav1.Object, error)
 	IsPartial() bool
+	// GetNodeSelector returns the labels a node requires to get the
+	// profile installed.
+	GetNodeSelector() map[string]string
 }
 
 func IsPartial(obj metav1.Object) bool {
@@ -92,3 +95,20 @@ type StatusBaseUser interface {
 	// implementation
 	SetImplementationStatus()
 }
+
+// ViolationSummary summarizes the denials of workloads using a profile, as
+// observed by the log enricher.
+type ViolationSummary struct {
+	// Count is the number of observed denials.
+	Count int64 `json:"count"`
+	// LastDenied is the last denied syscall or SELinux access.
+	// +optional
+	LastDenied string `json:"lastDenied,omitempty"`
+	// LastPod is the pod which caused the last denial, in the format
+	// "namespace/name".
+	// +optional
+	LastPod string `json:"lastPod,omitempty"`
+	// LastSeen is the time of the last denial.
+	// +optional
+	LastSeen metav1.Time `json:"lastSeen,omitempty"`
+}
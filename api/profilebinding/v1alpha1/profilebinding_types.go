@@ -23,24 +23,63 @@ import (
 type ProfileBindingKind string
 
 const (
-	ProfileBindingKindSeccompProfile ProfileBindingKind = "SeccompProfile"
-	ProfileBindingKindSelinuxProfile ProfileBindingKind = "SelinuxProfile"
+	ProfileBindingKindSeccompProfile        ProfileBindingKind = "SeccompProfile"
+	ProfileBindingKindClusterSeccompProfile ProfileBindingKind = "ClusterSeccompProfile"
+	ProfileBindingKindSelinuxProfile        ProfileBindingKind = "SelinuxProfile"
+	ProfileBindingKindAppArmorProfile       ProfileBindingKind = "AppArmorProfile"
 )
 
 // ProfileBindingSpec defines the desired state of ProfileBinding.
+//
+//nolint:lll // required for kubebuilder
+// +kubebuilder:validation:XValidation:rule="(has(self.image) && self.image != '') || has(self.podSelector) || (has(self.containerNames) && size(self.containerNames) > 0)",message="at least one of image, podSelector or containerNames is required"
 type ProfileBindingSpec struct {
 	// ProfileRef references a SeccompProfile or other profile type in the current namespace.
 	ProfileRef ProfileRef `json:"profileRef"`
-	// Image name within pod containers to match to the profile.
-	Image string `json:"image"`
+	// Image name within pod containers to match to the profile. Required if
+	// neither PodSelector nor ContainerNames are set. Image references are
+	// normalized before matching, so `nginx` equals `docker.io/library/nginx`.
+	// An image without tag and digest matches all tags and digests of the
+	// repository, and glob patterns like `registry.example.com/team/*:*` are
+	// supported as well. Patterns are normalized in the same way, so `*/app`
+	// matches `docker.io/team/app` and a glob within the registry requires a
+	// `.` or `:`, like `*.example.com/app`.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// PodSelector restricts the binding to pods whose labels match the
+	// selector.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// ContainerNames restricts the binding to containers with one of the
+	// listed names.
+	// +optional
+	ContainerNames []string `json:"containerNames,omitempty"`
+}
+
+// Specificity returns the number of criteria used by the binding to select
+// containers. If several bindings of the same profile kind match a container,
+// the one with the highest specificity gets applied.
+func (pb *ProfileBinding) Specificity() int {
+	specificity := 0
+	if pb.Spec.Image != "" {
+		specificity++
+	}
+	if pb.Spec.PodSelector != nil {
+		specificity++
+	}
+	if len(pb.Spec.ContainerNames) > 0 {
+		specificity++
+	}
+	return specificity
 }
 
 // ProfileRef contains information that points to the profile being used.
 type ProfileRef struct {
 	// Kind of object to be bound.
-	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile
+	// +kubebuilder:validation:Enum=SeccompProfile;ClusterSeccompProfile;SelinuxProfile;AppArmorProfile
 	Kind ProfileBindingKind `json:"kind"`
 	// Name of the profile within the current namespace to which to bind the selected pods.
+	// ClusterSeccompProfiles are referenced by their name only.
 	Name string `json:"name"`
 }
 
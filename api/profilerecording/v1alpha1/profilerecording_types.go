@@ -18,19 +18,23 @@ package v1alpha1
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
 type ProfileRecordingKind string
 
 const (
-	ProfileRecordingKindSeccompProfile ProfileRecordingKind = "SeccompProfile"
-	ProfileRecordingKindSelinuxProfile ProfileRecordingKind = "SelinuxProfile"
+	ProfileRecordingKindSeccompProfile  ProfileRecordingKind = "SeccompProfile"
+	ProfileRecordingKindSelinuxProfile  ProfileRecordingKind = "SelinuxProfile"
+	ProfileRecordingKindAppArmorProfile ProfileRecordingKind = "AppArmorProfile"
 )
 
 type ProfileRecorder string
@@ -52,6 +56,12 @@ const (
 	ProfileToRecordingLabel = "spo.x-k8s.io/recording-id"
 	// ProfileToContainerLabel is the name of the container that produced this profile.
 	ProfileToContainerLabel = "spo.x-k8s.io/container-id"
+	// ProfileExecutableSyscallsAnnotation contains a JSON object mapping each
+	// executable observed during the recording to the syscalls it used.
+	ProfileExecutableSyscallsAnnotation = "spo.x-k8s.io/executable-syscalls"
+	// ProfileSyscallCountsAnnotation contains a JSON object mapping each
+	// recorded syscall to the number of times it has been observed.
+	ProfileSyscallCountsAnnotation = "spo.x-k8s.io/syscall-counts"
 	// RecordingHasUnmergedProfiles is a finalizer that indicates that the recording has partial policies. Its
 	// main use is to hold off the deletion of the recording until all partial profiles are merged.
 	RecordingHasUnmergedProfiles = "spo.x-k8s.io/has-unmerged-profiles"
@@ -60,7 +70,7 @@ const (
 // ProfileRecordingSpec defines the desired state of ProfileRecording.
 type ProfileRecordingSpec struct {
 	// Kind of object to be recorded.
-	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile
+	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile;AppArmorProfile
 	Kind ProfileRecordingKind `json:"kind"`
 
 	// Recorder to be used.
@@ -84,11 +94,275 @@ type ProfileRecordingSpec struct {
 	// in the pod.
 	// +optional
 	Containers []string `json:"containers,omitempty"`
+
+	// Duration limits how long the recording is active, counted from the
+	// creation of the ProfileRecording. Once it elapsed, the profiles of all
+	// recorded containers are collected even if their pods are still running
+	// and new pods are no longer annotated for recording.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// StopAt is the point in time at which the recording ends. It behaves
+	// like Duration and if both are set, the earlier point in time wins.
+	// +optional
+	StopAt *metav1.Time `json:"stopAt,omitempty"`
+
+	// SnapshotInterval enables periodic snapshots of the recorded profiles
+	// while the workloads are still running. Every interval, the syscalls
+	// recorded so far are written to the resulting profile without
+	// interrupting the recording. Only supported for SeccompProfile
+	// recordings.
+	// +optional
+	SnapshotInterval *metav1.Duration `json:"snapshotInterval,omitempty"`
+
+	// StartupDuration splits each recorded profile into a startup profile
+	// and a steady state profile. The startup phase starts with the first
+	// recorded syscall of a container, which may be later than the container
+	// start, and lasts for the provided duration. The startup profile, which
+	// is suffixed with "-startup", contains all recorded syscalls so that the
+	// steady state profile can be layered on top of it. The steady state
+	// profile omits the syscalls only seen during the startup phase. Only
+	// supported for SeccompProfile recordings.
+	// +optional
+	StartupDuration *metav1.Duration `json:"startupDuration,omitempty"`
+}
+
+// Condition types of a ProfileRecording. Only one of them is true at any
+// point in time and reflects the current phase of the recording.
+const (
+	// RecordingConditionRecording indicates that workloads are being recorded.
+	RecordingConditionRecording spodv1alpha1.ConditionType = "Recording"
+	// RecordingConditionCollecting indicates that recorded profiles are being
+	// collected.
+	RecordingConditionCollecting spodv1alpha1.ConditionType = "Collecting"
+	// RecordingConditionMerging indicates that partial profiles are waiting
+	// for or being merged.
+	RecordingConditionMerging spodv1alpha1.ConditionType = "Merging"
+	// RecordingConditionCompleted indicates that all recorded profiles have
+	// been produced.
+	RecordingConditionCompleted spodv1alpha1.ConditionType = "Completed"
+	// RecordingConditionFailed indicates that collecting or merging the
+	// profiles failed.
+	RecordingConditionFailed spodv1alpha1.ConditionType = "Failed"
+)
+
+// Reasons for the conditions of a ProfileRecording.
+const (
+	ReasonWorkloadsRecorded   spodv1alpha1.ConditionReason = "WorkloadsRecorded"
+	ReasonCollectingProfiles  spodv1alpha1.ConditionReason = "CollectingProfiles"
+	ReasonPartialProfiles     spodv1alpha1.ConditionReason = "PartialProfiles"
+	ReasonProfilesCollected   spodv1alpha1.ConditionReason = "ProfilesCollected"
+	ReasonProfilesMerged      spodv1alpha1.ConditionReason = "ProfilesMerged"
+	ReasonCollectionFailed    spodv1alpha1.ConditionReason = "CollectionFailed"
+	ReasonMergeFailed         spodv1alpha1.ConditionReason = "MergeFailed"
+	ReasonMergingProfiles     spodv1alpha1.ConditionReason = "MergingProfiles"
+	ReasonRecordingInProgress spodv1alpha1.ConditionReason = "RecordingInProgress"
+)
+
+// recordingPhases are the mutually exclusive condition types of a recording.
+var recordingPhases = []spodv1alpha1.ConditionType{
+	RecordingConditionRecording,
+	RecordingConditionCollecting,
+	RecordingConditionMerging,
+	RecordingConditionCompleted,
+	RecordingConditionFailed,
+}
+
+// RecordedProfile references a profile produced by a ProfileRecording.
+type RecordedProfile struct {
+	// Name of the produced profile.
+	Name string `json:"name"`
+
+	// Container for which the profile has been recorded.
+	Container string `json:"container"`
+
+	// Partial indicates that the profile still has to be merged.
+	// +optional
+	Partial bool `json:"partial,omitempty"`
+
+	// Capabilities are the Linux capabilities used by the container while
+	// recording the profile, without the CAP_ prefix. They are only recorded
+	// by the bpf recorder.
+	// +optional
+	Capabilities []string `json:"capabilities,omitempty"`
+}
+
+// RecordedContainer summarizes the profiles produced for a single container.
+type RecordedContainer struct {
+	// Name of the container.
+	Name string `json:"name"`
+
+	// Profiles is the number of complete profiles produced for the container.
+	Profiles int32 `json:"profiles"`
+
+	// PartialProfiles is the number of partial profiles produced for the
+	// container which are waiting to be merged.
+	PartialProfiles int32 `json:"partialProfiles"`
+
+	// Capabilities are the Linux capabilities used by the container across
+	// all its profiles. They can be used to restrict the capabilities in the
+	// security context of the container.
+	// +optional
+	Capabilities []string `json:"capabilities,omitempty"`
 }
 
 // ProfileRecordingStatus contains status of the ProfileRecording.
 type ProfileRecordingStatus struct {
+	spodv1alpha1.ConditionedStatus `json:",inline"`
+
 	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
+
+	// Profiles are the profiles produced by the recording.
+	// +optional
+	Profiles []RecordedProfile `json:"profiles,omitempty"`
+
+	// Containers summarizes the produced profiles per container.
+	// +optional
+	Containers []RecordedContainer `json:"containers,omitempty"`
+}
+
+// SetPhase marks the provided condition type as true and all other phase
+// condition types as false.
+func (s *ProfileRecordingStatus) SetPhase(
+	phase spodv1alpha1.ConditionType, reason spodv1alpha1.ConditionReason, message string,
+) {
+	now := metav1.Now()
+
+	for _, c := range s.Conditions {
+		if c.Type == phase || c.Status != corev1.ConditionTrue || !isRecordingPhase(c.Type) {
+			continue
+		}
+		s.SetConditions(spodv1alpha1.Condition{
+			Type:               c.Type,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: now,
+			Reason:             reason,
+		})
+	}
+
+	s.SetConditions(spodv1alpha1.Condition{
+		Type:               phase,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// Phase returns the condition type of the current phase or an empty string
+// if the recording has no phase yet.
+func (s *ProfileRecordingStatus) Phase() spodv1alpha1.ConditionType {
+	for _, c := range s.Conditions {
+		if c.Status == corev1.ConditionTrue && isRecordingPhase(c.Type) {
+			return c.Type
+		}
+	}
+	return ""
+}
+
+func sortedUnique(values []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isRecordingPhase(t spodv1alpha1.ConditionType) bool {
+	for _, phase := range recordingPhases {
+		if t == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// AddProfile adds the provided profile to the produced profiles or replaces
+// an existing one of the same name.
+func (s *ProfileRecordingStatus) AddProfile(profile RecordedProfile) {
+	replaced := false
+	for i := range s.Profiles {
+		if s.Profiles[i].Name == profile.Name {
+			s.Profiles[i] = profile
+			replaced = true
+		}
+	}
+	if !replaced {
+		s.Profiles = append(s.Profiles, profile)
+	}
+
+	sort.Slice(s.Profiles, func(i, j int) bool {
+		return s.Profiles[i].Name < s.Profiles[j].Name
+	})
+	s.countContainerProfiles()
+}
+
+// RemovePartialProfiles removes all partial profiles from the produced
+// profiles.
+func (s *ProfileRecordingStatus) RemovePartialProfiles() {
+	profiles := []RecordedProfile{}
+	for _, profile := range s.Profiles {
+		if !profile.Partial {
+			profiles = append(profiles, profile)
+		}
+	}
+	s.Profiles = profiles
+	s.countContainerProfiles()
+}
+
+// HasPartialProfiles returns true if any produced profile is partial.
+func (s *ProfileRecordingStatus) HasPartialProfiles() bool {
+	for _, profile := range s.Profiles {
+		if profile.Partial {
+			return true
+		}
+	}
+	return false
+}
+
+// PartialProfileCapabilities returns the capabilities used by all partial
+// profiles of the provided container.
+func (s *ProfileRecordingStatus) PartialProfileCapabilities(container string) []string {
+	capabilities := []string{}
+	for _, profile := range s.Profiles {
+		if profile.Partial && profile.Container == container {
+			capabilities = append(capabilities, profile.Capabilities...)
+		}
+	}
+	return sortedUnique(capabilities)
+}
+
+func (s *ProfileRecordingStatus) countContainerProfiles() {
+	counts := map[string]*RecordedContainer{}
+	for _, profile := range s.Profiles {
+		container, ok := counts[profile.Container]
+		if !ok {
+			container = &RecordedContainer{Name: profile.Container}
+			counts[profile.Container] = container
+		}
+		if profile.Partial {
+			container.PartialProfiles++
+		} else {
+			container.Profiles++
+		}
+		container.Capabilities = append(container.Capabilities, profile.Capabilities...)
+	}
+
+	s.Containers = nil
+	for _, container := range counts {
+		if len(container.Capabilities) > 0 {
+			container.Capabilities = sortedUnique(container.Capabilities)
+		}
+		s.Containers = append(s.Containers, *container)
+	}
+	sort.Slice(s.Containers, func(i, j int) bool {
+		return s.Containers[i].Name < s.Containers[j].Name
+	})
 }
 
 // +kubebuilder:object:root=true
@@ -110,6 +384,8 @@ func (pr *ProfileRecording) CtrAnnotation(ctrName string) (key, value string, er
 		return pr.ctrAnnotationSeccomp(ctrName)
 	case ProfileRecordingKindSelinuxProfile:
 		return pr.ctrAnnotationSelinux(ctrName)
+	case ProfileRecordingKindAppArmorProfile:
+		return pr.ctrAnnotationAppArmor(ctrName)
 	}
 
 	return "", "", fmt.Errorf(
@@ -117,9 +393,39 @@ func (pr *ProfileRecording) CtrAnnotation(ctrName string) (key, value string, er
 	)
 }
 
+// StopTime returns the point in time at which the recording ends and whether
+// the recording is time bounded at all.
+func (pr *ProfileRecording) StopTime() (time.Time, bool) {
+	var (
+		stopTime time.Time
+		bounded  bool
+	)
+
+	if pr.Spec.Duration != nil {
+		stopTime = pr.CreationTimestamp.Add(pr.Spec.Duration.Duration)
+		bounded = true
+	}
+
+	if pr.Spec.StopAt != nil && (!bounded || pr.Spec.StopAt.Time.Before(stopTime)) {
+		stopTime = pr.Spec.StopAt.Time
+		bounded = true
+	}
+
+	return stopTime, bounded
+}
+
+// IsStopped returns true if the recording is time bounded and its window
+// ended before or at the provided point in time.
+func (pr *ProfileRecording) IsStopped(now time.Time) bool {
+	stopTime, bounded := pr.StopTime()
+	return bounded && !now.Before(stopTime)
+}
+
 func (pr *ProfileRecording) IsKindSupported() bool {
 	switch pr.Spec.Kind {
-	case ProfileRecordingKindSelinuxProfile, ProfileRecordingKindSeccompProfile:
+	case ProfileRecordingKindSelinuxProfile,
+		ProfileRecordingKindSeccompProfile,
+		ProfileRecordingKindAppArmorProfile:
 		return true
 	}
 	return false
@@ -174,6 +480,18 @@ func (pr *ProfileRecording) ctrAnnotationSelinux(ctrName string) (key, value str
 	return
 }
 
+func (pr *ProfileRecording) ctrAnnotationAppArmor(ctrName string) (key, value string, err error) {
+	if pr.Spec.Recorder != ProfileRecorderLogs {
+		return "", "", fmt.Errorf(
+			"invalid recorder: %s, only %s is supported", pr.Spec.Recorder, ProfileRecorderLogs,
+		)
+	}
+
+	value = pr.ctrAnnotationValue(ctrName)
+	key = config.AppArmorProfileRecordLogsAnnotationKey + ctrName
+	return key, value, nil
+}
+
 // +kubebuilder:object:root=true
 
 // ProfileRecordingList contains a list of ProfileRecording.
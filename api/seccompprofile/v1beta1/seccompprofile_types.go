@@ -30,19 +30,42 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
+// SeccompProfileObject is the common interface of the namespaced SeccompProfile
+// and the ClusterSeccompProfile.
+// +k8s:deepcopy-gen=false
+type SeccompProfileObject interface {
+	profilebase.StatusBaseUser
+	profilebase.SecurityProfileBase
+	GetSpec() *SeccompProfileSpec
+	GetStatus() *SeccompProfileStatus
+	GetProfilePath() string
+}
+
 // Ensure SeccompProfile implements the StatusBaseUser and SecurityProfileBase interfaces.
 var (
 	_ profilebase.StatusBaseUser      = &SeccompProfile{}
 	_ profilebase.SecurityProfileBase = &SeccompProfile{}
+	_ profilebase.StatusBaseUser      = &ClusterSeccompProfile{}
+	_ profilebase.SecurityProfileBase = &ClusterSeccompProfile{}
+	_ SeccompProfileObject            = &SeccompProfile{}
+	_ SeccompProfileObject            = &ClusterSeccompProfile{}
 )
 
-const ExtJSON = ".json"
+const (
+	ExtJSON = ".json"
+
+	// ClusterProfilesDir is the directory below the profiles root path which
+	// contains the cluster scoped seccomp profiles. The underscore ensures
+	// that it cannot clash with a namespace name.
+	ClusterProfilesDir = "_cluster"
+)
 
 // SeccompProfileSpec defines the desired state of SeccompProfile.
 type SeccompProfileSpec struct {
 	// BaseProfileName is the name of base profile (in the same namespace) that
 	// will be unioned into this profile. Base profiles can be references as
-	// remote OCI artifacts as well when prefixed with `oci://`.
+	// remote OCI artifacts as well when prefixed with `oci://` or as
+	// ClusterSeccompProfile when prefixed with `cluster://`.
 	BaseProfileName string `json:"baseProfileName,omitempty"`
 
 	// Properties from containers/common/pkg/seccomp.Seccomp type
@@ -67,6 +90,12 @@ type SeccompProfileSpec struct {
 
 	// list of flags to use with seccomp(2)
 	Flags []*Flag `json:"flags,omitempty"`
+
+	// NodeSelector restricts the nodes on which the profile gets
+	// installed to the ones matching the labels. The profile is installed
+	// on all nodes if the selector is empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=SCMP_ARCH_NATIVE;SCMP_ARCH_X86;SCMP_ARCH_X86_64;SCMP_ARCH_X32;SCMP_ARCH_ARM;SCMP_ARCH_AARCH64;SCMP_ARCH_MIPS;SCMP_ARCH_MIPS64;SCMP_ARCH_MIPS64N32;SCMP_ARCH_MIPSEL;SCMP_ARCH_MIPSEL64;SCMP_ARCH_MIPSEL64N32;SCMP_ARCH_PPC;SCMP_ARCH_PPC64;SCMP_ARCH_PPC64LE;SCMP_ARCH_S390;SCMP_ARCH_S390X;SCMP_ARCH_PARISC;SCMP_ARCH_PARISC64;SCMP_ARCH_RISCV64
@@ -120,6 +149,10 @@ type SeccompProfileStatus struct {
 	// The path that should be provided to the `securityContext.seccompProfile.localhostProfile`
 	// field of a Pod or container spec
 	LocalhostProfile string `json:"localhostProfile,omitempty"`
+	// Violations summarizes the denied syscalls of workloads using the
+	// profile, if observed by the log enricher.
+	// +optional
+	Violations *profilebase.ViolationSummary `json:"violations,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -131,6 +164,7 @@ type SeccompProfileStatus struct {
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="LocalhostProfile",type=string,priority=10,JSONPath=`.status.localhostProfile`
+// +kubebuilder:printcolumn:name="Violations",type=integer,priority=10,JSONPath=`.status.violations.count`
 type SeccompProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -139,6 +173,14 @@ type SeccompProfile struct {
 	Status SeccompProfileStatus `json:"status,omitempty"`
 }
 
+func (sp *SeccompProfile) GetSpec() *SeccompProfileSpec {
+	return &sp.Spec
+}
+
+func (sp *SeccompProfile) GetStatus() *SeccompProfileStatus {
+	return &sp.Status
+}
+
 func (sp *SeccompProfile) GetStatusBase() *profilebase.StatusBase {
 	return &sp.Status.StatusBase
 }
@@ -190,6 +232,10 @@ func (sp *SeccompProfile) IsPartial() bool {
 	return profilebase.IsPartial(sp)
 }
 
+func (sp *SeccompProfile) GetNodeSelector() map[string]string {
+	return sp.Spec.NodeSelector
+}
+
 // +kubebuilder:object:root=true
 
 // SeccompProfileList contains a list of SeccompProfile.
@@ -199,6 +245,97 @@ type SeccompProfileList struct {
 	Items           []SeccompProfile `json:"items"`
 }
 
+// +kubebuilder:object:root=true
+
+// ClusterSeccompProfile is a cluster scoped seccomp profile, which gets
+// installed once per node and can be referenced from every namespace.
+// +kubebuilder:resource:scope=Cluster,shortName=csp
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="LocalhostProfile",type=string,priority=10,JSONPath=`.status.localhostProfile`
+// +kubebuilder:printcolumn:name="Violations",type=integer,priority=10,JSONPath=`.status.violations.count`
+type ClusterSeccompProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SeccompProfileSpec   `json:"spec,omitempty"`
+	Status SeccompProfileStatus `json:"status,omitempty"`
+}
+
+func (sp *ClusterSeccompProfile) GetSpec() *SeccompProfileSpec {
+	return &sp.Spec
+}
+
+func (sp *ClusterSeccompProfile) GetStatus() *SeccompProfileStatus {
+	return &sp.Status
+}
+
+func (sp *ClusterSeccompProfile) GetStatusBase() *profilebase.StatusBase {
+	return &sp.Status.StatusBase
+}
+
+func (sp *ClusterSeccompProfile) DeepCopyToStatusBaseIf() profilebase.StatusBaseUser {
+	return sp.DeepCopy()
+}
+
+func (sp *ClusterSeccompProfile) SetImplementationStatus() {
+	profilePath := sp.GetProfilePath()
+	sp.Status.LocalhostProfile = strings.TrimPrefix(profilePath, config.KubeletSeccompRootPath()+"/")
+}
+
+func (sp *ClusterSeccompProfile) GetProfileFile() string {
+	pfile := sp.GetName()
+	if !strings.HasSuffix(pfile, ExtJSON) {
+		pfile = sp.GetName() + ExtJSON
+	}
+	return pfile
+}
+
+func (sp *ClusterSeccompProfile) GetProfilePath() string {
+	pfile := sp.GetProfileFile()
+	return path.Join(
+		config.ProfilesRootPath(),
+		ClusterProfilesDir,
+		filepath.Base(pfile),
+	)
+}
+
+func (sp *ClusterSeccompProfile) GetProfileOperatorPath() string {
+	pfile := sp.GetProfileFile()
+	return path.Join(
+		config.OperatorRoot,
+		ClusterProfilesDir,
+		filepath.Base(pfile),
+	)
+}
+
+func (sp *ClusterSeccompProfile) ListProfilesByRecording(
+	ctx context.Context,
+	cli client.Client,
+	recording string,
+) ([]metav1.Object, error) {
+	return profilebase.ListProfilesByRecording(ctx, cli, recording, "", &ClusterSeccompProfileList{})
+}
+
+func (sp *ClusterSeccompProfile) IsPartial() bool {
+	return profilebase.IsPartial(sp)
+}
+
+func (sp *ClusterSeccompProfile) GetNodeSelector() map[string]string {
+	return sp.Spec.NodeSelector
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterSeccompProfileList contains a list of ClusterSeccompProfile.
+type ClusterSeccompProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSeccompProfile `json:"items"`
+}
+
 func init() { //nolint:gochecknoinits // required to init scheme
 	SchemeBuilder.Register(&SeccompProfile{}, &SeccompProfileList{})
+	SchemeBuilder.Register(&ClusterSeccompProfile{}, &ClusterSeccompProfileList{})
 }
@@ -38,6 +38,9 @@ const (
 	ProfileStateTerminating ProfileState = "Terminating"
 	// The profile couldn't be installed.
 	ProfileStateError ProfileState = "Error"
+	// The profile was not installed because the node does not match the
+	// node selector of the profile.
+	ProfileStateSkipped ProfileState = "Skipped"
 	// When adding new statuses, remember to also adjust the LowerOfTwoStates function.
 )
 
@@ -69,6 +72,8 @@ func LowerOfTwoStates(currentLowest, candidate ProfileState) ProfileState {
 	orderedStates[ProfileStatePending] = 3
 	orderedStates[ProfileStateInProgress] = 4
 	orderedStates[ProfileStateInstalled] = 5
+	// skipped nodes must never lower the state of the nodes with the profile installed
+	orderedStates[ProfileStateSkipped] = 6
 
 	if orderedStates[currentLowest] > orderedStates[candidate] {
 		return candidate
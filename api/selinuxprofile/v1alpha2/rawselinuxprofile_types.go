@@ -34,6 +34,11 @@ var (
 // RawSelinuxProfileSpec defines the desired state of RawSelinuxProfile.
 type RawSelinuxProfileSpec struct {
 	Policy string `json:"policy,omitempty"`
+	// NodeSelector restricts the nodes on which the profile gets
+	// installed to the ones matching the labels. The profile is installed
+	// on all nodes if the selector is empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -87,6 +92,10 @@ func (sp *RawSelinuxProfile) IsPartial() bool {
 	return profilebasev1alpha1.IsPartial(sp)
 }
 
+func (sp *RawSelinuxProfile) GetNodeSelector() map[string]string {
+	return sp.Spec.NodeSelector
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // RawSelinuxProfileList contains a list of RawSelinuxProfile.
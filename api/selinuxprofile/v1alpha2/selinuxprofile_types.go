@@ -64,6 +64,11 @@ type SelinuxProfileSpec struct {
 	Permissive bool `json:"permissive,omitempty"`
 	// Defines the allow policy for the profile
 	Allow Allow `json:"allow,omitempty"`
+	// NodeSelector restricts the nodes on which the profile gets
+	// installed to the ones matching the labels. The profile is installed
+	// on all nodes if the selector is empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 }
 
 type LabelKey string
@@ -86,6 +91,10 @@ type SelinuxProfileStatus struct {
 	// referenced as in a pod seLinuxOptions section.
 	Usage           string   `json:"usage,omitempty"`
 	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
+	// Violations summarizes the denied accesses of workloads using the
+	// profile, if observed by the log enricher.
+	// +optional
+	Violations *profilebasev1alpha1.ViolationSummary `json:"violations,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -96,6 +105,7 @@ type SelinuxProfileStatus struct {
 // +kubebuilder:resource:path=selinuxprofiles,scope=Namespaced
 // +kubebuilder:printcolumn:name="Usage",type="string",JSONPath=`.status.usage`
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Violations",type="integer",priority=10,JSONPath=`.status.violations.count`
 type SelinuxProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -140,6 +150,10 @@ func (sp *SelinuxProfile) IsPartial() bool {
 	return profilebasev1alpha1.IsPartial(sp)
 }
 
+func (sp *SelinuxProfile) GetNodeSelector() map[string]string {
+	return sp.Spec.NodeSelector
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SelinuxProfileList contains a list of SelinuxProfile.
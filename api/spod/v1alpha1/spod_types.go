@@ -237,6 +237,99 @@ type SelinuxOptions struct {
 	AllowedSystemProfiles []string `json:"allowedSystemProfiles,omitempty"`
 }
 
+// RecordedSyscallArgument selects a syscall argument to be recorded by the bpf
+// recorder.
+type RecordedSyscallArgument struct {
+	// Name is the name of the syscall, for example "clone".
+	Name string `json:"name"`
+	// Index is the index of the argument to be recorded.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=5
+	Index uint `json:"index"`
+}
+
+// BpfRecorderKey is the key used by the bpf recorder to attribute the
+// recorded data to containers.
+// +kubebuilder:validation:Enum=MountNamespace;CgroupID
+type BpfRecorderKey string
+
+const (
+	// BpfRecorderKeyMountNamespace records per mount namespace. Containers
+	// sharing a mount namespace, or running in the host mount namespace,
+	// cannot be distinguished.
+	BpfRecorderKeyMountNamespace BpfRecorderKey = "MountNamespace"
+	// BpfRecorderKeyCgroupID records per cgroup v2 ID. This requires the
+	// node to run with cgroup v2.
+	BpfRecorderKeyCgroupID BpfRecorderKey = "CgroupID"
+)
+
+// LogEnricherSource is the source from which the log enricher reads the
+// audit messages.
+// +kubebuilder:validation:Enum=File;Journald;AuditNetlink
+type LogEnricherSource string
+
+const (
+	// LogEnricherSourceFile tails the auditd log file or falls back to
+	// syslog if auditd is not running.
+	LogEnricherSourceFile LogEnricherSource = "File"
+	// LogEnricherSourceJournald follows the audit and kernel messages of
+	// the systemd journal by using the journalctl binary of the node.
+	LogEnricherSourceJournald LogEnricherSource = "Journald"
+	// LogEnricherSourceAuditNetlink listens to the audit messages of the
+	// kernel via netlink, which requires neither auditd nor syslog.
+	LogEnricherSourceAuditNetlink LogEnricherSource = "AuditNetlink"
+)
+
+// EnricherSinkType is the type of a sink to which the log enricher exports
+// the enriched audit events.
+// +kubebuilder:validation:Enum=File;Webhook;OTLP
+type EnricherSinkType string
+
+const (
+	// EnricherSinkTypeFile appends the events as JSON lines to a file on
+	// the node.
+	EnricherSinkTypeFile EnricherSinkType = "File"
+	// EnricherSinkTypeWebhook sends the events as JSON array via HTTP POST.
+	EnricherSinkTypeWebhook EnricherSinkType = "Webhook"
+	// EnricherSinkTypeOTLP sends the events as OpenTelemetry logs via
+	// OTLP/HTTP using the JSON encoding.
+	EnricherSinkTypeOTLP EnricherSinkType = "OTLP"
+)
+
+// EnricherSink configures a sink to which the log enricher exports the
+// enriched audit events.
+// +kubebuilder:validation:XValidation:rule="self.type != 'File' || has(self.path)",message="path is required for File sinks"
+// +kubebuilder:validation:XValidation:rule="self.type == 'File' || has(self.endpoint)",message="endpoint is required for Webhook and OTLP sinks"
+//
+//nolint:lll // required for kubebuilder
+type EnricherSink struct {
+	// Type is the type of the sink.
+	Type EnricherSinkType `json:"type"`
+	// Path is the absolute path of the file on the node for the File sink.
+	// +kubebuilder:validation:Pattern=`^/.*[^/]$`
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Endpoint is the URL to which the Webhook and OTLP sinks send the
+	// events, for example "http://otel-collector:4318/v1/logs".
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// BatchSize is the maximum number of events exported at once.
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BatchSize int32 `json:"batchSize,omitempty"`
+	// FlushInterval is the maximum time an event waits for its batch to be
+	// exported. Defaults to 5s.
+	// +optional
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+	// MaxRetries is the number of retries with exponential backoff for
+	// exporting a batch before it gets dropped.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
 type WebhookOptions struct {
 	// Name specifies which webhook do we configure
 	Name string `json:"name,omitempty"`
@@ -276,6 +369,29 @@ type SPODSpec struct {
 	// tells the operator whether or not to enable bpf recorder support for this
 	// SPOD instance.
 	EnableBpfRecorder bool `json:"enableBpfRecorder,omitempty"`
+	// BpfRecorderSyscallArgs if specified, a list of syscalls for which the
+	// bpf recorder additionally records the values of a single argument.
+	// Recorded profiles will then only allow those syscalls for the observed
+	// argument values.
+	// +optional
+	BpfRecorderSyscallArgs []RecordedSyscallArgument `json:"bpfRecorderSyscallArgs,omitempty"`
+	// EnableBpfViolationObserver tells the log enricher to observe seccomp
+	// violations via BPF instead of reading them from the audit logs. This
+	// requires the log enricher to be enabled.
+	// +optional
+	EnableBpfViolationObserver bool `json:"enableBpfViolationObserver,omitempty"`
+	// LogEnricherSource is the source from which the log enricher reads
+	// the audit messages. Defaults to "File".
+	// +optional
+	LogEnricherSource LogEnricherSource `json:"logEnricherSource,omitempty"`
+	// LogEnricherSinks are the sinks to which the log enricher exports the
+	// enriched audit events in addition to its log output.
+	// +optional
+	LogEnricherSinks []EnricherSink `json:"logEnricherSinks,omitempty"`
+	// BpfRecorderKey is the key used by the bpf recorder to attribute the
+	// recorded data to containers. Defaults to "MountNamespace".
+	// +optional
+	BpfRecorderKey BpfRecorderKey `json:"bpfRecorderKey,omitempty"`
 	// tells the operator whether or not to enable AppArmor support for this
 	// SPOD instance.
 	EnableAppArmor bool `json:"enableAppArmor,omitempty"`
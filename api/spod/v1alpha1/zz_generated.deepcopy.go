@@ -23,9 +23,9 @@ package v1alpha1
 
 import (
 	"github.com/containers/common/pkg/seccomp"
-	"k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -67,6 +67,46 @@ func (in *ConditionedStatus) DeepCopy() *ConditionedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnricherSink) DeepCopyInto(out *EnricherSink) {
+	*out = *in
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnricherSink.
+func (in *EnricherSink) DeepCopy() *EnricherSink {
+	if in == nil {
+		return nil
+	}
+	out := new(EnricherSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordedSyscallArgument) DeepCopyInto(out *RecordedSyscallArgument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordedSyscallArgument.
+func (in *RecordedSyscallArgument) DeepCopy() *RecordedSyscallArgument {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordedSyscallArgument)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 	*out = *in
@@ -75,6 +115,18 @@ func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BpfRecorderSyscallArgs != nil {
+		in, out := &in.BpfRecorderSyscallArgs, &out.BpfRecorderSyscallArgs
+		*out = make([]RecordedSyscallArgument, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogEnricherSinks != nil {
+		in, out := &in.LogEnricherSinks, &out.LogEnricherSinks
+		*out = make([]EnricherSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]corev1.Toleration, len(*in))
@@ -227,17 +279,17 @@ func (in *WebhookOptions) DeepCopyInto(out *WebhookOptions) {
 	*out = *in
 	if in.FailurePolicy != nil {
 		in, out := &in.FailurePolicy, &out.FailurePolicy
-		*out = new(v1.FailurePolicyType)
+		*out = new(admissionregistrationv1.FailurePolicyType)
 		**out = **in
 	}
 	if in.NamespaceSelector != nil {
 		in, out := &in.NamespaceSelector, &out.NamespaceSelector
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ObjectSelector != nil {
 		in, out := &in.ObjectSelector, &out.ObjectSelector
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -18,6 +18,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -41,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
@@ -53,6 +55,7 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/sink"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile"
@@ -67,6 +70,7 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/version"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/recording"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/validation"
 )
 
 const (
@@ -189,6 +193,21 @@ func main() {
 			Action: func(ctx *cli.Context) error {
 				return runLogEnricher(ctx, info)
 			},
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "bpf-violations",
+					Usage: "observe seccomp violations via BPF instead of the audit logs",
+				},
+				&cli.StringFlag{
+					Name:  "log-source",
+					Value: enricher.LogSourceFile,
+					Usage: "the source of the audit messages, either File, Journald or AuditNetlink",
+				},
+				&cli.StringFlag{
+					Name:  "sinks",
+					Usage: "the JSON encoded list of sinks to export the enriched audit events to",
+				},
+			},
 		},
 		&cli.Command{
 			Before:  initialize,
@@ -198,6 +217,17 @@ func main() {
 			Action: func(ctx *cli.Context) error {
 				return runBPFRecorder(ctx, info)
 			},
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  "syscall-args",
+					Usage: "record the argument value for syscalls, specified as <syscall>:<index> (e.g. clone:0)",
+				},
+				&cli.StringFlag{
+					Name:  "recording-key",
+					Value: bpfrecorder.RecordingKeyMountNamespace,
+					Usage: "the key to attribute recorded data to containers, either MountNamespace or CgroupID",
+				},
+			},
 		},
 		&cli.Command{
 			Name:     spocCmd,
@@ -329,6 +359,9 @@ func runManager(ctx *cli.Context, info *version.Info) error {
 	if err := selxv1alpha2.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add selinuxprofile API to scheme: %w", err)
 	}
+	if err := apparmorprofileapi.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add apparmorprofile API to scheme: %w", err)
+	}
 	if err := monitoringv1.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add ServiceMonitor API to scheme: %w", err)
 	}
@@ -390,6 +423,7 @@ func setControllerOptionsForNamespaces(opts *ctrl.Options) {
 func getEnabledControllers(ctx *cli.Context) []controller.Controller {
 	controllers := []controller.Controller{
 		seccompprofile.NewController(),
+		seccompprofile.NewClusterController(),
 	}
 
 	if ctx.Bool(recordingFlag) {
@@ -494,17 +528,40 @@ func runDaemon(ctx *cli.Context, info *version.Info) error {
 	return nil
 }
 
-func runBPFRecorder(_ *cli.Context, info *version.Info) error {
+func runBPFRecorder(ctx *cli.Context, info *version.Info) error {
 	const component = "bpf-recorder"
 	printInfo(component, info)
-	return bpfrecorder.New(ctrl.Log.WithName(component)).Run()
+	recorder := bpfrecorder.New(ctrl.Log.WithName(component))
+	if err := recorder.RecordSyscallArgs(ctx.StringSlice("syscall-args")); err != nil {
+		return fmt.Errorf("configure syscall argument recording: %w", err)
+	}
+	if err := recorder.UseRecordingKey(ctx.String("recording-key")); err != nil {
+		return fmt.Errorf("configure recording key: %w", err)
+	}
+	return recorder.Run()
 }
 
-func runLogEnricher(_ *cli.Context, info *version.Info) error {
+func runLogEnricher(ctx *cli.Context, info *version.Info) error {
 	const component = "log-enricher"
 	printInfo(component, info)
 
-	return enricher.New(ctrl.Log.WithName(component)).Run()
+	e := enricher.New(ctrl.Log.WithName(component))
+	if ctx.Bool("bpf-violations") {
+		e.ObserveBpfViolations()
+	}
+	if err := e.UseLogSource(ctx.String("log-source")); err != nil {
+		return fmt.Errorf("configure log source: %w", err)
+	}
+	if sinks := ctx.String("sinks"); sinks != "" {
+		configs := []sink.Config{}
+		if err := json.Unmarshal([]byte(sinks), &configs); err != nil {
+			return fmt.Errorf("parse sinks: %w", err)
+		}
+		if err := e.ExportTo(configs); err != nil {
+			return fmt.Errorf("configure sinks: %w", err)
+		}
+	}
+	return e.Run()
 }
 
 func runNonRootEnabler(ctx *cli.Context, info *version.Info) error {
@@ -556,14 +613,21 @@ func runWebhook(ctx *cli.Context, info *version.Info) error {
 	if err := selxv1alpha2.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add selinuxprofile API to scheme: %w", err)
 	}
+	if err := apparmorprofileapi.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add apparmorprofile API to scheme: %w", err)
+	}
 	if err := profilerecording1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add profilerecording API to scheme: %w", err)
 	}
+	if err := spodv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add spod API to scheme: %w", err)
+	}
 
 	setupLog.Info("registering webhooks")
 	hookserver := mgr.GetWebhookServer()
 	binding.RegisterWebhook(hookserver, mgr.GetClient())
 	recording.RegisterWebhook(hookserver, mgr.GetEventRecorderFor("recording-webhook"), mgr.GetClient())
+	validation.RegisterWebhook(hookserver, mgr.GetClient())
 
 	sigHandler := ctrl.SetupSignalHandler()
 	setupLog.Info("starting webhook")
@@ -123,6 +123,11 @@ const (
 	// created a selinux profile.
 	SelinuxProfileRecordLogsAnnotationKey = "io.containers.trace-avcs/"
 
+	// AppArmorProfileRecordLogsAnnotationKey is the annotation on a Pod that
+	// triggers the internal log enricher to trace the AppArmor accesses of a
+	// Pod and created an AppArmor profile.
+	AppArmorProfileRecordLogsAnnotationKey = "io.containers.trace-apparmor/"
+
 	// KubeletDirNodeLabelKey is the label on a Node that specifies
 	// a custom kubelet root directory configured for this node. The directory
 	// path is provided in the following format folder-subfolder-subfolder
@@ -146,6 +151,10 @@ const (
 	// the log enricher.
 	SelinuxPermissiveProfile = "selinuxrecording.process"
 
+	// AppArmorComplainProfile is the AppArmor profile name for tracing
+	// accesses from the log enricher.
+	AppArmorComplainProfile = "spo-apparmor-recording"
+
 	// GRPCServerSocketMetrics is the socket path for the GRPC metrics server.
 	GRPCServerSocketMetrics = "/var/run/grpc/metrics.sock"
 
@@ -162,6 +171,10 @@ const (
 	// OCIProfilePrefix is the prefix used for specifying security profiles
 	// from OCI artifacts.
 	OCIProfilePrefix = "oci://"
+
+	// ClusterProfilePrefix is the prefix used for referencing cluster scoped
+	// security profiles from namespaced ones.
+	ClusterProfilePrefix = "cluster://"
 )
 
 // ProfileRecordingOutputPath is the path where the recorded profiles will be
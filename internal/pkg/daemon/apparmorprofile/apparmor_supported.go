@@ -32,6 +32,7 @@ import (
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
 )
 
 var (
@@ -74,7 +75,16 @@ func (a *aaProfileManager) InstallProfile(bp profilebasev1alpha1.StatusBaseUser)
 		return false, errors.New(errInvalidCustomResourceType)
 	}
 
-	return a.loadProfile(a.logger, profile.GetProfileName(), profile.Spec.Policy)
+	policy := profile.Spec.Policy
+	if policy == "" && profile.Spec.Abstract != nil {
+		var err error
+		policy, err = translator.Object2AppArmor(profile)
+		if err != nil {
+			return false, fmt.Errorf("translating abstract profile: %w", err)
+		}
+	}
+
+	return a.loadProfile(a.logger, profile.GetProfileName(), policy)
 }
 
 func (a *aaProfileManager) CustomResourceTypeName() string {
@@ -21,10 +21,12 @@ package apparmorprofile
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
@@ -32,7 +34,10 @@ import (
 	sec "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 )
 
-var errInvalidCRD = errors.New("invalid CRD kind")
+var (
+	errInvalidCRD    = errors.New("invalid CRD kind")
+	errInvalidPolicy = errors.New("invalid policy")
+)
 
 func TestInstallProfile(t *testing.T) {
 	t.Parallel()
@@ -55,6 +60,43 @@ func TestInstallProfile(t *testing.T) {
 			sut:     aaProfileManager{loadProfile: func(_ logr.Logger, _, _ string) (bool, error) { return false, nil }},
 			profile: &v1alpha1.AppArmorProfile{},
 		},
+		{
+			name: "abstract profile CRD",
+			sut: aaProfileManager{loadProfile: func(_ logr.Logger, name, content string) (bool, error) {
+				if name != "test" || !strings.Contains(content, "profile test flags=") ||
+					!strings.Contains(content, "capability chown,") {
+					return false, errInvalidPolicy
+				}
+				return true, nil
+			}},
+			profile: &v1alpha1.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: v1alpha1.AppArmorProfileSpec{
+					Abstract: &v1alpha1.AppArmorAbstract{
+						Capability: &v1alpha1.AppArmorCapabilityRules{
+							AllowedCapabilities: []string{"chown"},
+						},
+					},
+				},
+			},
+			wantResult: true,
+		},
+		{
+			name: "raw policy takes precedence over abstract",
+			sut: aaProfileManager{loadProfile: func(_ logr.Logger, _, content string) (bool, error) {
+				if content != "raw" {
+					return false, errInvalidPolicy
+				}
+				return true, nil
+			}},
+			profile: &v1alpha1.AppArmorProfile{
+				Spec: v1alpha1.AppArmorProfileSpec{
+					Policy:   "raw",
+					Abstract: &v1alpha1.AppArmorAbstract{},
+				},
+			},
+			wantResult: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -65,6 +107,8 @@ func TestInstallProfile(t *testing.T) {
 			gotResult, gotErr := tc.sut.InstallProfile(tc.profile)
 			if tc.wantErr != nil {
 				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
 			}
 			require.Equal(t, tc.wantResult, gotResult)
 		})
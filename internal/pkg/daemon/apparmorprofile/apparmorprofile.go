@@ -27,6 +27,7 @@ import (
 	"github.com/go-logr/logr"
 	aa "github.com/pjbgf/go-apparmor/pkg/apparmor"
 	"github.com/pjbgf/go-apparmor/pkg/hostop"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -173,6 +174,22 @@ func (r *Reconciler) reconcileAppArmorProfile(
 		return reconcile.Result{RequeueAfter: wait}, nil
 	}
 
+	matchesNode, err := nodeStatus.MatchesNode(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("matching node selector: %w", err)
+	}
+	if !matchesNode {
+		l.Info("Node does not match the node selector of the profile, skipping")
+		if err := nodeStatus.SetSkipped(ctx, func() error {
+			return r.handleDeletion(sp)
+		}); err != nil {
+			r.metrics.IncAppArmorProfileError(reasonCannotUpdateStatus)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+			return reconcile.Result{}, fmt.Errorf("setting node status to skipped: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	// TODO: backoff policy
 	updated, err := r.manager.InstallProfile(sp)
 	if err != nil {
@@ -293,6 +310,27 @@ func (r *Reconciler) logNodeInfo() {
 	}
 }
 
+// installRecordingProfile loads the complain mode profile used by the log
+// based profile recorder, if AppArmor is available on the node.
+func (r *Reconciler) installRecordingProfile() error {
+	if !r.manager.Enabled() {
+		return nil
+	}
+
+	profile := &v1alpha1.AppArmorProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: config.AppArmorComplainProfile},
+		Spec: v1alpha1.AppArmorProfileSpec{
+			Abstract:     &v1alpha1.AppArmorAbstract{},
+			ComplainMode: true,
+		},
+	}
+	if _, err := r.manager.InstallProfile(profile); err != nil {
+		return fmt.Errorf("load %s profile: %w", config.AppArmorComplainProfile, err)
+	}
+	r.log.Info("Installed apparmor recording profile", "profile", config.AppArmorComplainProfile)
+	return nil
+}
+
 func ok(ok bool, err error) string {
 	if ok {
 		return "OK"
@@ -18,6 +18,7 @@ package apparmorprofile
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	_ "github.com/go-logr/logr"
@@ -111,6 +112,45 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestInstallRecordingProfile(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		manager *FakeProfileManager
+		wantErr bool
+	}{
+		{
+			name:    "NotEnabled",
+			manager: &FakeProfileManager{enabled: false, err: errors.New("not called")},
+		},
+		{
+			name:    "Installed",
+			manager: &FakeProfileManager{enabled: true, installed: true},
+		},
+		{
+			name:    "InstallFailed",
+			manager: &FakeProfileManager{enabled: true, err: errors.New("load failed")},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &Reconciler{log: log.Log, manager: tc.manager}
+			err := r.installRecordingProfile()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 type FakeProfileManager struct {
 	enabled   bool
 	installed bool
@@ -19,10 +19,18 @@ package apparmorprofile
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 )
 
 // Setup adds a controller that reconciles AppArmor profiles.
@@ -39,9 +47,37 @@ func (r *Reconciler) Setup(
 
 	r.logNodeInfo()
 
+	if err := r.installRecordingProfile(); err != nil {
+		r.log.Error(err, "cannot install apparmor recording profile")
+	}
+
 	// Register the regular reconciler to manage AppArmorProfiles
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("apparmorprofile").
 		For(&v1alpha1.AppArmorProfile{}).
+		Watches(
+			&source.Kind{Type: &corev1.Node{}},
+			handler.EnqueueRequestsFromMapFunc(r.handleNodeChanged),
+			builder.WithPredicates(nodestatus.NodeLabelsChangedPredicate()),
+		).
 		Complete(r)
 }
+
+// handleNodeChanged enqueues all profiles using a node selector, because the
+// labels of the node may not match it any more or start to match it.
+func (r *Reconciler) handleNodeChanged(client.Object) []reconcile.Request {
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+
+	list := &v1alpha1.AppArmorProfileList{}
+	if err := r.client.List(ctx, list); err != nil {
+		r.log.Error(err, "cannot list apparmor profiles in the cluster")
+		return []reconcile.Request{}
+	}
+
+	profiles := make([]profilebase.SecurityProfileBase, 0, len(list.Items))
+	for i := range list.Items {
+		profiles = append(profiles, &list.Items[i])
+	}
+	return nodestatus.NodeSelectorRequests(profiles)
+}
@@ -3,10 +3,31 @@
 #include <asm-generic/errno.h>
 #include <bpf/bpf_core_read.h>
 #include <bpf/bpf_helpers.h>
+#include <bpf/bpf_tracing.h>
 
 #define MAX_ENTRIES 8 * 1024
 #define MAX_SYSCALLS 1024
 #define MAX_COMM_LEN 64
+#define TASK_COMM_LEN 16
+#define MAX_SYSCALL_ARGS 16 * 1024
+#define MAX_SYSCALL_TIMES 64 * 1024
+#define MAX_CAPABILITIES 64
+#define MAX_CGROUP_NAME_LEN 128
+#define ROOT_CGROUP_ID 1
+#define CAP_OPT_NOAUDIT 0b10
+#define SIGSYS 31
+#define SYS_SECCOMP 1
+#define SECCOMP_MODE_DEAD 3
+#define SECCOMP_RET_KILL_PROCESS 0x80000000U
+#define SECCOMP_RET_KILL_THREAD 0x00000000U
+#define SECCOMP_RET_TRAP 0x00030000U
+#define SECCOMP_RET_ERRNO 0x00050000U
+#define SECCOMP_RET_LOG 0x7ffc0000U
+#define SECCOMP_RET_ALLOW 0x7fff0000U
+#define SECCOMP_RET_ACTION_FULL 0xffff0000U
+#define SECCOMP_SKIPPED 0b1
+#define SECCOMP_ACTION_KNOWN 0b10
+#define SECCOMP_REPORTED 0b100
 
 char LICENSE[] SEC("license") = "Dual BSD/GPL";
 
@@ -17,6 +38,33 @@ struct {
     __type(value, u8[MAX_SYSCALLS]);  // syscall IDs
 } mntns_syscalls SEC(".maps");
 
+// The call counts are kept per syscall rather than in mntns_syscalls, which
+// would otherwise need MAX_SYSCALLS counters for every preallocated entry.
+struct syscall_times_t {
+    u64 first_seen;  // boot time in nanoseconds
+    u64 last_seen;   // boot time in nanoseconds
+    u64 count;       // number of calls
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_SYSCALL_TIMES);
+    __type(key, u64);  // mntns << 32 | syscall ID
+    __type(value, struct syscall_times_t);
+} mntns_syscall_times SEC(".maps");
+
+struct comm_key_t {
+    u32 mntns;
+    char comm[TASK_COMM_LEN];
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_ENTRIES);
+    __type(key, struct comm_key_t);
+    __type(value, u8[MAX_SYSCALLS]);  // syscall IDs
+} mntns_comm_syscalls SEC(".maps");
+
 struct {
     __uint(type, BPF_MAP_TYPE_HASH);
     __uint(max_entries, MAX_ENTRIES);
@@ -24,19 +72,106 @@ struct {
     __type(value, u32);  // mntns ID
 } pid_mntns SEC(".maps");
 
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_ENTRIES);
+    __type(key, u32);    // mntns
+    __type(value, u64);  // capabilities bitmask
+} mntns_capabilities SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(max_entries, MAX_SYSCALLS);
+    __type(key, u32);   // syscall ID
+    __type(value, u8);  // argument index + 1, 0 if not recorded
+} syscall_arg_index SEC(".maps");
+
+struct syscall_arg_t {
+    u32 mntns;
+    u32 syscall_id;
+    u64 value;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_SYSCALL_ARGS);
+    __type(key, struct syscall_arg_t);
+    __type(value, u8);  // unused
+} mntns_syscall_args SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_ENTRIES);
+    __type(key, u64);   // mntns << 32 | syscall ID
+    __type(value, u8);  // unused
+} mntns_syscall_args_overflow SEC(".maps");
+
 struct {
     __uint(type, BPF_MAP_TYPE_RINGBUF);
     __uint(max_entries, 1 << 24);
 } events SEC(".maps");
 
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, 1 << 20);
+} syscall_events SEC(".maps");
+
+struct syscall_event_t {
+    u32 mntns;  // recording key
+    u32 syscall_id;
+};
+
+struct seccomp_state_t {
+    u32 action;
+    u32 flags;
+};
+
+// Threads killed by seccomp never exit their syscall, which is why an LRU map
+// is used to evict their state.
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(max_entries, MAX_ENTRIES);
+    __type(key, u32);  // thread ID
+    __type(value, struct seccomp_state_t);
+} seccomp_denied SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, 1 << 20);
+} violations SEC(".maps");
+
+struct violation_t {
+    u32 pid;
+    u32 syscall_id;
+    s64 ret;
+    u32 action;  // SECCOMP_RET_* without data
+    char comm[TASK_COMM_LEN];
+};
+
 struct event_t {
     u32 pid;
-    u32 mntns;
+    u32 mntns;  // recording key
+    char cgroup[MAX_CGROUP_NAME_LEN];
 };
 
 const volatile char filter_name[MAX_COMM_LEN] = {};
 
+// Key the recordings by the cgroup v2 ID instead of the mount namespace.
+const volatile u8 use_cgroup_id = 0;
+
+// Mount namespace of the host PID, which is excluded from recording.
+const volatile u32 host_mntns = 0;
+
 static inline bool is_filtered(char * comm);
+static inline bool is_host_mntns(u32 mntns);
+static inline u32 get_recording_key(struct task_struct * task);
+static inline void notify_new_syscall(u32 mntns, u32 syscall_id);
+static inline void record_comm_syscall(u32 mntns, char * comm, u32 syscall_id);
+static inline void record_syscall_time(u32 mntns, u32 syscall_id);
+static inline void record_syscall_arg(struct trace_event_raw_sys_enter * args,
+                                      u32 mntns, u32 syscall_id);
+static inline void report_violation(u32 syscall_id, u32 action, s64 ret);
+static inline void update_seccomp_state(u32 tid, u32 action, u32 flags);
 
 SEC("tracepoint/raw_syscalls/sys_enter")
 int sys_enter(struct trace_event_raw_sys_enter * args)
@@ -49,21 +184,13 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
 
     u32 pid = bpf_get_current_pid_tgid() >> 32;
 
-    // Get the current mntns
+    // Get the current recording key, which is the mntns per default
     struct task_struct * task = (struct task_struct *)bpf_get_current_task();
-    u32 mntns = BPF_CORE_READ(task, nsproxy, mnt_ns, ns.inum);
+    u32 mntns = get_recording_key(task);
     if (mntns == 0) {
         return 0;
     }
 
-    // Filter out mntns of the host PID to exclude host processes
-    u32 hostPid = 1;
-    u32 * host_mntns = NULL;
-    host_mntns = bpf_map_lookup_elem(&pid_mntns, &hostPid);
-    if (host_mntns != NULL && *host_mntns == mntns) {
-        return 0;
-    }
-
     // Filter per program name if requested
     char comm[MAX_COMM_LEN] = {};
     bpf_get_current_comm(comm, sizeof(comm));
@@ -86,6 +213,15 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
 
             event->pid = pid;
             event->mntns = mntns;
+            event->cgroup[0] = 0;
+            if (use_cgroup_id) {
+                // Pass the name of the cgroup to the userspace, which
+                // allows to resolve the container ID directly from it.
+                const char * name =
+                    BPF_CORE_READ(task, cgroups, dfl_cgrp, kn, name);
+                bpf_probe_read_kernel_str(event->cgroup, sizeof(event->cgroup),
+                                          name);
+            }
             bpf_ringbuf_submit(event, 0);
 
             bpf_map_update_elem(&pid_mntns, &pid, &mntns, BPF_ANY);
@@ -96,11 +232,16 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
     u8 * const mntns_syscall_value =
         bpf_map_lookup_elem(&mntns_syscalls, &mntns);
     if (mntns_syscall_value) {
-        mntns_syscall_value[syscall_id] = 1;
+        // There are no atomic byte operations, which means that concurrent
+        // first calls may rarely notify twice.
+        if (!mntns_syscall_value[syscall_id]) {
+            mntns_syscall_value[syscall_id] = 1;
+            notify_new_syscall(mntns, syscall_id);
+        }
     } else {
         // Initialise the syscalls recording buffer and record this syscall.
-        static const char init[MAX_SYSCALLS];
-        bpf_map_update_elem(&mntns_syscalls, &mntns, &init, BPF_ANY);
+        static const u8 init[MAX_SYSCALLS];
+        bpf_map_update_elem(&mntns_syscalls, &mntns, &init, BPF_NOEXIST);
         u8 * const value = bpf_map_lookup_elem(&mntns_syscalls, &mntns);
         if (!value) {
             // Should not happen, we updated the element straight ahead
@@ -110,12 +251,369 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
                 pid, mntns, comm);
             return 0;
         }
+        if (!value[syscall_id]) {
+            value[syscall_id] = 1;
+            notify_new_syscall(mntns, syscall_id);
+        }
+    }
+
+    record_syscall_time(mntns, syscall_id);
+    record_comm_syscall(mntns, comm, syscall_id);
+    record_syscall_arg(args, mntns, syscall_id);
+
+    return 0;
+}
+
+SEC("kprobe/cap_capable")
+int BPF_KPROBE(cap_capable, const struct cred * cred,
+               struct user_namespace * targ_ns, int cap, unsigned int opts)
+{
+    // Sanity check for capability range
+    if (cap < 0 || cap >= MAX_CAPABILITIES) {
+        return 0;
+    }
+
+    // Skip checks which are not audited, because they are only used to probe
+    // for a capability and do not indicate that it is required.
+    if (opts & CAP_OPT_NOAUDIT) {
+        return 0;
+    }
+
+    struct task_struct * task = (struct task_struct *)bpf_get_current_task();
+    u32 mntns = get_recording_key(task);
+    if (mntns == 0) {
+        return 0;
+    }
+
+    char comm[MAX_COMM_LEN] = {};
+    bpf_get_current_comm(comm, sizeof(comm));
+    if (is_filtered(comm)) {
+        return 0;
+    }
+
+    // Record the capability for this mntns
+    u64 * const capabilities = bpf_map_lookup_elem(&mntns_capabilities, &mntns);
+    if (capabilities) {
+        __sync_fetch_and_or(capabilities, 1ULL << cap);
+    } else {
+        u64 init = 1ULL << cap;
+        if (bpf_map_update_elem(&mntns_capabilities, &mntns, &init,
+                                BPF_NOEXIST) != 0) {
+            // Another CPU initialised the entry concurrently
+            u64 * const value = bpf_map_lookup_elem(&mntns_capabilities, &mntns);
+            if (value) {
+                __sync_fetch_and_or(value, init);
+            }
+        }
+    }
+
+    return 0;
+}
+
+static inline void notify_new_syscall(u32 mntns, u32 syscall_id)
+{
+    // Notify the userspace about the first occurrence of a syscall, which
+    // allows streaming the recording progress. Events get dropped if the
+    // ring buffer is full.
+    struct syscall_event_t * event =
+        bpf_ringbuf_reserve(&syscall_events, sizeof(struct syscall_event_t), 0);
+    if (event) {
+        event->mntns = mntns;
+        event->syscall_id = syscall_id;
+        bpf_ringbuf_submit(event, 0);
+    }
+}
+
+static inline void record_comm_syscall(u32 mntns, char * comm, u32 syscall_id)
+{
+    // Record the syscall for the program name in this mntns, which allows
+    // to attribute the syscalls to the executables of a container.
+    struct comm_key_t key = {.mntns = mntns};
+    __builtin_memcpy(key.comm, comm, TASK_COMM_LEN);
+
+    u8 * const value = bpf_map_lookup_elem(&mntns_comm_syscalls, &key);
+    if (value) {
         value[syscall_id] = 1;
+        return;
+    }
+
+    // Another CPU may have initialised the entry concurrently, which is why
+    // the syscalls recorded by it must not be overwritten.
+    static const char init[MAX_SYSCALLS];
+    bpf_map_update_elem(&mntns_comm_syscalls, &key, &init, BPF_NOEXIST);
+    u8 * const new_value = bpf_map_lookup_elem(&mntns_comm_syscalls, &key);
+    if (new_value) {
+        new_value[syscall_id] = 1;
+    }
+}
+
+static inline void record_syscall_time(u32 mntns, u32 syscall_id)
+{
+    u64 key = ((u64)mntns << 32) | syscall_id;
+    u64 now = bpf_ktime_get_boot_ns();
+
+    struct syscall_times_t * const times =
+        bpf_map_lookup_elem(&mntns_syscall_times, &key);
+    if (times) {
+        times->last_seen = now;
+        __sync_fetch_and_add(&times->count, 1);
+        return;
     }
 
+    // The first_seen timestamp is kept if another CPU initialised the entry
+    // concurrently, in which case this call is counted on the existing entry.
+    struct syscall_times_t init = {
+        .first_seen = now, .last_seen = now, .count = 1};
+    if (bpf_map_update_elem(&mntns_syscall_times, &key, &init, BPF_NOEXIST)) {
+        struct syscall_times_t * const value =
+            bpf_map_lookup_elem(&mntns_syscall_times, &key);
+        if (value) {
+            __sync_fetch_and_add(&value->count, 1);
+        }
+    }
+}
+
+static inline u32 get_recording_key(struct task_struct * task)
+{
+    // Filter out mntns of the host PID to exclude host processes, which
+    // applies to both recording keys because host services run in their own
+    // cgroups as well. The pid_mntns map cannot be used as reference for
+    // that, because it contains the recording keys.
+    u32 mntns = BPF_CORE_READ(task, nsproxy, mnt_ns, ns.inum);
+    if (mntns == 0 || is_host_mntns(mntns)) {
+        return 0;
+    }
+
+    if (use_cgroup_id) {
+        // The lower 32 bits of the cgroup v2 ID are unique for all existing
+        // cgroups, which allows us to keep the map layouts. Processes in the
+        // root cgroup are excluded.
+        u64 cgroup_id = bpf_get_current_cgroup_id();
+        if (cgroup_id <= ROOT_CGROUP_ID) {
+            return 0;
+        }
+        return (u32)cgroup_id;
+    }
+
+    return mntns;
+}
+
+static inline bool is_host_mntns(u32 mntns)
+{
+    return host_mntns != 0 && mntns == host_mntns;
+}
+
+static inline void record_syscall_arg(struct trace_event_raw_sys_enter * args,
+                                      u32 mntns, u32 syscall_id)
+{
+    u8 * const index = bpf_map_lookup_elem(&syscall_arg_index, &syscall_id);
+    if (index == NULL || *index == 0) {
+        return;
+    }
+
+    // The verifier does not allow variable offsets into the context, which
+    // means that we have to read the argument using constant indexes.
+    struct syscall_arg_t key = {.mntns = mntns, .syscall_id = syscall_id};
+    switch (*index - 1) {
+        case 0:
+            key.value = args->args[0];
+            break;
+        case 1:
+            key.value = args->args[1];
+            break;
+        case 2:
+            key.value = args->args[2];
+            break;
+        case 3:
+            key.value = args->args[3];
+            break;
+        case 4:
+            key.value = args->args[4];
+            break;
+        case 5:
+            key.value = args->args[5];
+            break;
+        default:
+            return;
+    }
+
+    static const u8 set = 1;
+    long err = bpf_map_update_elem(&mntns_syscall_args, &key, &set, BPF_NOEXIST);
+    if (err == -E2BIG) {
+        // The map is full, mark the syscall so that the userspace falls back
+        // to allowing it unconditionally.
+        u64 overflow_key = ((u64)mntns << 32) | syscall_id;
+        bpf_map_update_elem(&mntns_syscall_args_overflow, &overflow_key, &set,
+                            BPF_ANY);
+    }
+}
+
+// audit_seccomp gets called with the action of the filter for every killed
+// process or thread and, if the filter requests logging, for the other actions
+// as well.
+SEC("kprobe/audit_seccomp")
+int BPF_KPROBE(audit_seccomp, unsigned long syscall, long signr, int code)
+{
+    u32 action = (u32)code & SECCOMP_RET_ACTION_FULL;
+    if (action == SECCOMP_RET_ALLOW || action == SECCOMP_RET_LOG) {
+        return 0;
+    }
+
+    struct task_struct * task = (struct task_struct *)bpf_get_current_task();
+    if (get_recording_key(task) == 0) {
+        return 0;
+    }
+
+    u32 tid = (u32)bpf_get_current_pid_tgid();
+    struct seccomp_state_t * state =
+        bpf_map_lookup_elem(&seccomp_denied, &tid);
+    if (state != NULL && state->flags & SECCOMP_ACTION_KNOWN) {
+        // The action is already known from signal_generate.
+        return 0;
+    }
+
+    if (action == SECCOMP_RET_KILL_PROCESS ||
+        action == SECCOMP_RET_KILL_THREAD) {
+        // Killed threads do not exit the syscall.
+        report_violation(syscall, action, 0);
+        update_seccomp_state(tid, action,
+                             SECCOMP_ACTION_KNOWN | SECCOMP_REPORTED);
+        return 0;
+    }
+
+    update_seccomp_state(tid, action, SECCOMP_ACTION_KNOWN);
+    return 0;
+}
+
+// signal_generate catches the SIGSYS sent by seccomp for SECCOMP_RET_TRAP and
+// for killed processes, even if the filter does not request logging.
+SEC("raw_tracepoint/signal_generate")
+int signal_generate(struct bpf_raw_tracepoint_args * ctx)
+{
+    if ((int)ctx->args[0] != SIGSYS) {
+        return 0;
+    }
+
+    struct task_struct * task = (struct task_struct *)bpf_get_current_task();
+    if ((struct task_struct *)ctx->args[2] != task) {
+        return 0;
+    }
+
+    struct kernel_siginfo * info = (struct kernel_siginfo *)ctx->args[1];
+    if (BPF_CORE_READ(info, si_code) != SYS_SECCOMP) {
+        return 0;
+    }
+
+    if (get_recording_key(task) == 0) {
+        return 0;
+    }
+
+    u32 tid = (u32)bpf_get_current_pid_tgid();
+    struct seccomp_state_t * state =
+        bpf_map_lookup_elem(&seccomp_denied, &tid);
+    if (state != NULL && state->flags & SECCOMP_ACTION_KNOWN) {
+        // The action is already known from audit_seccomp.
+        return 0;
+    }
+
+    // The filter of a killed task is marked as dead before sending the signal.
+    if (BPF_CORE_READ(task, seccomp.mode) == SECCOMP_MODE_DEAD) {
+        u32 syscall_id = BPF_CORE_READ(info, _sifields._sigsys._syscall);
+        report_violation(syscall_id, SECCOMP_RET_KILL_PROCESS, 0);
+        update_seccomp_state(tid, SECCOMP_RET_KILL_PROCESS,
+                             SECCOMP_ACTION_KNOWN | SECCOMP_REPORTED);
+        return 0;
+    }
+
+    update_seccomp_state(tid, SECCOMP_RET_TRAP, SECCOMP_ACTION_KNOWN);
+    return 0;
+}
+
+SEC("kretprobe/__secure_computing")
+int BPF_KRETPROBE(secure_computing_exit, int ret)
+{
+    // A return value of -1 indicates that the syscall has to be skipped,
+    // which means that a seccomp filter did not allow it.
+    if (ret != -1) {
+        return 0;
+    }
+
+    struct task_struct * task = (struct task_struct *)bpf_get_current_task();
+    if (get_recording_key(task) == 0) {
+        return 0;
+    }
+
+    // The syscall ID is not available at this point, which is why the
+    // violation gets reported when the skipped syscall exits.
+    u32 tid = (u32)bpf_get_current_pid_tgid();
+    update_seccomp_state(tid, 0, SECCOMP_SKIPPED);
     return 0;
 }
 
+SEC("tracepoint/raw_syscalls/sys_exit")
+int sys_exit(struct trace_event_raw_sys_exit * args)
+{
+    u32 tid = (u32)bpf_get_current_pid_tgid();
+    struct seccomp_state_t * state =
+        bpf_map_lookup_elem(&seccomp_denied, &tid);
+    if (state == NULL) {
+        return 0;
+    }
+
+    u32 action = state->action;
+    u32 flags = state->flags;
+    bpf_map_delete_elem(&seccomp_denied, &tid);
+
+    if (!(flags & SECCOMP_SKIPPED) || flags & SECCOMP_REPORTED) {
+        return 0;
+    }
+
+    // Without any log or signal, the action cannot be distinguished from
+    // SECCOMP_RET_ERRNO, for example for SECCOMP_RET_TRACE without a tracer
+    // or SECCOMP_RET_USER_NOTIF answered with an error.
+    if (!(flags & SECCOMP_ACTION_KNOWN)) {
+        action = SECCOMP_RET_ERRNO;
+    }
+
+    report_violation(args->id, action, args->ret);
+    return 0;
+}
+
+static inline void report_violation(u32 syscall_id, u32 action, s64 ret)
+{
+    struct violation_t * violation =
+        bpf_ringbuf_reserve(&violations, sizeof(struct violation_t), 0);
+    if (violation) {
+        violation->pid = bpf_get_current_pid_tgid() >> 32;
+        violation->syscall_id = syscall_id;
+        violation->ret = ret;
+        violation->action = action;
+        bpf_get_current_comm(violation->comm, sizeof(violation->comm));
+        bpf_ringbuf_submit(violation, 0);
+    }
+}
+
+// update_seccomp_state adds the flags to the seccomp state of the thread and
+// sets the action if it is known.
+static inline void update_seccomp_state(u32 tid, u32 action, u32 flags)
+{
+    struct seccomp_state_t * state =
+        bpf_map_lookup_elem(&seccomp_denied, &tid);
+    if (state != NULL) {
+        if (flags & SECCOMP_ACTION_KNOWN) {
+            state->action = action;
+        }
+        state->flags |= flags;
+        return;
+    }
+
+    struct seccomp_state_t new_state = {
+        .action = action,
+        .flags = flags,
+    };
+    bpf_map_update_elem(&seccomp_denied, &tid, &new_state, BPF_ANY);
+}
+
 static inline bool is_filtered(char * comm)
 {
     // No filter set
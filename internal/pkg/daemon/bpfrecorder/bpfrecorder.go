@@ -44,6 +44,7 @@ import (
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 
@@ -62,8 +63,20 @@ const (
 	maxCacheItems       uint64        = 1000
 	defaultHostPid      uint32        = 1
 	defaultByteNum      int           = 4
+	maxSyscalls         int32         = 1024
+	maxSyscallArgs      uint64        = 6
+	eventHeaderSize     int           = 8
 )
 
+const cgroupV2ControllersPath = "/sys/fs/cgroup/cgroup.controllers"
+
+// ErrInvalidSyscallArg is returned if a syscall argument to be recorded
+// cannot be parsed.
+var ErrInvalidSyscallArg = errors.New("invalid syscall argument")
+
+// ErrInvalidSession is returned if a recording session has no name.
+var ErrInvalidSession = errors.New("invalid recording session")
+
 // BpfRecorder is the main structure of this package.
 type BpfRecorder struct {
 	api.UnimplementedBpfRecorderServer
@@ -71,6 +84,9 @@ type BpfRecorder struct {
 	logger                  logr.Logger
 	startRequests           int64
 	syscalls                *bpf.BPFMap
+	syscallTimes            *bpf.BPFMap
+	commSyscalls            *bpf.BPFMap
+	capabilities            *bpf.BPFMap
 	mntns                   *bpf.BPFMap
 	btfPath                 string
 	syscallIDtoNameCache    *ttlcache.Cache[string, string]
@@ -83,6 +99,14 @@ type BpfRecorder struct {
 	loadUnloadMutex         sync.RWMutex
 	metricsClient           apimetrics.Metrics_BpfIncClient
 	programNameFilter       string
+	syscallArgIndexes       map[string]uint
+	syscallArgs             *bpf.BPFMap
+	syscallArgsOverflow     *bpf.BPFMap
+	sessions                map[string]sets.Set[string]
+	sessionsMutex           sync.Mutex
+	recordingKey            string
+	watchers                map[chan *api.SyscallEvent]string
+	watchersMutex           sync.RWMutex
 }
 
 // New returns a new BpfRecorder instance.
@@ -101,6 +125,9 @@ func New(logger logr.Logger) *BpfRecorder {
 		mntnsToContainerIDMap:   bimap.New[uint32, string](),
 		containerIDToProfileMap: bimap.New[string, string](),
 		loadUnloadMutex:         sync.RWMutex{},
+		sessions:                map[string]sets.Set[string]{},
+		recordingKey:            RecordingKeyMountNamespace,
+		watchers:                map[chan *api.SyscallEvent]string{},
 	}
 }
 
@@ -115,6 +142,45 @@ func (b *BpfRecorder) FilterProgramName(filter string) {
 	b.programNameFilter = filepath.Base(filter)
 }
 
+// RecordSyscallArgs configures the syscalls for which the value of a single
+// argument should be recorded. Every entry has the format `<syscall>:<index>`,
+// for example `clone:0` to record the flags passed to clone.
+func (b *BpfRecorder) RecordSyscallArgs(args []string) error {
+	indexes := make(map[string]uint, len(args))
+	for _, arg := range args {
+		name, rawIndex, found := strings.Cut(arg, ":")
+		if !found || name == "" {
+			return fmt.Errorf("%w: %q: expected <syscall>:<index>", ErrInvalidSyscallArg, arg)
+		}
+		index, err := strconv.ParseUint(rawIndex, 10, 8)
+		if err != nil || index >= maxSyscallArgs {
+			return fmt.Errorf(
+				"%w: %q: index must be between 0 and %d", ErrInvalidSyscallArg, arg, maxSyscallArgs-1,
+			)
+		}
+		indexes[name] = uint(index)
+	}
+	b.syscallArgIndexes = indexes
+	return nil
+}
+
+// UseRecordingKey configures the key used to attribute the recorded data to
+// containers, which is either RecordingKeyMountNamespace or
+// RecordingKeyCgroupID. Keying by cgroup ID allows to distinguish containers
+// which share a mount namespace or run in the host mount namespace.
+func (b *BpfRecorder) UseRecordingKey(key string) error {
+	switch key {
+	case RecordingKeyMountNamespace, RecordingKeyCgroupID:
+		b.recordingKey = key
+		return nil
+	default:
+		return fmt.Errorf(
+			"%w: %q: expected %s or %s",
+			ErrInvalidRecordingKey, key, RecordingKeyMountNamespace, RecordingKeyCgroupID,
+		)
+	}
+}
+
 // Run the BpfRecorder.
 func (b *BpfRecorder) Run() error {
 	b.logger.Info(fmt.Sprintf("Setting up caches with expiry of %v", defaultCacheTimeout))
@@ -244,26 +310,97 @@ func Dial() (*grpc.ClientConn, context.CancelFunc, error) {
 func (b *BpfRecorder) Start(
 	ctx context.Context, r *api.EmptyRequest,
 ) (*api.EmptyResponse, error) {
+	b.sessionsMutex.Lock()
+	defer b.sessionsMutex.Unlock()
+
+	//nolint:contextcheck // no context intended here
+	if err := b.start(); err != nil {
+		return nil, err
+	}
+	return &api.EmptyResponse{}, nil
+}
+
+func (b *BpfRecorder) Stop(
+	ctx context.Context, r *api.EmptyRequest,
+) (*api.EmptyResponse, error) {
+	b.sessionsMutex.Lock()
+	defer b.sessionsMutex.Unlock()
+
+	b.stop()
+	return &api.EmptyResponse{}, nil
+}
+
+// StartSession opens a named recording session for the provided profiles.
+// The bpf module stays loaded as long as any session is open. Starting an
+// already open session adds the provided profiles to it.
+func (b *BpfRecorder) StartSession(
+	ctx context.Context, r *api.SessionRequest,
+) (*api.EmptyResponse, error) {
+	if r.GetName() == "" {
+		return nil, ErrInvalidSession
+	}
+
+	b.sessionsMutex.Lock()
+	defer b.sessionsMutex.Unlock()
+
+	profiles, ok := b.sessions[r.GetName()]
+	if !ok {
+		b.logger.Info("Starting recording session", "session", r.GetName())
+		//nolint:contextcheck // no context intended here
+		if err := b.start(); err != nil {
+			return nil, err
+		}
+		profiles = sets.New[string]()
+		b.sessions[r.GetName()] = profiles
+	}
+	profiles.Insert(r.GetProfiles()...)
+
+	return &api.EmptyResponse{}, nil
+}
+
+// StopSession closes a named recording session. Data which has not been
+// retrieved for the profiles of the session gets discarded, while the data
+// of other sessions is kept.
+func (b *BpfRecorder) StopSession(
+	ctx context.Context, r *api.SessionRequest,
+) (*api.EmptyResponse, error) {
+	b.sessionsMutex.Lock()
+	defer b.sessionsMutex.Unlock()
+
+	profiles, ok := b.sessions[r.GetName()]
+	if !ok {
+		b.logger.Info("Recording session not running", "session", r.GetName())
+		return &api.EmptyResponse{}, nil
+	}
+
+	b.logger.Info("Stopping recording session", "session", r.GetName())
+	delete(b.sessions, r.GetName())
+	b.cleanupProfiles(sets.List(profiles))
+	b.stop()
+
+	return &api.EmptyResponse{}, nil
+}
+
+// start loads the bpf module on the first start request.
+func (b *BpfRecorder) start() error {
 	if b.startRequests == 0 {
 		b.logger.Info("Starting bpf recorder")
-		//nolint:contextcheck // no context intended here
 		if err := b.Load(true); err != nil {
-			return nil, fmt.Errorf("load bpf: %w", err)
+			return fmt.Errorf("load bpf: %w", err)
 		}
 	} else {
 		b.logger.Info("bpf recorder already running")
 	}
 
 	atomic.AddInt64(&b.startRequests, 1)
-	return &api.EmptyResponse{}, nil
+	return nil
 }
 
-func (b *BpfRecorder) Stop(
-	ctx context.Context, r *api.EmptyRequest,
-) (*api.EmptyResponse, error) {
+// stop unloads the bpf module once all start requests are stopped.
+func (b *BpfRecorder) stop() {
 	if b.startRequests == 0 {
 		b.logger.Info("bpf recorder not running")
-		return &api.EmptyResponse{}, nil
+		return
 	}
 
 	atomic.AddInt64(&b.startRequests, -1)
@@ -273,7 +410,66 @@ func (b *BpfRecorder) Stop(
 	} else {
 		b.logger.Info("Not stopping because another recording is in progress")
 	}
-	return &api.EmptyResponse{}, nil
+}
+
+// cleanupProfiles removes the data recorded for the provided profiles from
+// the bpf maps.
+func (b *BpfRecorder) cleanupProfiles(profiles []string) {
+	for _, profile := range profiles {
+		if mntns, found := b.getMntnsForProfile(profile); found {
+			b.logger.Info("Cleaning up recorded data", "profile", profile, "mntns", mntns)
+			b.loadUnloadMutex.Lock()
+			b.deleteRecordedDataForMntns(mntns)
+			b.loadUnloadMutex.Unlock()
+		}
+		b.deleteContainerIDFromCache(profile)
+	}
+}
+
+// deleteRecordedDataForMntns removes all recorded data of the provided mount
+// namespace from the bpf maps.
+func (b *BpfRecorder) deleteRecordedDataForMntns(mntns uint32) {
+	// The keys do not exist if nothing got recorded.
+	if err := b.DeleteKey(b.syscalls, mntns); err != nil {
+		b.logger.V(config.VerboseLevel).Info("No syscalls to cleanup", "mntns", mntns)
+	}
+	if err := b.DeleteKey(b.capabilities, mntns); err != nil {
+		b.logger.V(config.VerboseLevel).Info("No capabilities to cleanup", "mntns", mntns)
+	}
+
+	b.deleteKeysForMntns(b.syscallTimes, mntns, func(key []byte) (uint32, bool) {
+		if len(key) != 8 {
+			return 0, false
+		}
+		return uint32(binary.LittleEndian.Uint64(key) >> 32), true
+	})
+	b.deleteKeysForMntns(b.commSyscalls, mntns, func(key []byte) (uint32, bool) {
+		if len(key) <= defaultByteNum {
+			return 0, false
+		}
+		return binary.LittleEndian.Uint32(key), true
+	})
+	b.deleteSyscallArgsForMntns(mntns)
+}
+
+// deleteKeysForMntns removes the keys of the provided bpf map which belong to
+// the mount namespace.
+func (b *BpfRecorder) deleteKeysForMntns(
+	m *bpf.BPFMap, mntns uint32, keyMntns func([]byte) (uint32, bool),
+) {
+	keys, err := b.MapKeys(m)
+	if err != nil {
+		b.logger.Error(err, "Unable to list bpf map keys", "mntns", mntns)
+		return
+	}
+	for _, key := range keys {
+		if keyMntnsValue, ok := keyMntns(key); !ok || keyMntnsValue != mntns {
+			continue
+		}
+		if err := b.DeleteKeyBytes(m, key); err != nil {
+			b.logger.Error(err, "Unable to cleanup bpf map", "mntns", mntns)
+		}
+	}
 }
 
 // SyscallsForProfile returns the syscall names for the provided profile name.
@@ -285,6 +481,315 @@ func (b *BpfRecorder) SyscallsForProfile(
 	}
 	b.logger.Info("Getting syscalls for profile " + r.Name)
 
+	mntns, err := b.lookupMntnsForProfile(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !r.Snapshot {
+		b.deleteContainerIDFromCache(r.Name)
+	}
+
+	b.loadUnloadMutex.RLock()
+	syscalls, err := b.GetValue(b.syscalls, mntns)
+	b.loadUnloadMutex.RUnlock()
+	if err != nil {
+		b.logger.Error(err, "No syscalls found for mntns", "mntns", mntns, "key", b.recordingKey)
+		return nil, fmt.Errorf("no syscalls found for %s: %d", b.recordingKey, mntns)
+	}
+
+	b.loadUnloadMutex.RLock()
+	syscallNames, statistics, timeKeys := b.syscallStatisticsForMntns(mntns, syscalls)
+	syscallArgs := b.syscallArgsForMntns(mntns)
+	executables, executableKeys := b.executableSyscallsForMntns(mntns)
+	b.loadUnloadMutex.RUnlock()
+
+	// Cleanup the syscalls map from eBpf, unless this is only a snapshot of
+	// an ongoing recording.
+	if !r.Snapshot {
+		b.logger.Info("Cleaning up BPF syscalls hashmaps")
+		b.loadUnloadMutex.Lock()
+		if err := b.DeleteKey(b.syscalls, mntns); err != nil {
+			b.logger.Error(err, "Unable to cleanup syscalls map", "mntns", mntns)
+		}
+		for _, key := range timeKeys {
+			if err := b.DeleteKeyBytes(b.syscallTimes, key); err != nil {
+				b.logger.Error(err, "Unable to cleanup syscall times map", "mntns", mntns)
+			}
+		}
+		for _, key := range executableKeys {
+			if err := b.DeleteKeyBytes(b.commSyscalls, key); err != nil {
+				b.logger.Error(err, "Unable to cleanup program name syscalls map", "mntns", mntns)
+			}
+		}
+		b.deleteSyscallArgsForMntns(mntns)
+		b.loadUnloadMutex.Unlock()
+	}
+
+	return &api.SyscallsResponse{
+		Syscalls:    sortUnique(syscallNames),
+		GoArch:      runtime.GOARCH,
+		SyscallArgs: syscallArgs,
+		Executables: executables,
+		Statistics:  statistics,
+	}, nil
+}
+
+// syscallTimes is the value of the mntns_syscall_times bpf map, containing
+// the boot time in nanoseconds and the number of calls.
+type syscallTimes struct {
+	FirstSeen uint64
+	LastSeen  uint64
+	Count     uint64
+}
+
+// syscallStatisticsForMntns converts the syscalls recorded for the provided
+// mount namespace into syscall names and statistics. It also returns the keys
+// of the mntns_syscall_times bpf map which have been read.
+func (b *BpfRecorder) syscallStatisticsForMntns(mntns uint32, syscalls []byte) (
+	names []string, statistics []*api.SyscallStatistics, timeKeys [][]byte,
+) {
+	bootTime, err := b.BootTime()
+	if err != nil {
+		b.logger.Error(err, "Unable to get boot time, omitting syscall timestamps")
+	}
+
+	for id, found := range syscalls {
+		if found == 0 {
+			continue
+		}
+		name, err := b.syscallNameForID(id)
+		if err != nil {
+			b.logger.Error(err, "unable to convert syscall ID")
+			continue
+		}
+		names = append(names, name)
+		// The syscall has been called at least once, even if the times map
+		// was full and could not track it.
+		stats := &api.SyscallStatistics{Name: name, Count: 1}
+		statistics = append(statistics, stats)
+
+		key := make([]byte, 8)
+		binary.LittleEndian.PutUint64(key, mntnsSyscallKey(mntns, uint32(id)))
+		value, err := b.GetValueBytes(b.syscallTimes, key)
+		if err != nil {
+			continue
+		}
+		timeKeys = append(timeKeys, key)
+
+		var times syscallTimes
+		if binary.Read(bytes.NewReader(value), binary.LittleEndian, &times) != nil {
+			continue
+		}
+		if times.Count > 0 {
+			stats.Count = times.Count
+		}
+		if bootTime.IsZero() {
+			continue
+		}
+		stats.FirstSeen = bootTime.Add(time.Duration(times.FirstSeen)).UnixNano()
+		stats.LastSeen = bootTime.Add(time.Duration(times.LastSeen)).UnixNano()
+	}
+
+	sort.Slice(statistics, func(i, j int) bool {
+		return statistics[i].Name < statistics[j].Name
+	})
+	return names, statistics, timeKeys
+}
+
+// executableSyscallsForMntns returns the syscalls recorded per program name
+// for the provided mount namespace, as well as the bpf map keys containing
+// them.
+func (b *BpfRecorder) executableSyscallsForMntns(mntns uint32) (
+	executables []*api.ExecutableSyscalls, keys [][]byte,
+) {
+	allKeys, err := b.MapKeys(b.commSyscalls)
+	if err != nil {
+		b.logger.Error(err, "Unable to list program name syscalls")
+		return nil, nil
+	}
+
+	for _, key := range allKeys {
+		if len(key) <= defaultByteNum || binary.LittleEndian.Uint32(key) != mntns {
+			continue
+		}
+
+		syscalls, err := b.GetValueBytes(b.commSyscalls, key)
+		if err != nil {
+			b.logger.Error(err, "Unable to get syscalls for program name", "mntns", mntns)
+			continue
+		}
+
+		keys = append(keys, key)
+		executables = append(executables, &api.ExecutableSyscalls{
+			Executable: toStringByte(key[defaultByteNum:]),
+			Syscalls:   sortUnique(b.convertSyscallIDsToNames(syscalls)),
+		})
+	}
+
+	sort.Slice(executables, func(i, j int) bool {
+		return executables[i].Executable < executables[j].Executable
+	})
+	return executables, keys
+}
+
+// syscallArgKey is the key of the mntns_syscall_args bpf map.
+type syscallArgKey struct {
+	Mntns     uint32
+	SyscallID uint32
+	Value     uint64
+}
+
+// mntnsSyscallKey returns the key of the bpf maps which are indexed by the
+// mount namespace and syscall ID.
+func mntnsSyscallKey(mntns, syscallID uint32) uint64 {
+	return uint64(mntns)<<32 | uint64(syscallID)
+}
+
+// syscallArgsForMntns returns the recorded syscall argument values for the
+// provided mount namespace. Syscalls for which more values have been seen
+// than the bpf map can hold are omitted, which means that they will be
+// allowed unconditionally.
+func (b *BpfRecorder) syscallArgsForMntns(mntns uint32) []*api.SyscallArguments {
+	if b.syscallArgs == nil {
+		return nil
+	}
+
+	keys, err := b.MapKeys(b.syscallArgs)
+	if err != nil {
+		b.logger.Error(err, "Unable to list recorded syscall arguments")
+		return nil
+	}
+
+	values := map[uint32][]uint64{}
+	for _, rawKey := range keys {
+		key := syscallArgKey{}
+		if err := binary.Read(bytes.NewReader(rawKey), binary.LittleEndian, &key); err != nil {
+			b.logger.Error(err, "Unable to read syscall argument key")
+			continue
+		}
+		if key.Mntns == mntns {
+			values[key.SyscallID] = append(values[key.SyscallID], key.Value)
+		}
+	}
+
+	result := []*api.SyscallArguments{}
+	for id, idValues := range values {
+		name, err := b.syscallNameForID(int(id))
+		if err != nil {
+			b.logger.Error(err, "unable to convert syscall ID")
+			continue
+		}
+
+		index, ok := b.syscallArgIndexes[name]
+		if !ok {
+			continue
+		}
+
+		if _, err := b.GetValue64(
+			b.syscallArgsOverflow, mntnsSyscallKey(mntns, id),
+		); err == nil {
+			b.logger.Info(
+				"Too many argument values recorded, allowing syscall unconditionally",
+				"syscall", name, "mntns", mntns,
+			)
+			continue
+		}
+
+		sort.Slice(idValues, func(i, j int) bool { return idValues[i] < idValues[j] })
+		result = append(result, &api.SyscallArguments{
+			Name:   name,
+			Index:  uint32(index),
+			Values: idValues,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+func (b *BpfRecorder) deleteSyscallArgsForMntns(mntns uint32) {
+	if b.syscallArgs == nil {
+		return
+	}
+
+	keys, err := b.MapKeys(b.syscallArgs)
+	if err != nil {
+		b.logger.Error(err, "Unable to list recorded syscall arguments")
+	}
+	for _, rawKey := range keys {
+		key := syscallArgKey{}
+		if err := binary.Read(bytes.NewReader(rawKey), binary.LittleEndian, &key); err != nil {
+			continue
+		}
+		if key.Mntns != mntns {
+			continue
+		}
+		if err := b.DeleteKeyBytes(b.syscallArgs, rawKey); err != nil {
+			b.logger.Error(err, "Unable to cleanup syscall arguments map", "mntns", mntns)
+		}
+	}
+
+	overflowKeys, err := b.MapKeys(b.syscallArgsOverflow)
+	if err != nil {
+		b.logger.Error(err, "Unable to list overflowed syscall arguments")
+	}
+	for _, rawKey := range overflowKeys {
+		if len(rawKey) != 8 || uint32(binary.LittleEndian.Uint64(rawKey)>>32) != mntns {
+			continue
+		}
+		if err := b.DeleteKeyBytes(b.syscallArgsOverflow, rawKey); err != nil {
+			b.logger.Error(err, "Unable to cleanup syscall arguments overflow map", "mntns", mntns)
+		}
+	}
+}
+
+// CapabilitiesForProfile returns the capabilities used by the container of
+// the provided profile name. It has to be called before SyscallsForProfile,
+// because retrieving the syscalls finishes the recording of the container.
+func (b *BpfRecorder) CapabilitiesForProfile(
+	ctx context.Context, r *api.ProfileRequest,
+) (*api.CapabilitiesResponse, error) {
+	if b.startRequests == 0 {
+		return nil, errors.New("bpf recorder not running")
+	}
+	b.logger.Info("Getting capabilities for profile " + r.Name)
+
+	mntns, err := b.lookupMntnsForProfile(r.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	b.loadUnloadMutex.RLock()
+	value, err := b.GetValue(b.capabilities, mntns)
+	b.loadUnloadMutex.RUnlock()
+	if err != nil {
+		// The container did not do any capability check.
+		b.logger.Info("No capabilities found for mntns", "mntns", mntns)
+		return &api.CapabilitiesResponse{}, nil
+	}
+	if len(value) != capabilitiesValueSize {
+		return nil, fmt.Errorf("invalid capabilities value size %d for mntns: %d", len(value), mntns)
+	}
+
+	// Cleanup the capabilities map from eBpf, unless this is only a snapshot
+	// of an ongoing recording.
+	if !r.Snapshot {
+		b.logger.Info("Cleaning up BPF capabilities hashmap")
+		b.loadUnloadMutex.Lock()
+		if err := b.DeleteKey(b.capabilities, mntns); err != nil {
+			b.logger.Error(err, "Unable to cleanup capabilities map", "mntns", mntns)
+		}
+		b.loadUnloadMutex.Unlock()
+	}
+
+	return &api.CapabilitiesResponse{
+		Capabilities: capabilityNames(binary.LittleEndian.Uint64(value)),
+	}, nil
+}
+
+// lookupMntnsForProfile returns the mount namespace of the container
+// recorded for the provided profile.
+func (b *BpfRecorder) lookupMntnsForProfile(profile string) (uint32, error) {
 	// There is a chance to miss the PID if concurrent processes are being
 	// analyzed. If we request the `SyscallsForProfile` exactly between two
 	// events, while the first one is from a different recording container and
@@ -298,44 +803,22 @@ func (b *BpfRecorder) SyscallsForProfile(
 	if err := util.Retry(
 		func() error {
 			try++
-			b.logger.Info("Looking up mount namespace for profile", "try", try, "profile", r.Name)
+			b.logger.Info("Looking up mount namespace for profile", "try", try, "profile", profile)
 
-			if foundMntns, ok := b.getMntnsForProfile(r.Name); ok {
+			if foundMntns, ok := b.getMntnsForProfile(profile); ok {
 				mntns = foundMntns
-				b.logger.Info("Found mount namespace for profile", "mntns", mntns, "profile", r.Name)
-				b.deleteContainerIDFromCache(r.Name)
+				b.logger.Info("Found mount namespace for profile", "mntns", mntns, "profile", profile)
 				return nil
 			}
 
-			b.logger.Info("No mount namespace found for profile", "profile", r.Name)
+			b.logger.Info("No mount namespace found for profile", "profile", profile)
 			return ErrNotFound
 		},
 		func(error) bool { return true },
 	); err != nil {
-		return nil, ErrNotFound
-	}
-
-	b.loadUnloadMutex.RLock()
-	syscalls, err := b.GetValue(b.syscalls, mntns)
-	b.loadUnloadMutex.RUnlock()
-	if err != nil {
-		b.logger.Error(err, "No syscalls found for mntns", "mntns", mntns)
-		return nil, fmt.Errorf("no syscalls found for mntns: %d", mntns)
-	}
-	syscallNames := b.convertSyscallIDsToNames(syscalls)
-
-	// Cleanup the syscalls map from eBpf.
-	b.logger.Info("Cleaning up BPF syscalls hashmaps")
-	b.loadUnloadMutex.Lock()
-	if err := b.DeleteKey(b.syscalls, mntns); err != nil {
-		b.logger.Error(err, "Unable to cleanup syscalls map", "mntns", mntns)
+		return 0, ErrNotFound
 	}
-	b.loadUnloadMutex.Unlock()
-
-	return &api.SyscallsResponse{
-		Syscalls: sortUnique(syscallNames),
-		GoArch:   runtime.GOARCH,
-	}, nil
+	return mntns, nil
 }
 
 func (b *BpfRecorder) getMntnsForProfile(profile string) (uint32, bool) {
@@ -381,41 +864,77 @@ func sortUnique(input []string) (result []string) {
 	return result
 }
 
-// Load prestarts the bpf recorder.
-func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
+// loadModule loads the bpf object for the current architecture into a new
+// module without attaching any program. It returns the module together with
+// the path to the btf file used for it. The maps in mapSizes get resized
+// before loading. Processes in the hostMntns mount namespace are excluded
+// from recording.
+func (b *BpfRecorder) loadModule(
+	mapSizes map[string]uint32, hostMntns uint32,
+) (module *bpf.Module, btfPath string, err error) {
 	b.logger.Info("Loading bpf module")
-	b.btfPath, err = b.findBtfPath()
+	btfPath, err = b.findBtfPath()
 	if err != nil {
-		return fmt.Errorf("find btf: %w", err)
+		return nil, "", fmt.Errorf("find btf: %w", err)
 	}
 
 	bpfObject, ok := bpfObjects[b.GoArch()]
 	if !ok {
-		return fmt.Errorf("architecture %s is currently unsupported", runtime.GOARCH)
+		return nil, "", fmt.Errorf("architecture %s is currently unsupported", runtime.GOARCH)
 	}
 
-	module, err := b.NewModuleFromBufferArgs(&bpf.NewModuleArgs{
+	module, err = b.NewModuleFromBufferArgs(&bpf.NewModuleArgs{
 		BPFObjBuff: bpfObject,
 		BPFObjName: "recorder.bpf.o",
-		BTFObjPath: b.btfPath,
+		BTFObjPath: btfPath,
 	})
 	if err != nil {
-		return fmt.Errorf("load bpf module: %w", err)
+		return nil, "", fmt.Errorf("load bpf module: %w", err)
+	}
+
+	if err := b.InitGlobalVariable(module, "host_mntns", hostMntns); err != nil {
+		return nil, "", fmt.Errorf("init global variable: %w", err)
+	}
+
+	if b.recordingKey == RecordingKeyCgroupID {
+		if _, err := b.Stat(cgroupV2ControllersPath); err != nil {
+			return nil, "", fmt.Errorf("cgroup ID recording key requires cgroup v2: %w", err)
+		}
+		if err := b.InitGlobalVariable(module, "use_cgroup_id", uint8(1)); err != nil {
+			return nil, "", fmt.Errorf("init global variable: %w", err)
+		}
 	}
 
 	if b.programNameFilter != "" {
 		if err := b.InitGlobalVariable(
 			module, "filter_name", []byte(b.programNameFilter),
 		); err != nil {
-			return fmt.Errorf("init global variable: %w", err)
+			return nil, "", fmt.Errorf("init global variable: %w", err)
+		}
+	}
+
+	for name, maxEntries := range mapSizes {
+		if err := b.ResizeMap(module, name, maxEntries); err != nil {
+			return nil, "", fmt.Errorf("resize map %s: %w", name, err)
 		}
 	}
 
 	b.logger.Info("Loading bpf object from module")
 	if err := b.BPFLoadObject(module); err != nil {
-		return fmt.Errorf("load bpf object: %w", err)
+		return nil, "", fmt.Errorf("load bpf object: %w", err)
 	}
 
+	return module, btfPath, nil
+}
+
+// Load prestarts the bpf recorder.
+func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
+	module, btfPath, err := b.loadModule(nil, b.systemMountNamespace)
+	if err != nil {
+		return err
+	}
+	b.btfPath = btfPath
+
 	const programName = "sys_enter"
 	b.logger.Info("Getting bpf program " + programName)
 	program, err := b.GetProgram(module, programName)
@@ -428,6 +947,18 @@ func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
 		return fmt.Errorf("attach tracepoint: %w", err)
 	}
 
+	const capProgramName = "cap_capable"
+	b.logger.Info("Getting bpf program " + capProgramName)
+	capProgram, err := b.GetProgram(module, capProgramName)
+	if err != nil {
+		return fmt.Errorf("get %s program: %w", capProgramName, err)
+	}
+
+	b.logger.Info("Attaching bpf kprobe")
+	if _, err := b.AttachKprobe(capProgram, capProgramName); err != nil {
+		return fmt.Errorf("attach kprobe: %w", err)
+	}
+
 	b.logger.Info("Getting syscalls map")
 	syscalls, err := b.GetMap(module, "mntns_syscalls")
 	if err != nil {
@@ -440,11 +971,35 @@ func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
 		return fmt.Errorf("get pid_mntns: %w", err)
 	}
 
+	b.logger.Info("Getting program name syscalls map")
+	commSyscalls, err := b.GetMap(module, "mntns_comm_syscalls")
+	if err != nil {
+		return fmt.Errorf("get program name syscalls map: %w", err)
+	}
+
+	b.logger.Info("Getting capabilities map")
+	capabilities, err := b.GetMap(module, "mntns_capabilities")
+	if err != nil {
+		return fmt.Errorf("get capabilities map: %w", err)
+	}
+
+	b.logger.Info("Getting syscall times map")
+	syscallTimes, err := b.GetMap(module, "mntns_syscall_times")
+	if err != nil {
+		return fmt.Errorf("get syscall times map: %w", err)
+	}
+
 	b.syscalls = syscalls
+	b.syscallTimes = syscallTimes
 	b.mntns = mntns
+	b.capabilities = capabilities
+	b.commSyscalls = commSyscalls
 
-	// Update the host mntns into pid_mntns map
-	b.updateSystemMntns()
+	if len(b.syscallArgIndexes) > 0 {
+		if err := b.loadSyscallArgs(module); err != nil {
+			return fmt.Errorf("load syscall arguments: %w", err)
+		}
+	}
 
 	events := make(chan []byte)
 	ringbuffer, err := b.InitRingBuf(module, "events", events)
@@ -453,14 +1008,61 @@ func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
 	}
 	b.StartRingBuffer(ringbuffer)
 
+	syscallEvents := make(chan []byte)
+	syscallRingbuffer, err := b.InitRingBuf(module, "syscall_events", syscallEvents)
+	if err != nil {
+		return fmt.Errorf("init syscall events ringbuffer: %w", err)
+	}
+	b.StartRingBuffer(syscallRingbuffer)
+
 	if startEventProcessor {
 		go b.processEvents(events)
+		go b.processSyscallEvents(syscallEvents)
 	}
 
 	b.logger.Info("Module successfully loaded")
 	return nil
 }
 
+func (b *BpfRecorder) loadSyscallArgs(module *bpf.Module) error {
+	b.logger.Info("Getting syscall_arg_index map")
+	argIndex, err := b.GetMap(module, "syscall_arg_index")
+	if err != nil {
+		return fmt.Errorf("get syscall_arg_index map: %w", err)
+	}
+
+	for name, index := range b.syscallArgIndexes {
+		id, err := b.GetSyscallFromName(name)
+		if err != nil {
+			return fmt.Errorf("get syscall ID for %s: %w", name, err)
+		}
+		if id < 0 || int32(id) >= maxSyscalls {
+			b.logger.Info("Syscall not available on this architecture, skipping argument recording",
+				"syscall", name)
+			continue
+		}
+
+		b.logger.Info("Recording syscall argument", "syscall", name, "index", index)
+		if err := b.UpdateValue(argIndex, uint32(id), []byte{byte(index + 1)}); err != nil {
+			return fmt.Errorf("update syscall_arg_index map: %w", err)
+		}
+	}
+
+	b.logger.Info("Getting mntns_syscall_args map")
+	b.syscallArgs, err = b.GetMap(module, "mntns_syscall_args")
+	if err != nil {
+		return fmt.Errorf("get mntns_syscall_args map: %w", err)
+	}
+
+	b.logger.Info("Getting mntns_syscall_args_overflow map")
+	b.syscallArgsOverflow, err = b.GetMap(module, "mntns_syscall_args_overflow")
+	if err != nil {
+		return fmt.Errorf("get mntns_syscall_args_overflow map: %w", err)
+	}
+
+	return nil
+}
+
 func (b *BpfRecorder) findBtfPath() (string, error) {
 	// Use the system btf if possible
 	if _, err := b.Stat("/sys/kernel/btf/vmlinux"); err == nil {
@@ -584,15 +1186,6 @@ func (b *BpfRecorder) processEvents(events chan []byte) {
 	}
 }
 
-func (b *BpfRecorder) updateSystemMntns() {
-	mntnsByte := make([]byte, defaultByteNum)
-	binary.LittleEndian.PutUint32(mntnsByte, b.systemMountNamespace)
-	err := b.UpdateValue(b.mntns, defaultHostPid, mntnsByte)
-	if err != nil {
-		b.logger.Error(err, "update system_mntns map failed")
-	}
-}
-
 func (b *BpfRecorder) handleEvent(event []byte) {
 	e := struct {
 		Pid   uint32
@@ -609,8 +1202,7 @@ func (b *BpfRecorder) handleEvent(event []byte) {
 	pid := e.Pid
 	mntns := e.Mntns
 
-	// Look up the container ID based on PID from cgroup file.
-	containerID, err := b.ContainerIDForPID(b.pidToContainerIDCache, int(pid))
+	containerID, err := b.containerIDForEvent(pid, event)
 	if err != nil {
 		b.logger.V(config.VerboseLevel).Info(
 			"No container ID found for PID",
@@ -639,6 +1231,17 @@ func (b *BpfRecorder) handleEvent(event []byte) {
 	b.trackProfileMetric(mntns, profile)
 }
 
+// containerIDForEvent resolves the container ID of the event. If the recording
+// is keyed by cgroup ID, then the event contains the cgroup name which
+// already includes the container ID. Otherwise the container ID is looked up
+// based on PID from the cgroup file.
+func (b *BpfRecorder) containerIDForEvent(pid uint32, event []byte) (string, error) {
+	if b.recordingKey == RecordingKeyCgroupID && len(event) > eventHeaderSize {
+		return util.ContainerIDForCgroupPath(toStringByte(event[eventHeaderSize:]))
+	}
+	return b.ContainerIDForPID(b.pidToContainerIDCache, int(pid))
+}
+
 // FindProcMountNamespace is looking up the mnt ns for a given PID.
 func (b *BpfRecorder) FindProcMountNamespace(pid uint32) (uint32, error) {
 	// This requires the container to run with host PID, otherwise we will get
@@ -785,6 +1388,11 @@ func (b *BpfRecorder) Unload() {
 	b.loadUnloadMutex.Lock()
 	b.CloseModule(b.syscalls)
 	b.syscalls = nil
+	b.syscallTimes = nil
+	b.capabilities = nil
+	b.commSyscalls = nil
+	b.syscallArgs = nil
+	b.syscallArgsOverflow = nil
 	os.RemoveAll(b.btfPath)
 	b.loadUnloadMutex.Unlock()
 }
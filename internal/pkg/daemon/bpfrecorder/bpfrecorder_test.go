@@ -20,6 +20,7 @@ limitations under the License.
 package bpfrecorder
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/json"
@@ -30,7 +31,9 @@ import (
 	"testing"
 	"time"
 
+	bpf "github.com/aquasecurity/libbpfgo"
 	"github.com/go-logr/logr"
+	seccomp "github.com/seccomp/libseccomp-golang"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
@@ -229,6 +232,26 @@ func TestRun(t *testing.T) {
 				require.NotNil(t, err)
 			},
 		},
+		{ // load:GetProgram fails for cap_capable
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetenvReturns(node)
+				mock.GoArchReturns(validGoArch)
+				mock.GetProgramReturnsOnCall(1, nil, errTest)
+			},
+			assert: func(err error) {
+				require.NotNil(t, err)
+			},
+		},
+		{ // load:AttachKprobe fails
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetenvReturns(node)
+				mock.GoArchReturns(validGoArch)
+				mock.AttachKprobeReturns(nil, errTest)
+			},
+			assert: func(err error) {
+				require.NotNil(t, err)
+			},
+		},
 		{ // load:AttachTracepoint fails
 			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
 				mock.GetenvReturns(node)
@@ -259,6 +282,36 @@ func TestRun(t *testing.T) {
 				require.NotNil(t, err)
 			},
 		},
+		{ // load:GetMap fails on third call
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetenvReturns(node)
+				mock.GoArchReturns(validGoArch)
+				mock.GetMapReturnsOnCall(2, nil, errTest)
+			},
+			assert: func(err error) {
+				require.NotNil(t, err)
+			},
+		},
+		{ // load:GetMap fails on fourth call
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetenvReturns(node)
+				mock.GoArchReturns(validGoArch)
+				mock.GetMapReturnsOnCall(3, nil, errTest)
+			},
+			assert: func(err error) {
+				require.NotNil(t, err)
+			},
+		},
+		{ // load:GetMap fails on fifth call
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetenvReturns(node)
+				mock.GoArchReturns(validGoArch)
+				mock.GetMapReturnsOnCall(4, nil, errTest)
+			},
+			assert: func(err error) {
+				require.NotNil(t, err)
+			},
+		},
 		{ // load:InitRingBuf fails
 			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
 				mock.GetenvReturns(node)
@@ -525,6 +578,119 @@ func TestStop(t *testing.T) {
 	}
 }
 
+func TestSessions(t *testing.T) {
+	t.Parallel()
+
+	commKey := func(mntns uint32, comm string) []byte {
+		key := make([]byte, 20)
+		binary.LittleEndian.PutUint32(key, mntns)
+		copy(key[4:], comm)
+		return key
+	}
+	timesKey := func(mntns, syscallID uint32) []byte {
+		key := make([]byte, 8)
+		binary.LittleEndian.PutUint64(key, mntnsSyscallKey(mntns, syscallID))
+		return key
+	}
+
+	for _, tc := range []struct {
+		prepare func(*BpfRecorder, *bpfrecorderfakes.FakeImpl)
+		assert  func(*BpfRecorder, *bpfrecorderfakes.FakeImpl)
+	}{
+		{ // overlapping sessions keep the module loaded
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				_, err := sut.StartSession(context.Background(), &api.SessionRequest{
+					Name: "other", Profiles: []string{"other-profile"},
+				})
+				require.Nil(t, err)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				require.EqualValues(t, 1, sut.startRequests)
+				require.Contains(t, sut.sessions, "other")
+				require.Zero(t, mock.CloseModuleCallCount())
+			},
+		},
+		{ // last session unloads the module
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				require.EqualValues(t, 0, sut.startRequests)
+				require.Empty(t, sut.sessions)
+				require.Equal(t, 1, mock.CloseModuleCallCount())
+			},
+		},
+		{ // restarting a session does not increase the references
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				_, err := sut.StartSession(context.Background(), &api.SessionRequest{
+					Name: "session", Profiles: []string{"another-profile"},
+				})
+				require.Nil(t, err)
+				require.EqualValues(t, 1, sut.startRequests)
+				require.True(t, sut.sessions["session"].Has("another-profile"))
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				require.EqualValues(t, 0, sut.startRequests)
+			},
+		},
+		{ // stopping a session cleans up its data only
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				sut.containerIDToProfileMap.Insert("other-id", "other-profile")
+				sut.mntnsToContainerIDMap.Insert(mntns+1, "other-id")
+				mock.MapKeysReturnsOnCall(0, [][]byte{
+					timesKey(mntns, 1), timesKey(mntns+1, 1), timesKey(mntns, 2),
+				}, nil)
+				mock.MapKeysReturnsOnCall(1, [][]byte{
+					commKey(mntns, "sh"), commKey(mntns+1, "sh"),
+				}, nil)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				require.Equal(t, 2, mock.DeleteKeyCallCount())
+				_, key := mock.DeleteKeyArgsForCall(0)
+				require.Equal(t, mntns, key)
+				require.Equal(t, 3, mock.DeleteKeyBytesCallCount())
+				_, found := sut.getMntnsForProfile(profile)
+				require.False(t, found)
+				_, found = sut.getMntnsForProfile("other-profile")
+				require.True(t, found)
+			},
+		},
+	} {
+		mock := &bpfrecorderfakes.FakeImpl{}
+		mock.GoArchReturns(validGoArch)
+
+		sut := New(logr.Discard())
+		sut.impl = mock
+
+		_, err := sut.StartSession(context.Background(), &api.SessionRequest{
+			Name: "session", Profiles: []string{profile},
+		})
+		require.Nil(t, err)
+		require.EqualValues(t, 1, sut.startRequests)
+		tc.prepare(sut, mock)
+
+		_, err = sut.StopSession(context.Background(), &api.SessionRequest{Name: "session"})
+		require.Nil(t, err)
+		require.NotContains(t, sut.sessions, "session")
+		tc.assert(sut, mock)
+	}
+}
+
+func TestStartSessionInvalid(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &bpfrecorderfakes.FakeImpl{}
+
+	_, err := sut.StartSession(context.Background(), &api.SessionRequest{})
+	require.ErrorIs(t, err, ErrInvalidSession)
+	require.EqualValues(t, 0, sut.startRequests)
+
+	// stopping an unknown session is a no-op
+	_, err = sut.StopSession(context.Background(), &api.SessionRequest{Name: "unknown"})
+	require.Nil(t, err)
+}
+
 func TestSyscallsForProfile(t *testing.T) {
 	t.Parallel()
 
@@ -641,6 +807,613 @@ func TestSyscallsForProfile(t *testing.T) {
 	}
 }
 
+func TestSyscallsForProfileSnapshot(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	mock := &bpfrecorderfakes.FakeImpl{}
+	sut.impl = mock
+
+	mock.GoArchReturns(validGoArch)
+	_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+	require.Nil(t, err)
+	sut.containerIDToProfileMap.Insert(containerID, profile)
+	sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+	mock.GetValueReturns([]byte{1}, nil)
+	mock.GetNameReturns("syscall_a", nil)
+
+	resp, err := sut.SyscallsForProfile(
+		context.Background(), &api.ProfileRequest{Name: profile, Snapshot: true},
+	)
+	require.Nil(t, err)
+	require.Equal(t, []string{"syscall_a"}, resp.Syscalls)
+
+	// the recording continues
+	require.Zero(t, mock.DeleteKeyCallCount())
+	_, found := sut.getMntnsForProfile(profile)
+	require.True(t, found)
+}
+
+func TestCapabilitiesForProfile(t *testing.T) {
+	t.Parallel()
+
+	capabilitiesValue := func(caps ...uint) []byte {
+		var mask uint64
+		for _, c := range caps {
+			mask |= 1 << c
+		}
+		value := make([]byte, capabilitiesValueSize)
+		binary.LittleEndian.PutUint64(value, mask)
+		return value
+	}
+
+	for _, tc := range []struct {
+		snapshot bool
+		prepare  func(*BpfRecorder, *bpfrecorderfakes.FakeImpl)
+		assert   func(*BpfRecorder, *bpfrecorderfakes.FakeImpl, *api.CapabilitiesResponse, error)
+	}{
+		{ // Success
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetValueReturns(capabilitiesValue(21, 10, 0, 63), nil)
+			},
+			assert: func(
+				sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, resp *api.CapabilitiesResponse, err error,
+			) {
+				require.Nil(t, err)
+				require.Equal(t, []string{"CHOWN", "NET_BIND_SERVICE", "SYS_ADMIN"}, resp.Capabilities)
+				require.Equal(t, 1, mock.DeleteKeyCallCount())
+				// the recording of the container continues with the syscalls
+				_, found := sut.getMntnsForProfile(profile)
+				require.True(t, found)
+			},
+		},
+		{ // Success snapshot
+			snapshot: true,
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetValueReturns(capabilitiesValue(13), nil)
+			},
+			assert: func(
+				sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, resp *api.CapabilitiesResponse, err error,
+			) {
+				require.Nil(t, err)
+				require.Equal(t, []string{"NET_RAW"}, resp.Capabilities)
+				require.Zero(t, mock.DeleteKeyCallCount())
+			},
+		},
+		{ // Success no capabilities recorded
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetValueReturns(nil, errTest)
+			},
+			assert: func(
+				sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, resp *api.CapabilitiesResponse, err error,
+			) {
+				require.Nil(t, err)
+				require.Empty(t, resp.Capabilities)
+			},
+		},
+		{ // invalid value size
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetValueReturns([]byte{1}, nil)
+			},
+			assert: func(
+				sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, resp *api.CapabilitiesResponse, err error,
+			) {
+				require.NotNil(t, err)
+			},
+		},
+		{ // no mntns for profile
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				sut.containerIDToProfileMap.Delete(containerID)
+			},
+			assert: func(
+				sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, resp *api.CapabilitiesResponse, err error,
+			) {
+				require.ErrorIs(t, err, ErrNotFound)
+			},
+		},
+		{ // recorder not running
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				sut.startRequests = 0
+			},
+			assert: func(
+				sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, resp *api.CapabilitiesResponse, err error,
+			) {
+				require.NotNil(t, err)
+			},
+		},
+	} {
+		sut := New(logr.Discard())
+		mock := &bpfrecorderfakes.FakeImpl{}
+		sut.impl = mock
+
+		mock.GoArchReturns(validGoArch)
+		_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+		require.Nil(t, err)
+		sut.containerIDToProfileMap.Insert(containerID, profile)
+		sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+		tc.prepare(sut, mock)
+
+		resp, err := sut.CapabilitiesForProfile(
+			context.Background(), &api.ProfileRequest{Name: profile, Snapshot: tc.snapshot},
+		)
+		tc.assert(sut, mock, resp, err)
+	}
+}
+
+func TestSyscallsForProfileExecutables(t *testing.T) {
+	t.Parallel()
+
+	commKey := func(mntns uint32, comm string) []byte {
+		key := make([]byte, 20)
+		binary.LittleEndian.PutUint32(key, mntns)
+		copy(key[4:], comm)
+		return key
+	}
+
+	for _, tc := range []struct {
+		snapshot bool
+		prepare  func(*bpfrecorderfakes.FakeImpl)
+		assert   func(*bpfrecorderfakes.FakeImpl, *api.SyscallsResponse)
+	}{
+		{ // Success
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.MapKeysReturns([][]byte{
+					commKey(mntns, "sh"),
+					commKey(mntns+1, "other"),
+					commKey(mntns, "debug"),
+				}, nil)
+				mock.GetValueBytesReturnsOnCall(0, nil, errTest)
+				mock.GetValueBytesReturnsOnCall(1, []byte{0, 1, 1}, nil)
+				mock.GetValueBytesReturnsOnCall(2, []byte{0, 0, 1}, nil)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Len(t, resp.Executables, 2)
+				require.Equal(t, "debug", resp.Executables[0].Executable)
+				require.Equal(t, []string{"syscall_b"}, resp.Executables[0].Syscalls)
+				require.Equal(t, "sh", resp.Executables[1].Executable)
+				require.Equal(t, []string{"syscall_a", "syscall_b"}, resp.Executables[1].Syscalls)
+				require.Equal(t, 2, mock.DeleteKeyBytesCallCount())
+			},
+		},
+		{ // Success snapshot
+			snapshot: true,
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.MapKeysReturns([][]byte{commKey(mntns, "sh")}, nil)
+				mock.GetValueBytesReturns([]byte{0, 1}, nil)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Len(t, resp.Executables, 1)
+				require.Zero(t, mock.DeleteKeyBytesCallCount())
+			},
+		},
+		{ // GetValueBytes fails
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.MapKeysReturns([][]byte{commKey(mntns, "sh")}, nil)
+				mock.GetValueBytesReturns(nil, errTest)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Empty(t, resp.Executables)
+				require.Len(t, resp.Syscalls, 1)
+			},
+		},
+		{ // MapKeys fails
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.MapKeysReturns(nil, errTest)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Empty(t, resp.Executables)
+				require.Len(t, resp.Syscalls, 1)
+			},
+		},
+	} {
+		sut := New(logr.Discard())
+		mock := &bpfrecorderfakes.FakeImpl{}
+		sut.impl = mock
+
+		mock.GoArchReturns(validGoArch)
+		_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+		require.Nil(t, err)
+		sut.containerIDToProfileMap.Insert(containerID, profile)
+		sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+		mock.GetValueReturns([]byte{0, 1}, nil)
+		mock.GetNameCalls(func(id seccomp.ScmpSyscall) (string, error) {
+			return []string{"syscall_0", "syscall_a", "syscall_b"}[id], nil
+		})
+		tc.prepare(mock)
+
+		resp, err := sut.SyscallsForProfile(
+			context.Background(), &api.ProfileRequest{Name: profile, Snapshot: tc.snapshot},
+		)
+		require.Nil(t, err)
+		tc.assert(mock, resp)
+	}
+}
+
+func TestSyscallsForProfileStatistics(t *testing.T) {
+	t.Parallel()
+
+	syscallTimesValue := func(firstSeen, lastSeen time.Duration, count uint64) []byte {
+		value := make([]byte, 24)
+		binary.LittleEndian.PutUint64(value, uint64(firstSeen))
+		binary.LittleEndian.PutUint64(value[8:], uint64(lastSeen))
+		binary.LittleEndian.PutUint64(value[16:], count)
+		return value
+	}
+	bootTime := time.Unix(1000, 0)
+
+	for _, tc := range []struct {
+		snapshot bool
+		prepare  func(*bpfrecorderfakes.FakeImpl)
+		assert   func(*bpfrecorderfakes.FakeImpl, *api.SyscallsResponse)
+	}{
+		{ // Success
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetValueBytesCalls(func(_ *bpf.BPFMap, key []byte) ([]byte, error) {
+					if key[0] == 1 { // syscall_a
+						return syscallTimesValue(time.Second, time.Minute, 3), nil
+					}
+					return syscallTimesValue(time.Second, time.Second, 1), nil
+				})
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Equal(t, []string{"syscall_a", "syscall_b"}, resp.Syscalls)
+				require.Len(t, resp.Statistics, 2)
+				require.Equal(t, "syscall_a", resp.Statistics[0].Name)
+				require.EqualValues(t, 3, resp.Statistics[0].Count)
+				require.Equal(t, bootTime.Add(time.Second).UnixNano(), resp.Statistics[0].FirstSeen)
+				require.Equal(t, bootTime.Add(time.Minute).UnixNano(), resp.Statistics[0].LastSeen)
+				require.Equal(t, "syscall_b", resp.Statistics[1].Name)
+				require.EqualValues(t, 1, resp.Statistics[1].Count)
+				require.Equal(t, 2, mock.DeleteKeyBytesCallCount())
+			},
+		},
+		{ // Success snapshot
+			snapshot: true,
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetValueBytesReturns(syscallTimesValue(time.Second, time.Minute, 3), nil)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Len(t, resp.Statistics, 2)
+				require.NotZero(t, resp.Statistics[0].FirstSeen)
+				require.Zero(t, mock.DeleteKeyBytesCallCount())
+			},
+		},
+		{ // BootTime fails
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.BootTimeReturns(time.Time{}, errTest)
+				mock.GetValueBytesReturns(syscallTimesValue(time.Second, time.Minute, 3), nil)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Len(t, resp.Statistics, 2)
+				require.EqualValues(t, 3, resp.Statistics[0].Count)
+				require.Zero(t, resp.Statistics[0].FirstSeen)
+				require.Zero(t, resp.Statistics[0].LastSeen)
+			},
+		},
+		{ // GetValueBytes fails
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetValueBytesReturns(nil, errTest)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Len(t, resp.Statistics, 2)
+				require.EqualValues(t, 1, resp.Statistics[0].Count)
+				require.Zero(t, resp.Statistics[0].FirstSeen)
+				require.Zero(t, mock.DeleteKeyBytesCallCount())
+			},
+		},
+	} {
+		sut := New(logr.Discard())
+		mock := &bpfrecorderfakes.FakeImpl{}
+		sut.impl = mock
+
+		mock.GoArchReturns(validGoArch)
+		_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+		require.Nil(t, err)
+		sut.containerIDToProfileMap.Insert(containerID, profile)
+		sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+		mock.GetValueReturns([]byte{0, 1, 1}, nil)
+		mock.GetNameCalls(func(id seccomp.ScmpSyscall) (string, error) {
+			return []string{"syscall_0", "syscall_a", "syscall_b"}[id], nil
+		})
+		mock.BootTimeReturns(bootTime, nil)
+		mock.MapKeysReturns(nil, nil)
+		tc.prepare(mock)
+
+		resp, err := sut.SyscallsForProfile(
+			context.Background(), &api.ProfileRequest{Name: profile, Snapshot: tc.snapshot},
+		)
+		require.Nil(t, err)
+		tc.assert(mock, resp)
+	}
+}
+
+func syscallArgKeyBytes(t *testing.T, key syscallArgKey) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	require.Nil(t, binary.Write(buf, binary.LittleEndian, key))
+	return buf.Bytes()
+}
+
+func TestRecordSyscallArgs(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		args     []string
+		expected map[string]uint
+		valid    bool
+	}{
+		{ // Success
+			args:     []string{"clone:0", "ioctl:1"},
+			expected: map[string]uint{"clone": 0, "ioctl": 1},
+			valid:    true,
+		},
+		{ // Success no args
+			args:     nil,
+			expected: map[string]uint{},
+			valid:    true,
+		},
+		{ // missing index
+			args: []string{"clone"},
+		},
+		{ // missing name
+			args: []string{":0"},
+		},
+		{ // index out of range
+			args: []string{"clone:6"},
+		},
+		{ // index not a number
+			args: []string{"clone:first"},
+		},
+	} {
+		sut := New(logr.Discard())
+		err := sut.RecordSyscallArgs(tc.args)
+		if tc.valid {
+			require.Nil(t, err)
+			require.Equal(t, tc.expected, sut.syscallArgIndexes)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidSyscallArg)
+		}
+	}
+}
+
+func TestLoadSyscallArgs(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		prepare func(*bpfrecorderfakes.FakeImpl)
+		assert  func(*BpfRecorder, *bpfrecorderfakes.FakeImpl, error)
+	}{
+		{ // Success
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetSyscallFromNameReturns(56, nil)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.Nil(t, err)
+				require.Equal(t, 1, mock.UpdateValueCallCount())
+				_, id, value := mock.UpdateValueArgsForCall(0)
+				require.EqualValues(t, 56, id)
+				require.Equal(t, []byte{2}, value)
+			},
+		},
+		{ // Success syscall not available on architecture
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetSyscallFromNameReturns(-10, nil)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.Nil(t, err)
+				require.Zero(t, mock.UpdateValueCallCount())
+			},
+		},
+		{ // GetSyscallFromName fails
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetSyscallFromNameReturns(0, errTest)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.NotNil(t, err)
+			},
+		},
+		{ // UpdateValue fails
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.UpdateValueReturns(errTest)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.NotNil(t, err)
+			},
+		},
+		{ // GetMap fails for syscall_arg_index
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetMapReturnsOnCall(5, nil, errTest)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.NotNil(t, err)
+			},
+		},
+		{ // GetMap fails for mntns_syscall_args
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetMapReturnsOnCall(6, nil, errTest)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.NotNil(t, err)
+			},
+		},
+		{ // GetMap fails for mntns_syscall_args_overflow
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetMapReturnsOnCall(7, nil, errTest)
+			},
+			assert: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.NotNil(t, err)
+			},
+		},
+	} {
+		mock := &bpfrecorderfakes.FakeImpl{}
+		mock.GoArchReturns(validGoArch)
+		tc.prepare(mock)
+
+		sut := New(logr.Discard())
+		sut.impl = mock
+		require.Nil(t, sut.RecordSyscallArgs([]string{"clone:1"}))
+
+		err := sut.Load(false)
+		tc.assert(sut, mock, err)
+	}
+}
+
+func TestUseRecordingKey(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		key     string
+		prepare func(*bpfrecorderfakes.FakeImpl)
+		assert  func(*bpfrecorderfakes.FakeImpl, error)
+	}{
+		{ // Success mount namespace
+			key:     RecordingKeyMountNamespace,
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.Nil(t, err)
+				require.Equal(t, 1, mock.InitGlobalVariableCallCount())
+				_, name, value := mock.InitGlobalVariableArgsForCall(0)
+				require.Equal(t, "host_mntns", name)
+				require.Equal(t, mntns, value)
+				require.Zero(t, mock.UpdateValueCallCount())
+			},
+		},
+		{ // Success cgroup ID
+			key:     RecordingKeyCgroupID,
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.Nil(t, err)
+				require.Equal(t, 2, mock.InitGlobalVariableCallCount())
+				// the host mount namespace is excluded in cgroup mode as well
+				_, name, value := mock.InitGlobalVariableArgsForCall(0)
+				require.Equal(t, "host_mntns", name)
+				require.Equal(t, mntns, value)
+				_, name, value = mock.InitGlobalVariableArgsForCall(1)
+				require.Equal(t, "use_cgroup_id", name)
+				require.Equal(t, uint8(1), value)
+				require.Zero(t, mock.UpdateValueCallCount())
+			},
+		},
+		{ // cgroup v2 not available
+			key: RecordingKeyCgroupID,
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.StatReturnsOnCall(1, nil, errTest)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		mock := &bpfrecorderfakes.FakeImpl{}
+		mock.GoArchReturns(validGoArch)
+		tc.prepare(mock)
+
+		sut := New(logr.Discard())
+		sut.impl = mock
+		sut.systemMountNamespace = mntns
+		require.Nil(t, sut.UseRecordingKey(tc.key))
+
+		err := sut.Load(false)
+		tc.assert(mock, err)
+	}
+
+	sut := New(logr.Discard())
+	require.ErrorIs(t, sut.UseRecordingKey("pid"), ErrInvalidRecordingKey)
+	require.Equal(t, RecordingKeyMountNamespace, sut.recordingKey)
+}
+
+func TestSyscallsForProfileWithArgs(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		snapshot bool
+		prepare  func(*testing.T, *bpfrecorderfakes.FakeImpl)
+		assert   func(*bpfrecorderfakes.FakeImpl, *api.SyscallsResponse)
+	}{
+		{ // Success
+			prepare: func(t *testing.T, mock *bpfrecorderfakes.FakeImpl) {
+				mock.MapKeysReturnsOnCall(0, [][]byte{
+					syscallArgKeyBytes(t, syscallArgKey{Mntns: mntns, SyscallID: 1, Value: 3}),
+					syscallArgKeyBytes(t, syscallArgKey{Mntns: mntns + 1, SyscallID: 1, Value: 5}),
+					syscallArgKeyBytes(t, syscallArgKey{Mntns: mntns, SyscallID: 1, Value: 2}),
+				}, nil)
+				mock.MapKeysReturnsOnCall(2, [][]byte{
+					syscallArgKeyBytes(t, syscallArgKey{Mntns: mntns, SyscallID: 1, Value: 3}),
+					syscallArgKeyBytes(t, syscallArgKey{Mntns: mntns + 1, SyscallID: 1, Value: 5}),
+					syscallArgKeyBytes(t, syscallArgKey{Mntns: mntns, SyscallID: 1, Value: 2}),
+				}, nil)
+				mock.GetValue64Returns(nil, errTest)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Len(t, resp.SyscallArgs, 1)
+				require.Equal(t, "clone", resp.SyscallArgs[0].Name)
+				require.EqualValues(t, 0, resp.SyscallArgs[0].Index)
+				require.Equal(t, []uint64{2, 3}, resp.SyscallArgs[0].Values)
+				require.Equal(t, 2, mock.DeleteKeyBytesCallCount())
+			},
+		},
+		{ // Success snapshot keeps arguments
+			snapshot: true,
+			prepare: func(t *testing.T, mock *bpfrecorderfakes.FakeImpl) {
+				mock.MapKeysReturns([][]byte{
+					syscallArgKeyBytes(t, syscallArgKey{Mntns: mntns, SyscallID: 1, Value: 3}),
+				}, nil)
+				mock.GetValue64Returns(nil, errTest)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Len(t, resp.SyscallArgs, 1)
+				require.Zero(t, mock.DeleteKeyBytesCallCount())
+			},
+		},
+		{ // overflowed syscall is allowed unconditionally
+			prepare: func(t *testing.T, mock *bpfrecorderfakes.FakeImpl) {
+				mock.MapKeysReturnsOnCall(0, [][]byte{
+					syscallArgKeyBytes(t, syscallArgKey{Mntns: mntns, SyscallID: 1, Value: 3}),
+				}, nil)
+				overflowKey := make([]byte, 8)
+				binary.LittleEndian.PutUint64(overflowKey, mntnsSyscallKey(mntns, 1))
+				mock.MapKeysReturnsOnCall(3, [][]byte{overflowKey}, nil)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Empty(t, resp.SyscallArgs)
+				require.Equal(t, 1, mock.DeleteKeyBytesCallCount())
+			},
+		},
+		{ // MapKeys fails
+			prepare: func(t *testing.T, mock *bpfrecorderfakes.FakeImpl) {
+				mock.MapKeysReturns(nil, errTest)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse) {
+				require.Empty(t, resp.SyscallArgs)
+				require.Equal(t, []string{"clone"}, resp.Syscalls)
+			},
+		},
+	} {
+		sut := New(logr.Discard())
+		mock := &bpfrecorderfakes.FakeImpl{}
+		sut.impl = mock
+
+		mock.GoArchReturns(validGoArch)
+		_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+		require.Nil(t, err)
+		require.Nil(t, sut.RecordSyscallArgs([]string{"clone:0"}))
+		sut.syscallArgs = &bpf.BPFMap{}
+		sut.syscallArgsOverflow = &bpf.BPFMap{}
+		sut.containerIDToProfileMap.Insert(containerID, profile)
+		sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+		mock.GetValueReturns([]byte{0, 1}, nil)
+		mock.GetValueBytesReturns(nil, errTest)
+		mock.GetNameReturns("clone", nil)
+		tc.prepare(t, mock)
+
+		resp, err := sut.SyscallsForProfile(
+			context.Background(), &api.ProfileRequest{Name: profile, Snapshot: tc.snapshot},
+		)
+		require.Nil(t, err)
+		tc.assert(mock, resp)
+	}
+}
+
 type Logger struct {
 	messages []string
 	mutex    sync.RWMutex
@@ -708,6 +1481,46 @@ func TestProcessEvents(t *testing.T) {
 				require.Equal(t, mntns, foundMntns)
 			},
 		},
+		{ // Success cgroup ID
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) []byte {
+				require.Nil(t, sut.UseRecordingKey(RecordingKeyCgroupID))
+				mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pod,
+						Namespace: namespace,
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey + "ctr": "profile.json",
+						},
+					},
+					Status: v1.PodStatus{
+						ContainerStatuses: []v1.ContainerStatus{{
+							ContainerID: crioPrefix + containerID,
+							Name:        "ctr",
+						}},
+					},
+				}}}, nil)
+				event := []byte{
+					1, 0, 0, 0,
+					2, 0, 1, 0,
+				}
+				event = append(event, []byte("crio-"+containerID+".scope")...)
+				return append(event, make([]byte, 8)...)
+			},
+			assert: func(sut *BpfRecorder, logger *Logger) {
+				key := binary.LittleEndian.Uint32([]byte{2, 0, 1, 0})
+				var foundKey uint32
+				for i := 0; i < 100; i++ {
+					if id, ok := sut.containerIDToProfileMap.GetBackwards("profile.json"); ok {
+						if actualKey, ok := sut.mntnsToContainerIDMap.GetBackwards(id); ok {
+							foundKey = actualKey
+							break
+						}
+					}
+					time.Sleep(100 * time.Millisecond)
+				}
+				require.Equal(t, key, foundKey)
+			},
+		},
 		{ // invalid event length
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) []byte {
 				return []byte{1, 0, 0}
@@ -788,3 +1601,200 @@ func TestProcessEvents(t *testing.T) {
 		close(ch)
 	}
 }
+
+type fakeWatchSyscallsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *api.SyscallEvent
+}
+
+func (f *fakeWatchSyscallsServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchSyscallsServer) Send(event *api.SyscallEvent) error {
+	f.events <- event
+	return nil
+}
+
+func TestWatchSyscalls(t *testing.T) {
+	t.Parallel()
+
+	mock := &bpfrecorderfakes.FakeImpl{}
+	mock.GetNameReturns("read", nil)
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+
+	// not running
+	err := sut.WatchSyscalls(&api.WatchRequest{}, &fakeWatchSyscallsServer{})
+	require.NotNil(t, err)
+
+	sut.startRequests = 1
+	sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+	sut.containerIDToProfileMap.Insert(containerID, profile)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	all := &fakeWatchSyscallsServer{ctx: ctx, events: make(chan *api.SyscallEvent, 1)}
+	other := &fakeWatchSyscallsServer{ctx: ctx, events: make(chan *api.SyscallEvent, 1)}
+
+	var wg sync.WaitGroup
+	for server, request := range map[*fakeWatchSyscallsServer]*api.WatchRequest{
+		all:   {},
+		other: {Profile: "other"},
+	} {
+		server, request := server, request
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Nil(t, sut.WatchSyscalls(request, server))
+		}()
+	}
+	require.Eventually(t, func() bool {
+		sut.watchersMutex.RLock()
+		defer sut.watchersMutex.RUnlock()
+		return len(sut.watchers) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ch := make(chan []byte)
+	go sut.processSyscallEvents(ch)
+	event := make([]byte, 8)
+	binary.LittleEndian.PutUint32(event, mntns)
+	binary.LittleEndian.PutUint32(event[4:], 0)
+	ch <- event
+
+	select {
+	case received := <-all.events:
+		require.Equal(t, profile, received.Profile)
+		require.Equal(t, containerID, received.ContainerId)
+		require.Equal(t, "read", received.Syscall)
+		require.NotZero(t, received.Timestamp)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no syscall event received")
+	}
+	require.Empty(t, other.events)
+
+	cancel()
+	wg.Wait()
+	close(ch)
+	require.Empty(t, sut.watchers)
+}
+
+func TestParseViolation(t *testing.T) {
+	t.Parallel()
+
+	event := make([]byte, 40)
+	binary.LittleEndian.PutUint32(event, 42)
+	binary.LittleEndian.PutUint32(event[4:], 10)
+	binary.LittleEndian.PutUint64(event[8:], uint64(0xffffffffffffffff)) // -1
+	binary.LittleEndian.PutUint32(event[16:], 0x00050000)                // SECCOMP_RET_ERRNO
+	copy(event[20:], "sleep")
+	now := time.Now()
+
+	for _, tc := range []struct {
+		prepare func(*bpfrecorderfakes.FakeImpl)
+		event   []byte
+		assert  func(*Violation, error)
+	}{
+		{ // Success
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.ReadlinkReturns("/bin/sleep", nil)
+			},
+			event: event,
+			assert: func(violation *Violation, err error) {
+				require.Nil(t, err)
+				require.Equal(t, 42, violation.PID)
+				require.EqualValues(t, 10, violation.SyscallID)
+				require.EqualValues(t, -1, violation.Return)
+				require.EqualValues(t, 0x00050000, violation.Action)
+				require.Equal(t, "/bin/sleep", violation.Executable)
+				require.Equal(t, now, violation.Timestamp)
+			},
+		},
+		{ // Success fallback to process name
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.ReadlinkReturns("", errTest)
+			},
+			event: event,
+			assert: func(violation *Violation, err error) {
+				require.Nil(t, err)
+				require.Equal(t, "sleep", violation.Executable)
+			},
+		},
+		{ // invalid event length
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {},
+			event:   event[:8],
+			assert: func(violation *Violation, err error) {
+				require.NotNil(t, err)
+			},
+		},
+	} {
+		mock := &bpfrecorderfakes.FakeImpl{}
+		tc.prepare(mock)
+
+		sut := New(logr.Discard())
+		sut.impl = mock
+
+		violation, err := sut.parseViolation(tc.event, now)
+		tc.assert(violation, err)
+	}
+}
+
+func TestObserveViolations(t *testing.T) {
+	t.Parallel()
+
+	mock := &bpfrecorderfakes.FakeImpl{}
+	mock.GoArchReturns(validGoArch)
+	mock.ParseUintReturns(mntns, nil)
+	mock.GetMapReturns(&bpf.BPFMap{}, nil)
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.Nil(t, sut.ObserveViolations(ctx, make(chan *Violation)))
+
+	// Only the maps of the recorder get shrunk
+	resized := map[string]uint32{}
+	for i := 0; i < mock.ResizeMapCallCount(); i++ {
+		_, name, maxEntries := mock.ResizeMapArgsForCall(i)
+		resized[name] = maxEntries
+	}
+	require.Equal(t, violationObserverMapSizes(), resized)
+	require.NotContains(t, resized, "pid_mntns")
+	require.NotContains(t, resized, "seccomp_denied")
+	require.NotContains(t, resized, "violations")
+
+	// The state of the recorder is not touched
+	require.Nil(t, sut.mntns)
+	require.Zero(t, sut.systemMountNamespace)
+	require.Zero(t, mock.UpdateValueCallCount())
+
+	// The host mount namespace gets excluded
+	require.Equal(t, 1, mock.InitGlobalVariableCallCount())
+	_, name, value := mock.InitGlobalVariableArgsForCall(0)
+	require.Equal(t, "host_mntns", name)
+	require.Equal(t, mntns, value)
+
+	// All programs get attached
+	_, kprobe := mock.AttachKprobeArgsForCall(0)
+	require.Equal(t, "audit_seccomp", kprobe)
+	_, rawTracepoint := mock.AttachRawTracepointArgsForCall(0)
+	require.Equal(t, "signal_generate", rawTracepoint)
+	_, kretprobe := mock.AttachKretprobeArgsForCall(0)
+	require.Equal(t, "__secure_computing", kretprobe)
+	require.Equal(t, 1, mock.AttachTracepointCallCount())
+
+	// success without audit_seccomp
+	mock.AttachKprobeReturns(nil, errTest)
+	require.Nil(t, sut.ObserveViolations(ctx, make(chan *Violation)))
+
+	// failure on AttachRawTracepoint
+	mock.AttachRawTracepointReturns(nil, errTest)
+	require.ErrorIs(t, sut.ObserveViolations(ctx, make(chan *Violation)), errTest)
+
+	// failure on ResizeMap
+	mock.ResizeMapReturns(errTest)
+	require.ErrorIs(t, sut.ObserveViolations(ctx, make(chan *Violation)), errTest)
+}
@@ -39,6 +39,29 @@ func New(logger logr.Logger) *BpfRecorder {
 	return &BpfRecorder{}
 }
 
+// RecordSyscallArgs configures the syscalls for which the value of a single
+// argument should be recorded.
+func (b *BpfRecorder) RecordSyscallArgs(args []string) error {
+	return nil
+}
+
+// UseRecordingKey configures the key used to attribute the recorded data to
+// containers.
+func (b *BpfRecorder) UseRecordingKey(key string) error {
+	return nil
+}
+
+// WatchSyscalls streams every syscall newly seen for a recorded container.
+func (b *BpfRecorder) WatchSyscalls(*api.WatchRequest, api.BpfRecorder_WatchSyscallsServer) error {
+	return errUnsupported
+}
+
+// ObserveViolations reports every syscall of a container denied by its
+// seccomp filter into the provided channel.
+func (b *BpfRecorder) ObserveViolations(context.Context, chan<- *Violation) error {
+	return errUnsupported
+}
+
 // Run the BpfRecorder.
 func (b *BpfRecorder) Run() error {
 	return errUnsupported
@@ -62,6 +85,28 @@ func (b *BpfRecorder) Stop(
 	return nil, errUnsupported
 }
 
+// StartSession opens a named recording session.
+func (b *BpfRecorder) StartSession(
+	ctx context.Context, r *api.SessionRequest,
+) (*api.EmptyResponse, error) {
+	return nil, errUnsupported
+}
+
+// StopSession closes a named recording session.
+func (b *BpfRecorder) StopSession(
+	ctx context.Context, r *api.SessionRequest,
+) (*api.EmptyResponse, error) {
+	return nil, errUnsupported
+}
+
+// CapabilitiesForProfile returns the capabilities for the provided profile
+// name.
+func (b *BpfRecorder) CapabilitiesForProfile(
+	ctx context.Context, r *api.ProfileRequest,
+) (*api.CapabilitiesResponse, error) {
+	return nil, errUnsupported
+}
+
 // SyscallsForNamespace returns the syscall names for the provided PID.
 func (b *BpfRecorder) SyscallsForProfile(
 	ctx context.Context, r *api.ProfileRequest,
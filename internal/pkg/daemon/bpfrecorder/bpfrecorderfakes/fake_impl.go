@@ -22,11 +22,11 @@ package bpfrecorderfakes
 
 import (
 	"context"
-	"io/fs"
 	"net"
 	"os"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/aquasecurity/libbpfgo"
 	ttlcache "github.com/jellydator/ttlcache/v3"
@@ -39,6 +39,48 @@ import (
 )
 
 type FakeImpl struct {
+	AttachKprobeStub        func(*libbpfgo.BPFProg, string) (*libbpfgo.BPFLink, error)
+	attachKprobeMutex       sync.RWMutex
+	attachKprobeArgsForCall []struct {
+		arg1 *libbpfgo.BPFProg
+		arg2 string
+	}
+	attachKprobeReturns struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}
+	attachKprobeReturnsOnCall map[int]struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}
+	AttachKretprobeStub        func(*libbpfgo.BPFProg, string) (*libbpfgo.BPFLink, error)
+	attachKretprobeMutex       sync.RWMutex
+	attachKretprobeArgsForCall []struct {
+		arg1 *libbpfgo.BPFProg
+		arg2 string
+	}
+	attachKretprobeReturns struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}
+	attachKretprobeReturnsOnCall map[int]struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}
+	AttachRawTracepointStub        func(*libbpfgo.BPFProg, string) (*libbpfgo.BPFLink, error)
+	attachRawTracepointMutex       sync.RWMutex
+	attachRawTracepointArgsForCall []struct {
+		arg1 *libbpfgo.BPFProg
+		arg2 string
+	}
+	attachRawTracepointReturns struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}
+	attachRawTracepointReturnsOnCall map[int]struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}
 	AttachTracepointStub        func(*libbpfgo.BPFProg, string, string) (*libbpfgo.BPFLink, error)
 	attachTracepointMutex       sync.RWMutex
 	attachTracepointArgsForCall []struct {
@@ -65,6 +107,18 @@ type FakeImpl struct {
 	bPFLoadObjectReturnsOnCall map[int]struct {
 		result1 error
 	}
+	BootTimeStub        func() (time.Time, error)
+	bootTimeMutex       sync.RWMutex
+	bootTimeArgsForCall []struct {
+	}
+	bootTimeReturns struct {
+		result1 time.Time
+		result2 error
+	}
+	bootTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 error
+	}
 	BpfIncClientStub        func(api_metrics.MetricsClient) (api_metrics.Metrics_BpfIncClient, error)
 	bpfIncClientMutex       sync.RWMutex
 	bpfIncClientArgsForCall []struct {
@@ -145,6 +199,18 @@ type FakeImpl struct {
 	deleteKey64ReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteKeyBytesStub        func(*libbpfgo.BPFMap, []byte) error
+	deleteKeyBytesMutex       sync.RWMutex
+	deleteKeyBytesArgsForCall []struct {
+		arg1 *libbpfgo.BPFMap
+		arg2 []byte
+	}
+	deleteKeyBytesReturns struct {
+		result1 error
+	}
+	deleteKeyBytesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DialMetricsStub        func() (*grpc.ClientConn, context.CancelFunc, error)
 	dialMetricsMutex       sync.RWMutex
 	dialMetricsArgsForCall []struct {
@@ -200,6 +266,19 @@ type FakeImpl struct {
 		result1 *libbpfgo.BPFProg
 		result2 error
 	}
+	GetSyscallFromNameStub        func(string) (seccomp.ScmpSyscall, error)
+	getSyscallFromNameMutex       sync.RWMutex
+	getSyscallFromNameArgsForCall []struct {
+		arg1 string
+	}
+	getSyscallFromNameReturns struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}
+	getSyscallFromNameReturnsOnCall map[int]struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}
 	GetValueStub        func(*libbpfgo.BPFMap, uint32) ([]byte, error)
 	getValueMutex       sync.RWMutex
 	getValueArgsForCall []struct {
@@ -228,6 +307,20 @@ type FakeImpl struct {
 		result1 []byte
 		result2 error
 	}
+	GetValueBytesStub        func(*libbpfgo.BPFMap, []byte) ([]byte, error)
+	getValueBytesMutex       sync.RWMutex
+	getValueBytesArgsForCall []struct {
+		arg1 *libbpfgo.BPFMap
+		arg2 []byte
+	}
+	getValueBytesReturns struct {
+		result1 []byte
+		result2 error
+	}
+	getValueBytesReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	GetenvStub        func(string) string
 	getenvMutex       sync.RWMutex
 	getenvArgsForCall []struct {
@@ -318,6 +411,19 @@ type FakeImpl struct {
 		result1 net.Listener
 		result2 error
 	}
+	MapKeysStub        func(*libbpfgo.BPFMap) ([][]byte, error)
+	mapKeysMutex       sync.RWMutex
+	mapKeysArgsForCall []struct {
+		arg1 *libbpfgo.BPFMap
+	}
+	mapKeysReturns struct {
+		result1 [][]byte
+		result2 error
+	}
+	mapKeysReturnsOnCall map[int]struct {
+		result1 [][]byte
+		result2 error
+	}
 	NewForConfigStub        func(*rest.Config) (*kubernetes.Clientset, error)
 	newForConfigMutex       sync.RWMutex
 	newForConfigArgsForCall []struct {
@@ -393,6 +499,19 @@ type FakeImpl struct {
 	removeAllReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResizeMapStub        func(*libbpfgo.Module, string, uint32) error
+	resizeMapMutex       sync.RWMutex
+	resizeMapArgsForCall []struct {
+		arg1 *libbpfgo.Module
+		arg2 string
+		arg3 uint32
+	}
+	resizeMapReturns struct {
+		result1 error
+	}
+	resizeMapReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SendMetricStub        func(api_metrics.Metrics_BpfIncClient, *api_metrics.BpfRequest) error
 	sendMetricMutex       sync.RWMutex
 	sendMetricArgsForCall []struct {
@@ -422,17 +541,17 @@ type FakeImpl struct {
 	startRingBufferArgsForCall []struct {
 		arg1 *libbpfgo.RingBuffer
 	}
-	StatStub        func(string) (fs.FileInfo, error)
+	StatStub        func(string) (os.FileInfo, error)
 	statMutex       sync.RWMutex
 	statArgsForCall []struct {
 		arg1 string
 	}
 	statReturns struct {
-		result1 fs.FileInfo
+		result1 os.FileInfo
 		result2 error
 	}
 	statReturnsOnCall map[int]struct {
-		result1 fs.FileInfo
+		result1 os.FileInfo
 		result2 error
 	}
 	TempFileStub        func(string, string) (*os.File, error)
@@ -516,6 +635,201 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeImpl) AttachKprobe(arg1 *libbpfgo.BPFProg, arg2 string) (*libbpfgo.BPFLink, error) {
+	fake.attachKprobeMutex.Lock()
+	ret, specificReturn := fake.attachKprobeReturnsOnCall[len(fake.attachKprobeArgsForCall)]
+	fake.attachKprobeArgsForCall = append(fake.attachKprobeArgsForCall, struct {
+		arg1 *libbpfgo.BPFProg
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AttachKprobeStub
+	fakeReturns := fake.attachKprobeReturns
+	fake.recordInvocation("AttachKprobe", []interface{}{arg1, arg2})
+	fake.attachKprobeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) AttachKprobeCallCount() int {
+	fake.attachKprobeMutex.RLock()
+	defer fake.attachKprobeMutex.RUnlock()
+	return len(fake.attachKprobeArgsForCall)
+}
+
+func (fake *FakeImpl) AttachKprobeCalls(stub func(*libbpfgo.BPFProg, string) (*libbpfgo.BPFLink, error)) {
+	fake.attachKprobeMutex.Lock()
+	defer fake.attachKprobeMutex.Unlock()
+	fake.AttachKprobeStub = stub
+}
+
+func (fake *FakeImpl) AttachKprobeArgsForCall(i int) (*libbpfgo.BPFProg, string) {
+	fake.attachKprobeMutex.RLock()
+	defer fake.attachKprobeMutex.RUnlock()
+	argsForCall := fake.attachKprobeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) AttachKprobeReturns(result1 *libbpfgo.BPFLink, result2 error) {
+	fake.attachKprobeMutex.Lock()
+	defer fake.attachKprobeMutex.Unlock()
+	fake.AttachKprobeStub = nil
+	fake.attachKprobeReturns = struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AttachKprobeReturnsOnCall(i int, result1 *libbpfgo.BPFLink, result2 error) {
+	fake.attachKprobeMutex.Lock()
+	defer fake.attachKprobeMutex.Unlock()
+	fake.AttachKprobeStub = nil
+	if fake.attachKprobeReturnsOnCall == nil {
+		fake.attachKprobeReturnsOnCall = make(map[int]struct {
+			result1 *libbpfgo.BPFLink
+			result2 error
+		})
+	}
+	fake.attachKprobeReturnsOnCall[i] = struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AttachKretprobe(arg1 *libbpfgo.BPFProg, arg2 string) (*libbpfgo.BPFLink, error) {
+	fake.attachKretprobeMutex.Lock()
+	ret, specificReturn := fake.attachKretprobeReturnsOnCall[len(fake.attachKretprobeArgsForCall)]
+	fake.attachKretprobeArgsForCall = append(fake.attachKretprobeArgsForCall, struct {
+		arg1 *libbpfgo.BPFProg
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AttachKretprobeStub
+	fakeReturns := fake.attachKretprobeReturns
+	fake.recordInvocation("AttachKretprobe", []interface{}{arg1, arg2})
+	fake.attachKretprobeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) AttachKretprobeCallCount() int {
+	fake.attachKretprobeMutex.RLock()
+	defer fake.attachKretprobeMutex.RUnlock()
+	return len(fake.attachKretprobeArgsForCall)
+}
+
+func (fake *FakeImpl) AttachKretprobeCalls(stub func(*libbpfgo.BPFProg, string) (*libbpfgo.BPFLink, error)) {
+	fake.attachKretprobeMutex.Lock()
+	defer fake.attachKretprobeMutex.Unlock()
+	fake.AttachKretprobeStub = stub
+}
+
+func (fake *FakeImpl) AttachKretprobeArgsForCall(i int) (*libbpfgo.BPFProg, string) {
+	fake.attachKretprobeMutex.RLock()
+	defer fake.attachKretprobeMutex.RUnlock()
+	argsForCall := fake.attachKretprobeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) AttachKretprobeReturns(result1 *libbpfgo.BPFLink, result2 error) {
+	fake.attachKretprobeMutex.Lock()
+	defer fake.attachKretprobeMutex.Unlock()
+	fake.AttachKretprobeStub = nil
+	fake.attachKretprobeReturns = struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AttachKretprobeReturnsOnCall(i int, result1 *libbpfgo.BPFLink, result2 error) {
+	fake.attachKretprobeMutex.Lock()
+	defer fake.attachKretprobeMutex.Unlock()
+	fake.AttachKretprobeStub = nil
+	if fake.attachKretprobeReturnsOnCall == nil {
+		fake.attachKretprobeReturnsOnCall = make(map[int]struct {
+			result1 *libbpfgo.BPFLink
+			result2 error
+		})
+	}
+	fake.attachKretprobeReturnsOnCall[i] = struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AttachRawTracepoint(arg1 *libbpfgo.BPFProg, arg2 string) (*libbpfgo.BPFLink, error) {
+	fake.attachRawTracepointMutex.Lock()
+	ret, specificReturn := fake.attachRawTracepointReturnsOnCall[len(fake.attachRawTracepointArgsForCall)]
+	fake.attachRawTracepointArgsForCall = append(fake.attachRawTracepointArgsForCall, struct {
+		arg1 *libbpfgo.BPFProg
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AttachRawTracepointStub
+	fakeReturns := fake.attachRawTracepointReturns
+	fake.recordInvocation("AttachRawTracepoint", []interface{}{arg1, arg2})
+	fake.attachRawTracepointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) AttachRawTracepointCallCount() int {
+	fake.attachRawTracepointMutex.RLock()
+	defer fake.attachRawTracepointMutex.RUnlock()
+	return len(fake.attachRawTracepointArgsForCall)
+}
+
+func (fake *FakeImpl) AttachRawTracepointCalls(stub func(*libbpfgo.BPFProg, string) (*libbpfgo.BPFLink, error)) {
+	fake.attachRawTracepointMutex.Lock()
+	defer fake.attachRawTracepointMutex.Unlock()
+	fake.AttachRawTracepointStub = stub
+}
+
+func (fake *FakeImpl) AttachRawTracepointArgsForCall(i int) (*libbpfgo.BPFProg, string) {
+	fake.attachRawTracepointMutex.RLock()
+	defer fake.attachRawTracepointMutex.RUnlock()
+	argsForCall := fake.attachRawTracepointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) AttachRawTracepointReturns(result1 *libbpfgo.BPFLink, result2 error) {
+	fake.attachRawTracepointMutex.Lock()
+	defer fake.attachRawTracepointMutex.Unlock()
+	fake.AttachRawTracepointStub = nil
+	fake.attachRawTracepointReturns = struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AttachRawTracepointReturnsOnCall(i int, result1 *libbpfgo.BPFLink, result2 error) {
+	fake.attachRawTracepointMutex.Lock()
+	defer fake.attachRawTracepointMutex.Unlock()
+	fake.AttachRawTracepointStub = nil
+	if fake.attachRawTracepointReturnsOnCall == nil {
+		fake.attachRawTracepointReturnsOnCall = make(map[int]struct {
+			result1 *libbpfgo.BPFLink
+			result2 error
+		})
+	}
+	fake.attachRawTracepointReturnsOnCall[i] = struct {
+		result1 *libbpfgo.BPFLink
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) AttachTracepoint(arg1 *libbpfgo.BPFProg, arg2 string, arg3 string) (*libbpfgo.BPFLink, error) {
 	fake.attachTracepointMutex.Lock()
 	ret, specificReturn := fake.attachTracepointReturnsOnCall[len(fake.attachTracepointArgsForCall)]
@@ -643,6 +957,62 @@ func (fake *FakeImpl) BPFLoadObjectReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) BootTime() (time.Time, error) {
+	fake.bootTimeMutex.Lock()
+	ret, specificReturn := fake.bootTimeReturnsOnCall[len(fake.bootTimeArgsForCall)]
+	fake.bootTimeArgsForCall = append(fake.bootTimeArgsForCall, struct {
+	}{})
+	stub := fake.BootTimeStub
+	fakeReturns := fake.bootTimeReturns
+	fake.recordInvocation("BootTime", []interface{}{})
+	fake.bootTimeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) BootTimeCallCount() int {
+	fake.bootTimeMutex.RLock()
+	defer fake.bootTimeMutex.RUnlock()
+	return len(fake.bootTimeArgsForCall)
+}
+
+func (fake *FakeImpl) BootTimeCalls(stub func() (time.Time, error)) {
+	fake.bootTimeMutex.Lock()
+	defer fake.bootTimeMutex.Unlock()
+	fake.BootTimeStub = stub
+}
+
+func (fake *FakeImpl) BootTimeReturns(result1 time.Time, result2 error) {
+	fake.bootTimeMutex.Lock()
+	defer fake.bootTimeMutex.Unlock()
+	fake.BootTimeStub = nil
+	fake.bootTimeReturns = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) BootTimeReturnsOnCall(i int, result1 time.Time, result2 error) {
+	fake.bootTimeMutex.Lock()
+	defer fake.bootTimeMutex.Unlock()
+	fake.BootTimeStub = nil
+	if fake.bootTimeReturnsOnCall == nil {
+		fake.bootTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 error
+		})
+	}
+	fake.bootTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) BpfIncClient(arg1 api_metrics.MetricsClient) (api_metrics.Metrics_BpfIncClient, error) {
 	fake.bpfIncClientMutex.Lock()
 	ret, specificReturn := fake.bpfIncClientReturnsOnCall[len(fake.bpfIncClientArgsForCall)]
@@ -1052,6 +1422,73 @@ func (fake *FakeImpl) DeleteKey64ReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) DeleteKeyBytes(arg1 *libbpfgo.BPFMap, arg2 []byte) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.deleteKeyBytesMutex.Lock()
+	ret, specificReturn := fake.deleteKeyBytesReturnsOnCall[len(fake.deleteKeyBytesArgsForCall)]
+	fake.deleteKeyBytesArgsForCall = append(fake.deleteKeyBytesArgsForCall, struct {
+		arg1 *libbpfgo.BPFMap
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.DeleteKeyBytesStub
+	fakeReturns := fake.deleteKeyBytesReturns
+	fake.recordInvocation("DeleteKeyBytes", []interface{}{arg1, arg2Copy})
+	fake.deleteKeyBytesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) DeleteKeyBytesCallCount() int {
+	fake.deleteKeyBytesMutex.RLock()
+	defer fake.deleteKeyBytesMutex.RUnlock()
+	return len(fake.deleteKeyBytesArgsForCall)
+}
+
+func (fake *FakeImpl) DeleteKeyBytesCalls(stub func(*libbpfgo.BPFMap, []byte) error) {
+	fake.deleteKeyBytesMutex.Lock()
+	defer fake.deleteKeyBytesMutex.Unlock()
+	fake.DeleteKeyBytesStub = stub
+}
+
+func (fake *FakeImpl) DeleteKeyBytesArgsForCall(i int) (*libbpfgo.BPFMap, []byte) {
+	fake.deleteKeyBytesMutex.RLock()
+	defer fake.deleteKeyBytesMutex.RUnlock()
+	argsForCall := fake.deleteKeyBytesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) DeleteKeyBytesReturns(result1 error) {
+	fake.deleteKeyBytesMutex.Lock()
+	defer fake.deleteKeyBytesMutex.Unlock()
+	fake.DeleteKeyBytesStub = nil
+	fake.deleteKeyBytesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DeleteKeyBytesReturnsOnCall(i int, result1 error) {
+	fake.deleteKeyBytesMutex.Lock()
+	defer fake.deleteKeyBytesMutex.Unlock()
+	fake.DeleteKeyBytesStub = nil
+	if fake.deleteKeyBytesReturnsOnCall == nil {
+		fake.deleteKeyBytesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteKeyBytesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) DialMetrics() (*grpc.ClientConn, context.CancelFunc, error) {
 	fake.dialMetricsMutex.Lock()
 	ret, specificReturn := fake.dialMetricsReturnsOnCall[len(fake.dialMetricsArgsForCall)]
@@ -1305,6 +1742,70 @@ func (fake *FakeImpl) GetProgramReturnsOnCall(i int, result1 *libbpfgo.BPFProg,
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) GetSyscallFromName(arg1 string) (seccomp.ScmpSyscall, error) {
+	fake.getSyscallFromNameMutex.Lock()
+	ret, specificReturn := fake.getSyscallFromNameReturnsOnCall[len(fake.getSyscallFromNameArgsForCall)]
+	fake.getSyscallFromNameArgsForCall = append(fake.getSyscallFromNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetSyscallFromNameStub
+	fakeReturns := fake.getSyscallFromNameReturns
+	fake.recordInvocation("GetSyscallFromName", []interface{}{arg1})
+	fake.getSyscallFromNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSyscallFromNameCallCount() int {
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
+	return len(fake.getSyscallFromNameArgsForCall)
+}
+
+func (fake *FakeImpl) GetSyscallFromNameCalls(stub func(string) (seccomp.ScmpSyscall, error)) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = stub
+}
+
+func (fake *FakeImpl) GetSyscallFromNameArgsForCall(i int) string {
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
+	argsForCall := fake.getSyscallFromNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetSyscallFromNameReturns(result1 seccomp.ScmpSyscall, result2 error) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = nil
+	fake.getSyscallFromNameReturns = struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSyscallFromNameReturnsOnCall(i int, result1 seccomp.ScmpSyscall, result2 error) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = nil
+	if fake.getSyscallFromNameReturnsOnCall == nil {
+		fake.getSyscallFromNameReturnsOnCall = make(map[int]struct {
+			result1 seccomp.ScmpSyscall
+			result2 error
+		})
+	}
+	fake.getSyscallFromNameReturnsOnCall[i] = struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) GetValue(arg1 *libbpfgo.BPFMap, arg2 uint32) ([]byte, error) {
 	fake.getValueMutex.Lock()
 	ret, specificReturn := fake.getValueReturnsOnCall[len(fake.getValueArgsForCall)]
@@ -1435,6 +1936,76 @@ func (fake *FakeImpl) GetValue64ReturnsOnCall(i int, result1 []byte, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) GetValueBytes(arg1 *libbpfgo.BPFMap, arg2 []byte) ([]byte, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getValueBytesMutex.Lock()
+	ret, specificReturn := fake.getValueBytesReturnsOnCall[len(fake.getValueBytesArgsForCall)]
+	fake.getValueBytesArgsForCall = append(fake.getValueBytesArgsForCall, struct {
+		arg1 *libbpfgo.BPFMap
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.GetValueBytesStub
+	fakeReturns := fake.getValueBytesReturns
+	fake.recordInvocation("GetValueBytes", []interface{}{arg1, arg2Copy})
+	fake.getValueBytesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetValueBytesCallCount() int {
+	fake.getValueBytesMutex.RLock()
+	defer fake.getValueBytesMutex.RUnlock()
+	return len(fake.getValueBytesArgsForCall)
+}
+
+func (fake *FakeImpl) GetValueBytesCalls(stub func(*libbpfgo.BPFMap, []byte) ([]byte, error)) {
+	fake.getValueBytesMutex.Lock()
+	defer fake.getValueBytesMutex.Unlock()
+	fake.GetValueBytesStub = stub
+}
+
+func (fake *FakeImpl) GetValueBytesArgsForCall(i int) (*libbpfgo.BPFMap, []byte) {
+	fake.getValueBytesMutex.RLock()
+	defer fake.getValueBytesMutex.RUnlock()
+	argsForCall := fake.getValueBytesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetValueBytesReturns(result1 []byte, result2 error) {
+	fake.getValueBytesMutex.Lock()
+	defer fake.getValueBytesMutex.Unlock()
+	fake.GetValueBytesStub = nil
+	fake.getValueBytesReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetValueBytesReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.getValueBytesMutex.Lock()
+	defer fake.getValueBytesMutex.Unlock()
+	fake.GetValueBytesStub = nil
+	if fake.getValueBytesReturnsOnCall == nil {
+		fake.getValueBytesReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.getValueBytesReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) Getenv(arg1 string) string {
 	fake.getenvMutex.Lock()
 	ret, specificReturn := fake.getenvReturnsOnCall[len(fake.getenvArgsForCall)]
@@ -1865,6 +2436,70 @@ func (fake *FakeImpl) ListenReturnsOnCall(i int, result1 net.Listener, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) MapKeys(arg1 *libbpfgo.BPFMap) ([][]byte, error) {
+	fake.mapKeysMutex.Lock()
+	ret, specificReturn := fake.mapKeysReturnsOnCall[len(fake.mapKeysArgsForCall)]
+	fake.mapKeysArgsForCall = append(fake.mapKeysArgsForCall, struct {
+		arg1 *libbpfgo.BPFMap
+	}{arg1})
+	stub := fake.MapKeysStub
+	fakeReturns := fake.mapKeysReturns
+	fake.recordInvocation("MapKeys", []interface{}{arg1})
+	fake.mapKeysMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) MapKeysCallCount() int {
+	fake.mapKeysMutex.RLock()
+	defer fake.mapKeysMutex.RUnlock()
+	return len(fake.mapKeysArgsForCall)
+}
+
+func (fake *FakeImpl) MapKeysCalls(stub func(*libbpfgo.BPFMap) ([][]byte, error)) {
+	fake.mapKeysMutex.Lock()
+	defer fake.mapKeysMutex.Unlock()
+	fake.MapKeysStub = stub
+}
+
+func (fake *FakeImpl) MapKeysArgsForCall(i int) *libbpfgo.BPFMap {
+	fake.mapKeysMutex.RLock()
+	defer fake.mapKeysMutex.RUnlock()
+	argsForCall := fake.mapKeysArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) MapKeysReturns(result1 [][]byte, result2 error) {
+	fake.mapKeysMutex.Lock()
+	defer fake.mapKeysMutex.Unlock()
+	fake.MapKeysStub = nil
+	fake.mapKeysReturns = struct {
+		result1 [][]byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) MapKeysReturnsOnCall(i int, result1 [][]byte, result2 error) {
+	fake.mapKeysMutex.Lock()
+	defer fake.mapKeysMutex.Unlock()
+	fake.MapKeysStub = nil
+	if fake.mapKeysReturnsOnCall == nil {
+		fake.mapKeysReturnsOnCall = make(map[int]struct {
+			result1 [][]byte
+			result2 error
+		})
+	}
+	fake.mapKeysReturnsOnCall[i] = struct {
+		result1 [][]byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) NewForConfig(arg1 *rest.Config) (*kubernetes.Clientset, error) {
 	fake.newForConfigMutex.Lock()
 	ret, specificReturn := fake.newForConfigReturnsOnCall[len(fake.newForConfigArgsForCall)]
@@ -2238,6 +2873,69 @@ func (fake *FakeImpl) RemoveAllReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) ResizeMap(arg1 *libbpfgo.Module, arg2 string, arg3 uint32) error {
+	fake.resizeMapMutex.Lock()
+	ret, specificReturn := fake.resizeMapReturnsOnCall[len(fake.resizeMapArgsForCall)]
+	fake.resizeMapArgsForCall = append(fake.resizeMapArgsForCall, struct {
+		arg1 *libbpfgo.Module
+		arg2 string
+		arg3 uint32
+	}{arg1, arg2, arg3})
+	stub := fake.ResizeMapStub
+	fakeReturns := fake.resizeMapReturns
+	fake.recordInvocation("ResizeMap", []interface{}{arg1, arg2, arg3})
+	fake.resizeMapMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) ResizeMapCallCount() int {
+	fake.resizeMapMutex.RLock()
+	defer fake.resizeMapMutex.RUnlock()
+	return len(fake.resizeMapArgsForCall)
+}
+
+func (fake *FakeImpl) ResizeMapCalls(stub func(*libbpfgo.Module, string, uint32) error) {
+	fake.resizeMapMutex.Lock()
+	defer fake.resizeMapMutex.Unlock()
+	fake.ResizeMapStub = stub
+}
+
+func (fake *FakeImpl) ResizeMapArgsForCall(i int) (*libbpfgo.Module, string, uint32) {
+	fake.resizeMapMutex.RLock()
+	defer fake.resizeMapMutex.RUnlock()
+	argsForCall := fake.resizeMapArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ResizeMapReturns(result1 error) {
+	fake.resizeMapMutex.Lock()
+	defer fake.resizeMapMutex.Unlock()
+	fake.ResizeMapStub = nil
+	fake.resizeMapReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ResizeMapReturnsOnCall(i int, result1 error) {
+	fake.resizeMapMutex.Lock()
+	defer fake.resizeMapMutex.Unlock()
+	fake.ResizeMapStub = nil
+	if fake.resizeMapReturnsOnCall == nil {
+		fake.resizeMapReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resizeMapReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) SendMetric(arg1 api_metrics.Metrics_BpfIncClient, arg2 *api_metrics.BpfRequest) error {
 	fake.sendMetricMutex.Lock()
 	ret, specificReturn := fake.sendMetricReturnsOnCall[len(fake.sendMetricArgsForCall)]
@@ -2394,7 +3092,7 @@ func (fake *FakeImpl) StartRingBufferArgsForCall(i int) *libbpfgo.RingBuffer {
 	return argsForCall.arg1
 }
 
-func (fake *FakeImpl) Stat(arg1 string) (fs.FileInfo, error) {
+func (fake *FakeImpl) Stat(arg1 string) (os.FileInfo, error) {
 	fake.statMutex.Lock()
 	ret, specificReturn := fake.statReturnsOnCall[len(fake.statArgsForCall)]
 	fake.statArgsForCall = append(fake.statArgsForCall, struct {
@@ -2419,7 +3117,7 @@ func (fake *FakeImpl) StatCallCount() int {
 	return len(fake.statArgsForCall)
 }
 
-func (fake *FakeImpl) StatCalls(stub func(string) (fs.FileInfo, error)) {
+func (fake *FakeImpl) StatCalls(stub func(string) (os.FileInfo, error)) {
 	fake.statMutex.Lock()
 	defer fake.statMutex.Unlock()
 	fake.StatStub = stub
@@ -2432,28 +3130,28 @@ func (fake *FakeImpl) StatArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
-func (fake *FakeImpl) StatReturns(result1 fs.FileInfo, result2 error) {
+func (fake *FakeImpl) StatReturns(result1 os.FileInfo, result2 error) {
 	fake.statMutex.Lock()
 	defer fake.statMutex.Unlock()
 	fake.StatStub = nil
 	fake.statReturns = struct {
-		result1 fs.FileInfo
+		result1 os.FileInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) StatReturnsOnCall(i int, result1 fs.FileInfo, result2 error) {
+func (fake *FakeImpl) StatReturnsOnCall(i int, result1 os.FileInfo, result2 error) {
 	fake.statMutex.Lock()
 	defer fake.statMutex.Unlock()
 	fake.StatStub = nil
 	if fake.statReturnsOnCall == nil {
 		fake.statReturnsOnCall = make(map[int]struct {
-			result1 fs.FileInfo
+			result1 os.FileInfo
 			result2 error
 		})
 	}
 	fake.statReturnsOnCall[i] = struct {
-		result1 fs.FileInfo
+		result1 os.FileInfo
 		result2 error
 	}{result1, result2}
 }
@@ -2860,10 +3558,18 @@ func (fake *FakeImpl) WriteReturnsOnCall(i int, result1 int, result2 error) {
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.attachKprobeMutex.RLock()
+	defer fake.attachKprobeMutex.RUnlock()
+	fake.attachKretprobeMutex.RLock()
+	defer fake.attachKretprobeMutex.RUnlock()
+	fake.attachRawTracepointMutex.RLock()
+	defer fake.attachRawTracepointMutex.RUnlock()
 	fake.attachTracepointMutex.RLock()
 	defer fake.attachTracepointMutex.RUnlock()
 	fake.bPFLoadObjectMutex.RLock()
 	defer fake.bPFLoadObjectMutex.RUnlock()
+	fake.bootTimeMutex.RLock()
+	defer fake.bootTimeMutex.RUnlock()
 	fake.bpfIncClientMutex.RLock()
 	defer fake.bpfIncClientMutex.RUnlock()
 	fake.chownMutex.RLock()
@@ -2878,6 +3584,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.deleteKeyMutex.RUnlock()
 	fake.deleteKey64Mutex.RLock()
 	defer fake.deleteKey64Mutex.RUnlock()
+	fake.deleteKeyBytesMutex.RLock()
+	defer fake.deleteKeyBytesMutex.RUnlock()
 	fake.dialMetricsMutex.RLock()
 	defer fake.dialMetricsMutex.RUnlock()
 	fake.getMapMutex.RLock()
@@ -2886,10 +3594,14 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.getNameMutex.RUnlock()
 	fake.getProgramMutex.RLock()
 	defer fake.getProgramMutex.RUnlock()
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
 	fake.getValueMutex.RLock()
 	defer fake.getValueMutex.RUnlock()
 	fake.getValue64Mutex.RLock()
 	defer fake.getValue64Mutex.RUnlock()
+	fake.getValueBytesMutex.RLock()
+	defer fake.getValueBytesMutex.RUnlock()
 	fake.getenvMutex.RLock()
 	defer fake.getenvMutex.RUnlock()
 	fake.goArchMutex.RLock()
@@ -2904,6 +3616,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.listPodsMutex.RUnlock()
 	fake.listenMutex.RLock()
 	defer fake.listenMutex.RUnlock()
+	fake.mapKeysMutex.RLock()
+	defer fake.mapKeysMutex.RUnlock()
 	fake.newForConfigMutex.RLock()
 	defer fake.newForConfigMutex.RUnlock()
 	fake.newModuleFromBufferArgsMutex.RLock()
@@ -2916,6 +3630,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.readlinkMutex.RUnlock()
 	fake.removeAllMutex.RLock()
 	defer fake.removeAllMutex.RUnlock()
+	fake.resizeMapMutex.RLock()
+	defer fake.resizeMapMutex.RUnlock()
 	fake.sendMetricMutex.RLock()
 	defer fake.sendMetricMutex.RUnlock()
 	fake.serveMutex.RLock()
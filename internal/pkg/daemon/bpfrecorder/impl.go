@@ -23,11 +23,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
 	"os"
 	"runtime"
 	"strconv"
+	"strings"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/acobaugh/osrelease"
@@ -59,6 +62,9 @@ type impl interface {
 	BPFLoadObject(*bpf.Module) error
 	GetProgram(*bpf.Module, string) (*bpf.BPFProg, error)
 	AttachTracepoint(*bpf.BPFProg, string, string) (*bpf.BPFLink, error)
+	AttachKprobe(*bpf.BPFProg, string) (*bpf.BPFLink, error)
+	AttachKretprobe(*bpf.BPFProg, string) (*bpf.BPFLink, error)
+	AttachRawTracepoint(*bpf.BPFProg, string) (*bpf.BPFLink, error)
 	GetMap(*bpf.Module, string) (*bpf.BPFMap, error)
 	InitRingBuf(*bpf.Module, string, chan []byte) (*bpf.RingBuffer, error)
 	Stat(string) (os.FileInfo, error)
@@ -70,12 +76,17 @@ type impl interface {
 	ContainerIDForPID(*ttlcache.Cache[string, string], int) (string, error)
 	GetValue(*bpf.BPFMap, uint32) ([]byte, error)
 	GetValue64(*bpf.BPFMap, uint64) ([]byte, error)
+	GetValueBytes(*bpf.BPFMap, []byte) ([]byte, error)
 	UpdateValue(*bpf.BPFMap, uint32, []byte) error
 	UpdateValue64(*bpf.BPFMap, uint64, []byte) error
 	DeleteKey(*bpf.BPFMap, uint32) error
 	DeleteKey64(*bpf.BPFMap, uint64) error
+	DeleteKeyBytes(*bpf.BPFMap, []byte) error
+	MapKeys(*bpf.BPFMap) ([][]byte, error)
 	ListPods(context.Context, *kubernetes.Clientset, string) (*v1.PodList, error)
 	GetName(seccomp.ScmpSyscall) (string, error)
+	GetSyscallFromName(string) (seccomp.ScmpSyscall, error)
+	BootTime() (time.Time, error)
 	RemoveAll(string) error
 	Chown(string, int, int) error
 	CloseModule(*bpf.BPFMap)
@@ -88,6 +99,7 @@ type impl interface {
 	CloseGRPC(*grpc.ClientConn) error
 	SendMetric(apimetrics.Metrics_BpfIncClient, *apimetrics.BpfRequest) error
 	InitGlobalVariable(*bpf.Module, string, interface{}) error
+	ResizeMap(*bpf.Module, string, uint32) error
 }
 
 func (d *defaultImpl) Getenv(key string) string {
@@ -128,6 +140,18 @@ func (d *defaultImpl) AttachTracepoint(prog *bpf.BPFProg, category, name string)
 	return prog.AttachTracepoint(category, name)
 }
 
+func (d *defaultImpl) AttachKprobe(prog *bpf.BPFProg, name string) (*bpf.BPFLink, error) {
+	return prog.AttachKprobe(name)
+}
+
+func (d *defaultImpl) AttachKretprobe(prog *bpf.BPFProg, name string) (*bpf.BPFLink, error) {
+	return prog.AttachKretprobe(name)
+}
+
+func (d *defaultImpl) AttachRawTracepoint(prog *bpf.BPFProg, name string) (*bpf.BPFLink, error) {
+	return prog.AttachRawTracepoint(name)
+}
+
 func (d *defaultImpl) GetMap(module *bpf.Module, mapName string) (*bpf.BPFMap, error) {
 	return module.GetMap(mapName)
 }
@@ -178,6 +202,13 @@ func (d *defaultImpl) GetValue64(m *bpf.BPFMap, key uint64) ([]byte, error) {
 	return m.GetValue(unsafe.Pointer(&key))
 }
 
+func (d *defaultImpl) GetValueBytes(m *bpf.BPFMap, key []byte) ([]byte, error) {
+	if m == nil {
+		return nil, errors.New("provided bpf map is nil")
+	}
+	return m.GetValue(unsafe.Pointer(&key[0]))
+}
+
 func (d *defaultImpl) UpdateValue(m *bpf.BPFMap, key uint32, value []byte) error {
 	if m == nil {
 		return errors.New("provided bpf map is nil")
@@ -206,6 +237,27 @@ func (d *defaultImpl) DeleteKey64(m *bpf.BPFMap, key uint64) error {
 	return m.DeleteKey(unsafe.Pointer(&key))
 }
 
+func (d *defaultImpl) DeleteKeyBytes(m *bpf.BPFMap, key []byte) error {
+	if m == nil {
+		return errors.New("provided bpf map is nil")
+	}
+	return m.DeleteKey(unsafe.Pointer(&key[0]))
+}
+
+func (d *defaultImpl) MapKeys(m *bpf.BPFMap) ([][]byte, error) {
+	if m == nil {
+		return nil, errors.New("provided bpf map is nil")
+	}
+	keys := [][]byte{}
+	it := m.Iterator()
+	for it.Next() {
+		key := make([]byte, len(it.Key()))
+		copy(key, it.Key())
+		keys = append(keys, key)
+	}
+	return keys, it.Err()
+}
+
 func (d *defaultImpl) ListPods(
 	ctx context.Context, c *kubernetes.Clientset, nodeName string,
 ) (*v1.PodList, error) {
@@ -218,6 +270,27 @@ func (d *defaultImpl) GetName(s seccomp.ScmpSyscall) (string, error) {
 	return s.GetName()
 }
 
+func (d *defaultImpl) GetSyscallFromName(name string) (seccomp.ScmpSyscall, error) {
+	return seccomp.GetSyscallFromName(name)
+}
+
+func (d *defaultImpl) BootTime() (time.Time, error) {
+	// The uptime is based on the same clock as bpf_ktime_get_boot_ns().
+	uptime, err := os.ReadFile("/proc/uptime")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("read uptime: %w", err)
+	}
+	fields := strings.Fields(string(uptime))
+	if len(fields) == 0 {
+		return time.Time{}, errors.New("no uptime found")
+	}
+	seconds, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse uptime: %w", err)
+	}
+	return time.Now().Add(-time.Duration(seconds * float64(time.Second))), nil
+}
+
 func (d *defaultImpl) RemoveAll(path string) error {
 	return os.RemoveAll(path)
 }
@@ -271,3 +344,11 @@ func (d *defaultImpl) SendMetric(
 func (d *defaultImpl) InitGlobalVariable(module *bpf.Module, name string, value interface{}) error {
 	return module.InitGlobalVariable(name, value)
 }
+
+func (d *defaultImpl) ResizeMap(module *bpf.Module, name string, maxEntries uint32) error {
+	bpfMap, err := module.GetMap(name)
+	if err != nil {
+		return fmt.Errorf("get map: %w", err)
+	}
+	return bpfMap.Resize(maxEntries)
+}
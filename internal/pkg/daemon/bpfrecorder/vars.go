@@ -16,7 +16,43 @@ limitations under the License.
 
 package bpfrecorder
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // ErrNotFound is the GRPC error if no recorded profile found.
 var ErrNotFound = errors.New("no recorded profile found")
+
+// ErrInvalidRecordingKey is returned if an unknown recording key is used.
+var ErrInvalidRecordingKey = errors.New("invalid recording key")
+
+const (
+	// RecordingKeyMountNamespace attributes the recorded data to containers
+	// by their mount namespace.
+	RecordingKeyMountNamespace = "MountNamespace"
+
+	// RecordingKeyCgroupID attributes the recorded data to containers by
+	// their cgroup v2 ID.
+	RecordingKeyCgroupID = "CgroupID"
+)
+
+// Violation is a syscall of a container which has not been allowed by its
+// seccomp filter.
+type Violation struct {
+	// PID is the process ID of the violating process.
+	PID int
+	// SyscallID is the ID of the denied syscall.
+	SyscallID int32
+	// Executable is the path of the executable or the process name if the
+	// path could not be resolved.
+	Executable string
+	// Return is the return value of the denied syscall, which is zero if the
+	// process got killed.
+	Return int64
+	// Action is the seccomp action (SECCOMP_RET_*) without its data which
+	// denied the syscall.
+	Action uint32
+	// Timestamp is the time when the violation has been observed.
+	Timestamp time.Time
+}
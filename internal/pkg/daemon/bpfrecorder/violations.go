@@ -0,0 +1,191 @@
+//go:build linux && !no_bpf
+// +build linux,!no_bpf
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bpfrecorder
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"time"
+)
+
+// violationObserverMapSizes shrinks the maps of the bpf module which are only
+// used for recording, because the violation observer needs only the pid_mntns,
+// seccomp_denied and violations maps.
+func violationObserverMapSizes() map[string]uint32 {
+	// Ring buffers need at least the size of a page.
+	pageSize := uint32(os.Getpagesize())
+	return map[string]uint32{
+		"mntns_syscalls":              1,
+		"mntns_syscall_times":         1,
+		"mntns_comm_syscalls":         1,
+		"mntns_capabilities":          1,
+		"syscall_arg_index":           1,
+		"mntns_syscall_args":          1,
+		"mntns_syscall_args_overflow": 1,
+		"events":                      pageSize,
+		"syscall_events":              pageSize,
+	}
+}
+
+// ObserveViolations loads a dedicated bpf module which reports every syscall
+// of a container denied by its seccomp filter into the provided channel. The
+// module gets unloaded if the context is done. The state of the module is
+// kept separately from the one of the recorder.
+func (b *BpfRecorder) ObserveViolations(ctx context.Context, violations chan<- *Violation) error {
+	systemMountNamespace, err := b.FindProcMountNamespace(defaultHostPid)
+	if err != nil {
+		return fmt.Errorf("retrieve current mount namespace: %w", err)
+	}
+
+	module, btfPath, err := b.loadModule(violationObserverMapSizes(), systemMountNamespace)
+	if err != nil {
+		return err
+	}
+
+	// The kprobe reports killed processes and the actions of filters which
+	// request logging. It is not available if the kernel has been built
+	// without audit syscall support.
+	const kprobeName = "audit_seccomp"
+	b.logger.Info("Getting bpf program " + kprobeName)
+	kprobe, err := b.GetProgram(module, kprobeName)
+	if err != nil {
+		return fmt.Errorf("get %s program: %w", kprobeName, err)
+	}
+
+	b.logger.Info("Attaching bpf kprobe")
+	if _, err := b.AttachKprobe(kprobe, kprobeName); err != nil {
+		b.logger.Error(err, "Unable to attach kprobe, killed threads will not be reported")
+	}
+
+	const rawTracepointName = "signal_generate"
+	b.logger.Info("Getting bpf program " + rawTracepointName)
+	rawTracepoint, err := b.GetProgram(module, rawTracepointName)
+	if err != nil {
+		return fmt.Errorf("get %s program: %w", rawTracepointName, err)
+	}
+
+	b.logger.Info("Attaching bpf raw tracepoint")
+	if _, err := b.AttachRawTracepoint(rawTracepoint, rawTracepointName); err != nil {
+		return fmt.Errorf("attach raw tracepoint: %w", err)
+	}
+
+	const kretprobeName = "secure_computing_exit"
+	b.logger.Info("Getting bpf program " + kretprobeName)
+	kretprobe, err := b.GetProgram(module, kretprobeName)
+	if err != nil {
+		return fmt.Errorf("get %s program: %w", kretprobeName, err)
+	}
+
+	b.logger.Info("Attaching bpf kretprobe")
+	if _, err := b.AttachKretprobe(kretprobe, "__secure_computing"); err != nil {
+		return fmt.Errorf("attach kretprobe: %w", err)
+	}
+
+	const tracepointName = "sys_exit"
+	b.logger.Info("Getting bpf program " + tracepointName)
+	tracepoint, err := b.GetProgram(module, tracepointName)
+	if err != nil {
+		return fmt.Errorf("get %s program: %w", tracepointName, err)
+	}
+
+	b.logger.Info("Attaching bpf tracepoint")
+	if _, err := b.AttachTracepoint(tracepoint, "raw_syscalls", tracepointName); err != nil {
+		return fmt.Errorf("attach tracepoint: %w", err)
+	}
+
+	b.logger.Info("Getting pid_mntns map")
+	mntns, err := b.GetMap(module, "pid_mntns")
+	if err != nil {
+		return fmt.Errorf("get pid_mntns: %w", err)
+	}
+
+	events := make(chan []byte)
+	ringbuffer, err := b.InitRingBuf(module, "violations", events)
+	if err != nil {
+		return fmt.Errorf("init violations ringbuffer: %w", err)
+	}
+	b.StartRingBuffer(ringbuffer)
+
+	go func() {
+		<-ctx.Done()
+		b.logger.Info("Unloading violation observer")
+		b.CloseModule(mntns)
+		os.RemoveAll(btfPath)
+	}()
+	go b.processViolations(ctx, events, violations)
+
+	b.logger.Info("Observing seccomp violations")
+	return nil
+}
+
+func (b *BpfRecorder) processViolations(
+	ctx context.Context, events <-chan []byte, violations chan<- *Violation,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event := <-events:
+			violation, err := b.parseViolation(event, time.Now())
+			if err != nil {
+				b.logger.Error(err, "Unable to read violation")
+				continue
+			}
+
+			select {
+			case violations <- violation:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (b *BpfRecorder) parseViolation(event []byte, timestamp time.Time) (*Violation, error) {
+	e := struct {
+		Pid       uint32
+		SyscallID uint32
+		Ret       int64
+		Action    uint32
+		Comm      [16]byte
+	}{}
+
+	if err := binary.Read(bytes.NewReader(event), binary.LittleEndian, &e); err != nil {
+		return nil, fmt.Errorf("read violation event: %w", err)
+	}
+
+	executable, err := b.Readlink(fmt.Sprintf("/proc/%d/exe", e.Pid))
+	if err != nil {
+		executable = toStringByte(e.Comm[:])
+	}
+
+	return &Violation{
+		PID:        int(e.Pid),
+		SyscallID:  int32(e.SyscallID),
+		Executable: executable,
+		Return:     e.Ret,
+		Action:     e.Action,
+		Timestamp:  timestamp,
+	}, nil
+}
@@ -0,0 +1,171 @@
+//go:build linux && !no_bpf
+// +build linux,!no_bpf
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bpfrecorder
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// watcherBufferSize is the number of syscall events buffered per watcher
+// before events get dropped for it.
+const watcherBufferSize = 1024
+
+// WatchSyscalls streams every syscall newly seen for a recorded container
+// until the client disconnects.
+func (b *BpfRecorder) WatchSyscalls(
+	r *api.WatchRequest, stream api.BpfRecorder_WatchSyscallsServer,
+) error {
+	if b.startRequests == 0 {
+		return errors.New("bpf recorder not running")
+	}
+
+	events := make(chan *api.SyscallEvent, watcherBufferSize)
+	b.watchersMutex.Lock()
+	b.watchers[events] = r.Profile
+	b.watchersMutex.Unlock()
+
+	defer func() {
+		b.watchersMutex.Lock()
+		delete(b.watchers, events)
+		b.watchersMutex.Unlock()
+	}()
+
+	b.logger.Info("Watching syscalls", "profile", r.Profile)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return fmt.Errorf("send syscall event: %w", err)
+			}
+		}
+	}
+}
+
+// hasWatchers returns true if at least one client is watching syscalls.
+func (b *BpfRecorder) hasWatchers() bool {
+	b.watchersMutex.RLock()
+	defer b.watchersMutex.RUnlock()
+	return len(b.watchers) > 0
+}
+
+// publishSyscallEvent sends the event to all watchers of its profile. The
+// event gets dropped for watchers which do not keep up.
+func (b *BpfRecorder) publishSyscallEvent(event *api.SyscallEvent) {
+	b.watchersMutex.RLock()
+	defer b.watchersMutex.RUnlock()
+
+	for events, profile := range b.watchers {
+		if profile != "" && profile != event.Profile {
+			continue
+		}
+		select {
+		case events <- event:
+		default:
+			b.logger.V(config.VerboseLevel).Info(
+				"Dropping syscall event for slow watcher",
+				"profile", event.Profile, "syscall", event.Syscall,
+			)
+		}
+	}
+}
+
+func (b *BpfRecorder) processSyscallEvents(events chan []byte) {
+	b.logger.Info("Processing syscall events")
+
+	// The container of a syscall event may not be resolved yet, which is why
+	// the events are handled in parallel.
+	const workers = 100
+	sem := semaphore.NewWeighted(workers)
+
+	for event := range events {
+		if !b.hasWatchers() {
+			continue
+		}
+		if err := sem.Acquire(context.Background(), 1); err != nil {
+			b.logger.Error(err, "Unable to acquire semaphore, stopping syscall event processor")
+			break
+		}
+		eventCopy := event
+		go func() {
+			b.handleSyscallEvent(eventCopy, time.Now())
+			sem.Release(1)
+		}()
+	}
+}
+
+func (b *BpfRecorder) handleSyscallEvent(event []byte, timestamp time.Time) {
+	e := struct {
+		Mntns     uint32
+		SyscallID uint32
+	}{}
+
+	if err := binary.Read(bytes.NewReader(event), binary.LittleEndian, &e); err != nil {
+		b.logger.Error(err, "Unable to read syscall event")
+		return
+	}
+
+	syscall, err := b.syscallNameForID(int(e.SyscallID))
+	if err != nil {
+		b.logger.Error(err, "Unable to convert syscall ID", "id", e.SyscallID)
+		return
+	}
+
+	// The first syscalls of a container race with resolving its PID into
+	// the container ID and profile.
+	var containerID, profile string
+	if err := util.Retry(
+		func() error {
+			var ok bool
+			if containerID, ok = b.mntnsToContainerIDMap.Get(e.Mntns); !ok {
+				return ErrNotFound
+			}
+			if profile, ok = b.containerIDToProfileMap.Get(containerID); !ok {
+				return ErrNotFound
+			}
+			return nil
+		}, func(error) bool { return true },
+	); err != nil {
+		b.logger.V(config.VerboseLevel).Info(
+			"No profile found for syscall event", "mntns", e.Mntns, "syscall", syscall,
+		)
+		return
+	}
+
+	b.publishSyscallEvent(&api.SyscallEvent{
+		Profile:     profile,
+		ContainerId: containerID,
+		Syscall:     syscall,
+		Timestamp:   timestamp.UnixNano(),
+	})
+}
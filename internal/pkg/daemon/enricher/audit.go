@@ -35,8 +35,10 @@ var (
 	)
 	apparmorLineRegex = regexp.MustCompile(
 		//nolint:lll // no need to wrap regex
-		`(type=APPARMOR|audit:.+type=1400).+audit\((.+)\).+apparmor="(.+)".+operation="([a-zA-Z0-9\/\-\_]+)"\s(?:info.+)?profile="(.+)".+name="(.+)".+pid=(\b\d+\b).+comm="([a-zA-Z0-9\/\-\_]+)"\s?(.*)?`,
+		`(type=APPARMOR|type=AVC|audit:.+type=1400).+audit\((.+)\).+apparmor="([^"]+)".+operation="([a-zA-Z0-9\/\-\_]+)"\s(?:info.+)?profile="([^"]+)"(?:.*\sname="([^"]+)")?.+pid=(\b\d+\b).+comm="([a-zA-Z0-9\/\-\_]+)"\s?(.*)?`,
 	)
+	apparmorExtraInfoRegex = regexp.MustCompile(`(\w+)='?([^' ]*)'?`)
+	seccompCodeRegex       = regexp.MustCompile(`\scode=(0x[0-9a-f]+)`)
 )
 
 var (
@@ -100,6 +102,10 @@ func extractSeccompLine(logLine string) *types.AuditLine {
 		line.SystemCallID = int32(v)
 	}
 
+	if code := seccompCodeRegex.FindStringSubmatch(logLine); len(code) > 1 {
+		line.Code = code[1]
+	}
+
 	return &line
 }
 
@@ -143,6 +149,18 @@ func extractApparmorLine(logLine string) *types.AuditLine {
 
 	if len(captures) > minAppArmorCapturesExpected {
 		line.ExtraInfo = strings.ReplaceAll(captures[9], "\"", "'")
+		for _, kv := range apparmorExtraInfoRegex.FindAllStringSubmatch(line.ExtraInfo, -1) {
+			switch kv[1] {
+			case "requested_mask":
+				line.RequestedMask = kv[2]
+			case "capname":
+				line.Capability = kv[2]
+			case "family":
+				line.Family = kv[2]
+			case "sock_type":
+				line.SockType = kv[2]
+			}
+		}
 	}
 	return &line
 }
@@ -114,6 +114,7 @@ func Test_extractAuditLine(t *testing.T) {
 				SystemCallID: 0,
 				ProcessID:    3109464,
 				Executable:   "/bin/busybox",
+				Code:         "0x7ffc0000",
 			},
 			nil,
 		},
@@ -127,6 +128,7 @@ func Test_extractAuditLine(t *testing.T) {
 				SystemCallID: 3,
 				ProcessID:    2039886,
 				Executable:   "/bin/ls",
+				Code:         "0x7ffc0000",
 			},
 			nil,
 		},
@@ -184,16 +186,53 @@ func Test_extractAuditLine(t *testing.T) {
 			"Should extract apparmor long log lines",
 			//nolint:lll // no need to wrap
 			`audit: type=1400 audit(1668191154.949:64): apparmor="DENIED" operation="exec" profile="profile-name" name="/usr/local/bin/sample-app" pid=4166 comm="tini" requested_mask="x" denied_mask="x" fsuid=65534 ouid=0`,
+			&types.AuditLine{
+				AuditType:     "apparmor",
+				TimestampID:   "1668191154.949:64",
+				ProcessID:     4166,
+				Apparmor:      "DENIED",
+				Operation:     "exec",
+				Profile:       "profile-name",
+				Name:          "/usr/local/bin/sample-app",
+				Executable:    "tini",
+				ExtraInfo:     "requested_mask='x' denied_mask='x' fsuid=65534 ouid=0",
+				RequestedMask: "x",
+			},
+			nil,
+		},
+		{
+			"Should extract apparmor capability log lines",
+			//nolint:lll // no need to wrap
+			`type=AVC msg=audit(1668191154.949:65): apparmor="ALLOWED" operation="capable" profile="spo-apparmor-recording" pid=4166 comm="nginx" capability=10  capname="net_bind_service"`,
 			&types.AuditLine{
 				AuditType:   "apparmor",
-				TimestampID: "1668191154.949:64",
+				TimestampID: "1668191154.949:65",
 				ProcessID:   4166,
-				Apparmor:    "DENIED",
-				Operation:   "exec",
-				Profile:     "profile-name",
-				Name:        "/usr/local/bin/sample-app",
-				Executable:  "tini",
-				ExtraInfo:   "requested_mask='x' denied_mask='x' fsuid=65534 ouid=0",
+				Apparmor:    "ALLOWED",
+				Operation:   "capable",
+				Profile:     "spo-apparmor-recording",
+				Executable:  "nginx",
+				ExtraInfo:   "capability=10  capname='net_bind_service'",
+				Capability:  "net_bind_service",
+			},
+			nil,
+		},
+		{
+			"Should extract apparmor network log lines",
+			//nolint:lll // no need to wrap
+			`audit: type=1400 audit(1668191154.949:66): apparmor="ALLOWED" operation="create" profile="spo-apparmor-recording" pid=4166 comm="curl" family="inet6" sock_type="stream" protocol=6 requested_mask="create" denied_mask="create"`,
+			&types.AuditLine{
+				AuditType:     "apparmor",
+				TimestampID:   "1668191154.949:66",
+				ProcessID:     4166,
+				Apparmor:      "ALLOWED",
+				Operation:     "create",
+				Profile:       "spo-apparmor-recording",
+				Executable:    "curl",
+				ExtraInfo:     "family='inet6' sock_type='stream' protocol=6 requested_mask='create' denied_mask='create'",
+				RequestedMask: "create",
+				Family:        "inet6",
+				SockType:      "stream",
 			},
 			nil,
 		},
@@ -142,14 +142,19 @@ func (e *Enricher) populateCacheEntryForContainer(
 
 			recordProfile, ok := pod.Annotations[config.SeccompProfileRecordLogsAnnotationKey+containerName]
 			if !ok {
-				recordProfile = pod.Annotations[config.SelinuxProfileRecordLogsAnnotationKey+containerName]
+				recordProfile, ok = pod.Annotations[config.SelinuxProfileRecordLogsAnnotationKey+containerName]
+			}
+			if !ok {
+				recordProfile = pod.Annotations[config.AppArmorProfileRecordLogsAnnotationKey+containerName]
 			}
 			info := &types.ContainerInfo{
-				PodName:       pod.Name,
-				ContainerName: containerStatus.Name,
-				Namespace:     pod.Namespace,
-				ContainerID:   rawContainerID,
-				RecordProfile: recordProfile,
+				PodName:        pod.Name,
+				PodUID:         string(pod.UID),
+				ContainerName:  containerStatus.Name,
+				Namespace:      pod.Namespace,
+				ContainerID:    rawContainerID,
+				RecordProfile:  recordProfile,
+				SeccompProfile: seccompProfile(pod, containerName),
 			}
 
 			// Update the cache
@@ -160,6 +165,34 @@ func (e *Enricher) populateCacheEntryForContainer(
 	})
 }
 
+// seccompProfile returns the seccomp profile of the container, which
+// overrides the one of the pod.
+func seccompProfile(pod *v1.Pod, containerName string) string {
+	var profile *v1.SeccompProfile
+	if pod.Spec.SecurityContext != nil {
+		profile = pod.Spec.SecurityContext.SeccompProfile
+	}
+
+	//nolint:gocritic // This is what we expect and want
+	containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+	for i := range containers {
+		if containers[i].Name == containerName &&
+			containers[i].SecurityContext != nil &&
+			containers[i].SecurityContext.SeccompProfile != nil {
+			profile = containers[i].SecurityContext.SeccompProfile
+			break
+		}
+	}
+
+	if profile == nil {
+		return ""
+	}
+	if profile.Type == v1.SeccompProfileTypeLocalhost && profile.LocalhostProfile != nil {
+		return *profile.LocalhostProfile
+	}
+	return string(profile.Type)
+}
+
 func (e *Enricher) handleContainerIDEmpty(podName, containerName string, containerStatus *v1.ContainerStatus) error {
 	if containerStatus.State.Waiting != nil &&
 		(containerStatus.State.Waiting.Reason == "ContainerCreating" ||
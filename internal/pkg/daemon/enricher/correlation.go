@@ -0,0 +1,297 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"encoding/hex"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+const (
+	// auditEventTimeout is the time to wait for the remaining records of an
+	// audit event if the kernel does not end it with an EOE record, which is
+	// the case for events without syscall context.
+	auditEventTimeout = time.Second
+
+	// maxPendingAuditEvents is the maximum number of audit events waiting for
+	// their remaining records. Audit lines of new events get dispatched
+	// without correlation if the limit is exceeded.
+	maxPendingAuditEvents = 1000
+)
+
+// Auxiliary audit record types, which are correlated with the audit lines of
+// the same event.
+const (
+	auditRecordSyscall   = "SYSCALL"
+	auditRecordPath      = "PATH"
+	auditRecordCwd       = "CWD"
+	auditRecordExecve    = "EXECVE"
+	auditRecordEOE       = "EOE"
+	auditRecordProctitle = "PROCTITLE"
+)
+
+var (
+	auditRecordRegex = regexp.MustCompile(
+		`type=(SYSCALL|PATH|CWD|EXECVE|EOE|PROCTITLE|1300|1302|1307|1309|1320|1327)\s.*?audit\(([^)]+)\):\s?(.*)`,
+	)
+	auditFieldRegex   = regexp.MustCompile(`([\w\[\]]+)=("[^"]*"|\S+)`)
+	execveArgRegex    = regexp.MustCompile(`^a\d+(\[\d+\])?$`)
+	auditRecordTypeID = map[string]string{
+		"1300": auditRecordSyscall,
+		"1302": auditRecordPath,
+		"1307": auditRecordCwd,
+		"1309": auditRecordExecve,
+		"1320": auditRecordEOE,
+		"1327": auditRecordProctitle,
+	}
+)
+
+const minAuditRecordCapturesExpected = 4
+
+// auditCorrelator buffers the resolved audit lines by their event ID until
+// all auxiliary records of the same event have been read. The kernel emits the
+// records which caused an event before its SYSCALL, PATH, CWD, EXECVE and
+// PROCTITLE records, which means that records of unknown events can be
+// ignored. It is not safe for concurrent use.
+type auditCorrelator struct {
+	events map[string]*pendingAuditEvent
+}
+
+type pendingAuditEvent struct {
+	lines    []*resolvedAuditLine
+	received time.Time
+}
+
+// resolvedAuditLine is an audit line together with the information of the
+// container it belongs to.
+type resolvedAuditLine struct {
+	line *types.AuditLine
+	info *types.ContainerInfo
+}
+
+func newAuditCorrelator() *auditCorrelator {
+	return &auditCorrelator{events: map[string]*pendingAuditEvent{}}
+}
+
+// add buffers the audit line until its event is complete. It returns the
+// audit line if it cannot be buffered and has to be dispatched right away.
+func (c *auditCorrelator) add(
+	line *types.AuditLine, info *types.ContainerInfo, now time.Time,
+) []*resolvedAuditLine {
+	resolved := &resolvedAuditLine{line: line, info: info}
+	if event, ok := c.events[line.TimestampID]; ok {
+		event.lines = append(event.lines, resolved)
+		return nil
+	}
+
+	if len(c.events) >= maxPendingAuditEvents {
+		return []*resolvedAuditLine{resolved}
+	}
+
+	c.events[line.TimestampID] = &pendingAuditEvent{
+		lines:    []*resolvedAuditLine{resolved},
+		received: now,
+	}
+	return nil
+}
+
+// addRecord correlates the log line with the pending audit lines of its
+// event. It returns false if the log line is no auxiliary audit record and
+// the audit lines of the event if the record ends it.
+func (c *auditCorrelator) addRecord(logLine string) (isRecord bool, complete []*resolvedAuditLine) {
+	captures := auditRecordRegex.FindStringSubmatch(logLine)
+	if len(captures) < minAuditRecordCapturesExpected {
+		return false, nil
+	}
+
+	recordType := captures[1]
+	if name, ok := auditRecordTypeID[recordType]; ok {
+		recordType = name
+	}
+
+	event, ok := c.events[captures[2]]
+	if !ok {
+		// Record of an event which is not relevant for the enricher
+		return true, nil
+	}
+
+	if recordType == auditRecordEOE {
+		delete(c.events, captures[2])
+		return true, event.lines
+	}
+
+	fields := auditFieldRegex.FindAllStringSubmatch(captures[3], -1)
+	for _, resolved := range event.lines {
+		applyAuditRecord(resolved.line, recordType, fields)
+	}
+	return true, nil
+}
+
+// expire removes the events which are pending for longer than the
+// auditEventTimeout and returns their audit lines.
+func (c *auditCorrelator) expire(now time.Time) []*resolvedAuditLine {
+	return c.take(func(event *pendingAuditEvent) bool {
+		return now.Sub(event.received) >= auditEventTimeout
+	})
+}
+
+// flush removes all pending events and returns their audit lines.
+func (c *auditCorrelator) flush() []*resolvedAuditLine {
+	return c.take(func(*pendingAuditEvent) bool { return true })
+}
+
+func (c *auditCorrelator) take(matches func(*pendingAuditEvent) bool) []*resolvedAuditLine {
+	events := []*pendingAuditEvent{}
+	for id, event := range c.events {
+		if matches(event) {
+			events = append(events, event)
+			delete(c.events, id)
+		}
+	}
+
+	// Keep the order in which the audit lines have been read
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].received.Before(events[j].received)
+	})
+
+	lines := []*resolvedAuditLine{}
+	for _, event := range events {
+		lines = append(lines, event.lines...)
+	}
+	return lines
+}
+
+// applyAuditRecord adds the fields of an auxiliary audit record to the audit
+// line.
+func applyAuditRecord(line *types.AuditLine, recordType string, fields [][]string) {
+	for _, field := range fields {
+		key, value := field[1], field[2]
+
+		switch recordType {
+		case auditRecordSyscall:
+			switch key {
+			case "exit":
+				if exit, err := strconv.ParseInt(value, 10, 64); err == nil {
+					line.Exit = &exit
+				}
+			case "exe":
+				if line.Executable == "" {
+					line.Executable = auditFieldValue(value)
+				}
+			}
+
+		case auditRecordCwd:
+			if key == "cwd" {
+				line.Cwd = auditFieldValue(value)
+			}
+
+		case auditRecordPath:
+			if key != "name" {
+				continue
+			}
+			path := auditFieldValue(value)
+			if path == "" {
+				continue
+			}
+			line.Paths = append(line.Paths, path)
+
+			// Operations like exec do not contain the name in the AppArmor
+			// record, but it is required for recording the access.
+			if line.AuditType == types.AuditTypeApparmor && line.Name == "" {
+				line.Name = path
+			}
+
+		case auditRecordExecve:
+			// Long arguments are split into chunks: a1[0]="…" a1[1]="…"
+			captures := execveArgRegex.FindStringSubmatch(key)
+			if captures == nil {
+				continue
+			}
+			arg := auditFieldValue(value)
+			if captures[1] != "" && captures[1] != "[0]" && len(line.Arguments) > 0 {
+				line.Arguments[len(line.Arguments)-1] += arg
+				continue
+			}
+			line.Arguments = append(line.Arguments, arg)
+
+		case auditRecordProctitle:
+			if key == "proctitle" {
+				line.Proctitle = strings.TrimSpace(
+					strings.ReplaceAll(auditFieldValue(value), "\x00", " "),
+				)
+			}
+		}
+	}
+}
+
+// auditFieldValue returns the value of an untrusted string field, which is
+// either quoted or hex encoded by the kernel.
+func auditFieldValue(value string) string {
+	const quote = `"`
+	if len(value) >= len(quote+quote) &&
+		strings.HasPrefix(value, quote) && strings.HasSuffix(value, quote) {
+		return value[1 : len(value)-1]
+	}
+
+	if value == "(null)" {
+		return ""
+	}
+
+	if decoded, err := hex.DecodeString(value); err == nil {
+		return string(decoded)
+	}
+
+	return value
+}
+
+// correlatedValues returns the log values of the records correlated with the
+// audit line. The command line is not part of them, because it may contain
+// secrets.
+func correlatedValues(line *types.AuditLine) []interface{} {
+	values := []interface{}{}
+	if line.Exit != nil {
+		values = append(values, "exit", *line.Exit)
+	}
+	if line.Cwd != "" {
+		values = append(values, "cwd", line.Cwd)
+	}
+	if len(line.Paths) > 0 {
+		values = append(values, "paths", line.Paths)
+	}
+	return values
+}
+
+// logCommandLine logs the command line of the audit line on debug level,
+// because it may contain secrets.
+func (e *Enricher) logCommandLine(line *types.AuditLine) {
+	if len(line.Arguments) == 0 && line.Proctitle == "" {
+		return
+	}
+	e.logger.V(config.VerboseLevel).Info("audit command line",
+		"timestamp", line.TimestampID,
+		"pid", line.ProcessID,
+		"arguments", line.Arguments,
+		"proctitle", line.Proctitle,
+	)
+}
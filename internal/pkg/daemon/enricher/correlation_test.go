@@ -0,0 +1,226 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nxadm/tail"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+func TestAuditCorrelator(t *testing.T) {
+	t.Parallel()
+
+	const avcID = "1613173578.156:2945"
+	sut := newAuditCorrelator()
+	now := time.Now()
+	info := &types.ContainerInfo{PodName: pod, Namespace: namespace}
+
+	avc, err := ExtractAuditLine(avcLine)
+	require.Nil(t, err)
+	require.Empty(t, sut.add(avc, info, now))
+
+	// Not an auxiliary record
+	isRecord, complete := sut.addRecord(seccompLine)
+	require.False(t, isRecord)
+	require.Nil(t, complete)
+
+	for _, record := range []string{
+		`type=SYSCALL msg=audit(` + avcID + `): arch=c000003e syscall=89 ` +
+			`success=no exit=-13 a0=7ffd a1=c000 a2=80 a3=0 items=1 ppid=1 ` +
+			`pid=75593 comm="security-profil" exe="/usr/bin/security-profiles-operator"`,
+		`type=CWD msg=audit(` + avcID + `): cwd="/"`,
+		`type=PATH msg=audit(` + avcID + `): item=0 ` +
+			`name="/var/run/secrets/kubernetes.io/serviceaccount/token" inode=612459 nametype=NORMAL`,
+		`type=PATH msg=audit(` + avcID + `): item=1 name=(null) inode=612460 nametype=PARENT`,
+		`type=PROCTITLE msg=audit(` + avcID + `): ` +
+			`proctitle=2F7573722F62696E2F73656375726974792D70726F66696C65732D6F70657261746F72006461656D6F6E`,
+		// Record of another event
+		`type=CWD msg=audit(1613173578.156:2946): cwd="/tmp"`,
+	} {
+		isRecord, complete = sut.addRecord(record)
+		require.True(t, isRecord)
+		require.Nil(t, complete)
+	}
+	require.Empty(t, sut.expire(now))
+
+	isRecord, complete = sut.addRecord(`type=EOE msg=audit(` + avcID + `): `)
+	require.True(t, isRecord)
+	require.Equal(t, []*resolvedAuditLine{{line: avc, info: info}}, complete)
+	require.NotNil(t, avc.Exit)
+	require.EqualValues(t, -13, *avc.Exit)
+	require.Equal(t, "/usr/bin/security-profiles-operator", avc.Executable)
+	require.Equal(t, "/", avc.Cwd)
+	require.Equal(t, []string{"/var/run/secrets/kubernetes.io/serviceaccount/token"}, avc.Paths)
+	require.Equal(t, "/usr/bin/security-profiles-operator daemon", avc.Proctitle)
+	require.Empty(t, sut.flush())
+
+	// Events without EOE record expire
+	seccomp, err := ExtractAuditLine(seccompLine)
+	require.Nil(t, err)
+	require.Empty(t, sut.add(seccomp, info, now))
+	isRecord, complete = sut.addRecord(
+		`audit: type=1300 audit(1624537480.360:8477): arch=c000003e syscall=10 success=no exit=-1`,
+	)
+	require.True(t, isRecord)
+	require.Nil(t, complete)
+	require.Empty(t, sut.expire(now.Add(auditEventTimeout/2)))
+	require.Equal(t, []*resolvedAuditLine{{line: seccomp, info: info}}, sut.expire(now.Add(auditEventTimeout)))
+	require.EqualValues(t, -1, *seccomp.Exit)
+	require.Equal(t, executable, seccomp.Executable)
+
+	// Pending events get flushed in order
+	first := &types.AuditLine{TimestampID: "1.000:1"}
+	second := &types.AuditLine{TimestampID: "1.000:2"}
+	require.Empty(t, sut.add(first, info, now))
+	require.Empty(t, sut.add(second, info, now.Add(time.Millisecond)))
+	require.Equal(t, []*resolvedAuditLine{
+		{line: first, info: info},
+		{line: second, info: info},
+	}, sut.flush())
+}
+
+func TestRunCorrelation(t *testing.T) {
+	t.Parallel()
+
+	const avcID = "1613173578.156:2945"
+	lineChan := make(chan *tail.Line)
+	mock := &enricherfakes.FakeImpl{}
+	mock.GetenvReturns(node)
+	mock.DialReturns(nil, func() {}, nil)
+	mock.LinesReturns(lineChan)
+	mock.ContainerIDForPIDReturns(containerID, nil)
+	mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod,
+			Namespace: namespace,
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{{
+				ContainerID: crioPrefix + containerID,
+			}},
+		},
+	}}}, nil)
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+	go func() { require.NotNil(t, sut.Run()) }()
+
+	// The container gets resolved as soon as the audit line has been read,
+	// while dispatching waits for the remaining records of the event.
+	lineChan <- &tail.Line{Text: avcLine}
+	lineChan <- &tail.Line{Text: `type=CWD msg=audit(` + avcID + `): cwd="/"`}
+	require.Equal(t, 1, mock.ContainerIDForPIDCallCount())
+	require.Zero(t, mock.SendMetricCallCount())
+
+	lineChan <- &tail.Line{Text: `type=EOE msg=audit(` + avcID + `): `}
+	require.Eventually(t, func() bool {
+		return mock.SendMetricCallCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	close(lineChan)
+
+	_, res := mock.SendMetricArgsForCall(0)
+	require.Equal(t, pod, res.Pod)
+	require.NotNil(t, res.SelinuxReq)
+}
+
+func TestAuditCorrelatorLimit(t *testing.T) {
+	t.Parallel()
+
+	sut := newAuditCorrelator()
+	now := time.Now()
+	info := &types.ContainerInfo{}
+	for i := 0; i < maxPendingAuditEvents; i++ {
+		require.Empty(t, sut.add(&types.AuditLine{TimestampID: "1.000:" + strconv.Itoa(i)}, info, now))
+	}
+
+	// Lines of pending events are still buffered
+	require.Empty(t, sut.add(&types.AuditLine{TimestampID: "1.000:0"}, info, now))
+
+	line := &types.AuditLine{TimestampID: "2.000:0"}
+	require.Equal(t, []*resolvedAuditLine{{line: line, info: info}}, sut.add(line, info, now))
+	require.Len(t, sut.flush(), maxPendingAuditEvents+1)
+}
+
+func TestApplyAuditRecord(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		line       *types.AuditLine
+		recordType string
+		record     string
+		assert     func(*types.AuditLine)
+	}{
+		{
+			name:       "execve",
+			recordType: auditRecordExecve,
+			line:       &types.AuditLine{},
+			record:     `argc=4 a0="ls" a1="-l" a2=2F746D702F6D7920646972 a3_len=10 a3[0]="hello" a3[1]="world"`,
+			assert: func(line *types.AuditLine) {
+				require.Equal(t, []string{"ls", "-l", "/tmp/my dir", "helloworld"}, line.Arguments)
+			},
+		},
+		{
+			name:       "apparmor path",
+			recordType: auditRecordPath,
+			line:       &types.AuditLine{AuditType: types.AuditTypeApparmor},
+			record:     `item=0 name="/usr/bin/ls" inode=1234 nametype=NORMAL`,
+			assert: func(line *types.AuditLine) {
+				require.Equal(t, []string{"/usr/bin/ls"}, line.Paths)
+				require.Equal(t, "/usr/bin/ls", line.Name)
+			},
+		},
+		{
+			name:       "apparmor path with name",
+			recordType: auditRecordPath,
+			line:       &types.AuditLine{AuditType: types.AuditTypeApparmor, Name: "/etc/hosts"},
+			record:     `item=0 name="/usr/bin/ls" inode=1234 nametype=NORMAL`,
+			assert: func(line *types.AuditLine) {
+				require.Equal(t, "/etc/hosts", line.Name)
+			},
+		},
+		{
+			name:       "invalid exit",
+			recordType: auditRecordSyscall,
+			line:       &types.AuditLine{Executable: executable},
+			record:     `syscall=59 exit=wrong exe="/usr/bin/ls"`,
+			assert: func(line *types.AuditLine) {
+				require.Nil(t, line.Exit)
+				require.Equal(t, executable, line.Executable)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fields := auditFieldRegex.FindAllStringSubmatch(tc.record, -1)
+			applyAuditRecord(tc.line, tc.recordType, fields)
+			tc.assert(tc.line)
+		})
+	}
+}
@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -29,17 +28,21 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
-	"github.com/nxadm/tail"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/encoding/protojson"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	rutil "sigs.k8s.io/release-utils/util"
 
 	apienricher "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
 	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/sink"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
@@ -63,16 +66,36 @@ type Enricher struct {
 	containerIDCache *ttlcache.Cache[string, string]
 	infoCache        *ttlcache.Cache[string, *types.ContainerInfo]
 	syscalls         sync.Map
+	executables      sync.Map
+	statistics       sync.Map
 	avcs             sync.Map
+	apparmor         sync.Map
 	auditLineCache   *ttlcache.Cache[string, []*types.AuditLine]
 	clientset        kubernetes.Interface
+	bpfViolations    bool
+	logSource        string
+	exporter         *sink.Exporter
+	client           client.Client
+	recorder         record.EventRecorder
+
+	// stoppedRecordings contains the profiles which are not recorded any
+	// more, although their containers are still running.
+	stoppedRecordings *ttlcache.Cache[string, bool]
+
+	violationEventCache    *ttlcache.Cache[string, bool]
+	profileViolations      map[profileRef]*profilebasev1alpha1.ViolationSummary
+	profileViolationsMutex sync.Mutex
+
+	subscribers      map[chan *apienricher.AuditEvent]*apienricher.SubscribeRequest
+	subscribersMutex sync.RWMutex
 }
 
 // New returns a new Enricher instance.
 func New(logger logr.Logger) *Enricher {
 	return &Enricher{
-		impl:   &defaultImpl{},
-		logger: logger,
+		impl:      &defaultImpl{},
+		logger:    logger,
+		logSource: LogSourceFile,
 		containerIDCache: ttlcache.New(
 			ttlcache.WithTTL[string, string](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, string](maxCacheItems),
@@ -81,8 +104,11 @@ func New(logger logr.Logger) *Enricher {
 			ttlcache.WithTTL[string, *types.ContainerInfo](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, *types.ContainerInfo](maxCacheItems),
 		),
-		syscalls: sync.Map{},
-		avcs:     sync.Map{},
+		syscalls:    sync.Map{},
+		executables: sync.Map{},
+		statistics:  sync.Map{},
+		avcs:        sync.Map{},
+		apparmor:    sync.Map{},
 		auditLineCache: ttlcache.New(
 			ttlcache.WithTTL[string, []*types.AuditLine](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, []*types.AuditLine](maxCacheItems),
@@ -91,7 +117,34 @@ func New(logger logr.Logger) *Enricher {
 			// if/when the cache is full.
 			ttlcache.WithDisableTouchOnHit[string, []*types.AuditLine](),
 		),
+		stoppedRecordings: ttlcache.New(
+			ttlcache.WithCapacity[string, bool](maxCacheItems),
+		),
+		violationEventCache: ttlcache.New(
+			ttlcache.WithTTL[string, bool](violationEventInterval),
+			ttlcache.WithCapacity[string, bool](maxCacheItems),
+			ttlcache.WithDisableTouchOnHit[string, bool](),
+		),
+		profileViolations: map[profileRef]*profilebasev1alpha1.ViolationSummary{},
+		subscribers:       map[chan *apienricher.AuditEvent]*apienricher.SubscribeRequest{},
+	}
+}
+
+// ObserveBpfViolations enables reporting seccomp violations via BPF instead
+// of reading them from the audit logs, which works without auditd.
+func (e *Enricher) ObserveBpfViolations() {
+	e.bpfViolations = true
+}
+
+// ExportTo configures the sinks to which the enriched audit events get
+// exported in addition to the log output.
+func (e *Enricher) ExportTo(configs []sink.Config) error {
+	exporter, err := sink.New(e.logger, configs)
+	if err != nil {
+		return fmt.Errorf("create sinks: %w", err)
 	}
+	e.exporter = exporter
+	return nil
 }
 
 // Run the log-enricher to scrap audit logs and enrich them with
@@ -121,6 +174,13 @@ func (e *Enricher) Run() error {
 
 	e.logger.Info("Starting log-enricher on node: " + nodeName)
 
+	e.client, err = e.NewClient(clusterConfig)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+	e.recorder = e.NewEventRecorder(e.clientset, nodeName)
+	go e.violationEventCache.Start()
+
 	e.logger.Info("Connecting to local GRPC server")
 	var (
 		conn          *grpc.ClientConn
@@ -153,91 +213,183 @@ func (e *Enricher) Run() error {
 		return fmt.Errorf("start GRPC server: %w", err)
 	}
 
-	// Use auditd logs as main source or syslog as fallback.
-	filePath := LogFilePath()
-
-	// If the file does not exist, then tail will wait for it to appear
-	tailFile, err := e.TailFile(
-		filePath,
-		tail.Config{
-			ReOpen: true,
-			Follow: true,
-			Location: &tail.SeekInfo{
-				Offset: 0,
-				Whence: io.SeekEnd,
-			},
-		},
-	)
+	lines, reason, err := e.openLogSource()
 	if err != nil {
-		return fmt.Errorf("tailing file: %w", err)
+		return fmt.Errorf("open log source: %w", err)
 	}
 
-	e.logger.Info("Reading from file " + filePath)
-	for l := range e.Lines(tailFile) {
-		if l.Err != nil {
-			e.logger.Error(l.Err, "failed to tail")
-			continue
-		}
+	if e.client != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go e.flushProfileViolations(ctx)
+	}
 
-		line := l.Text
-		e.logger.V(config.VerboseLevel).Info("Got line: " + line)
-		if !IsAuditLine(line) {
-			e.logger.V(config.VerboseLevel).Info("Not an audit line")
-			continue
+	if e.exporter != nil {
+		e.logger.Info("Starting sink exporter")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		e.exporter.Start(ctx)
+	}
+
+	var violations chan *bpfrecorder.Violation
+	if e.bpfViolations {
+		e.logger.Info("Starting BPF seccomp violation observer")
+		violations = make(chan *bpfrecorder.Violation)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if err := e.ObserveViolations(ctx, e.logger, violations); err != nil {
+			return fmt.Errorf("observe seccomp violations: %w", err)
 		}
+	}
 
-		auditLine, err := ExtractAuditLine(line)
-		if err != nil {
-			e.logger.Error(err, "extract audit line")
-			continue
+	// Audit lines are resolved into their container right away, because the
+	// process may be gone soon. They are dispatched once all records of their
+	// event are read.
+	correlator := newAuditCorrelator()
+	var violationSerial uint64
+	ticker := time.NewTicker(auditEventTimeout)
+	defer ticker.Stop()
+	dispatchAuditLines := func(auditLines []*resolvedAuditLine) {
+		for _, l := range auditLines {
+			e.dispatchResolvedAuditLine(metricsClient, nodeName, l.line, l.info)
 		}
+	}
 
-		e.logger.V(config.VerboseLevel).Info(fmt.Sprintf("Get container ID for PID: %d", auditLine.ProcessID))
-		cID, err := e.ContainerIDForPID(e.containerIDCache, auditLine.ProcessID)
-		if errors.Is(err, os.ErrNotExist) {
-			// We're probably in container creation or removal
-			if backlogErr := e.addToBacklog(auditLine); backlogErr != nil {
-				e.logger.Error(backlogErr, "adding line to backlog")
+	for {
+		select {
+		case l, ok := <-lines:
+			if !ok {
+				dispatchAuditLines(correlator.flush())
+				return fmt.Errorf("enricher failed: %w", reason())
 			}
-			continue
-		}
-		if err != nil {
-			e.logger.Error(
-				err, "unable to get container ID",
-				"processID", auditLine.ProcessID,
-			)
-			if backlogErr := e.addToBacklog(auditLine); backlogErr != nil {
-				e.logger.Error(backlogErr, "adding line to backlog")
+			if l.Err != nil {
+				e.logger.Error(l.Err, "failed to tail")
+				continue
 			}
-			continue
-		}
 
-		e.logger.V(config.VerboseLevel).Info("Get container info for: " + cID)
-		info, err := e.getContainerInfo(nodeName, cID)
-		if err != nil {
-			e.logger.Error(
-				err, "container ID not found in cluster",
-				"processID", auditLine.ProcessID,
-				"containerID", cID,
-			)
-			if backlogErr := e.addToBacklog(auditLine); backlogErr != nil {
-				e.logger.Error(backlogErr, "adding line to backlog")
+			line := l.Text
+			e.logger.V(config.VerboseLevel).Info("Got line: " + line)
+			if isRecord, complete := correlator.addRecord(line); isRecord {
+				dispatchAuditLines(complete)
+				continue
 			}
-			continue
+			if !IsAuditLine(line) {
+				e.logger.V(config.VerboseLevel).Info("Not an audit line")
+				continue
+			}
+
+			auditLine, err := ExtractAuditLine(line)
+			if err != nil {
+				e.logger.Error(err, "extract audit line")
+				continue
+			}
+
+			if info := e.resolveAuditLine(nodeName, auditLine); info != nil {
+				dispatchAuditLines(correlator.add(auditLine, info, time.Now()))
+			}
+
+		case <-ticker.C:
+			dispatchAuditLines(correlator.expire(time.Now()))
+
+		case violation := <-violations:
+			// Violations consist of a single record, which is why they
+			// bypass the correlation.
+			violationSerial++
+			e.processAuditLine(metricsClient, nodeName, violationAuditLine(violation, violationSerial))
 		}
+	}
+}
 
-		err = e.dispatchAuditLine(metricsClient, nodeName, auditLine, info)
-		if err != nil {
-			e.logger.Error(
-				err, "dispatch audit line")
-			continue
+// violationAuditLine converts a seccomp violation observed by the BPF
+// observer into an audit line. The serial makes the timestamp ID unique among
+// the observed violations.
+func violationAuditLine(violation *bpfrecorder.Violation, serial uint64) *types.AuditLine {
+	const millisPerSecond = 1000
+	millis := violation.Timestamp.UnixMilli()
+	return &types.AuditLine{
+		AuditType:    types.AuditTypeSeccomp,
+		ProcessID:    violation.PID,
+		TimestampID:  fmt.Sprintf("%d.%03d:%d", millis/millisPerSecond, millis%millisPerSecond, serial),
+		SystemCallID: violation.SyscallID,
+		Executable:   violation.Executable,
+		// same format as the code field of the audit log
+		Code: fmt.Sprintf("0x%x", violation.Action),
+	}
+}
+
+// processAuditLine enriches the audit line with the container information
+// and dispatches it. Audit lines of processes which cannot be found yet are
+// added to the backlog.
+func (e *Enricher) processAuditLine(
+	metricsClient apimetrics.Metrics_AuditIncClient,
+	nodeName string,
+	auditLine *types.AuditLine,
+) {
+	if info := e.resolveAuditLine(nodeName, auditLine); info != nil {
+		e.dispatchResolvedAuditLine(metricsClient, nodeName, auditLine, info)
+	}
+}
+
+// resolveAuditLine returns the container information of the audit line. The
+// audit line is added to the backlog and nil is returned if its process
+// cannot be found yet.
+func (e *Enricher) resolveAuditLine(
+	nodeName string,
+	auditLine *types.AuditLine,
+) *types.ContainerInfo {
+	e.logger.V(config.VerboseLevel).Info(fmt.Sprintf("Get container ID for PID: %d", auditLine.ProcessID))
+	cID, err := e.ContainerIDForPID(e.containerIDCache, auditLine.ProcessID)
+	if errors.Is(err, os.ErrNotExist) {
+		// We're probably in container creation or removal
+		if backlogErr := e.addToBacklog(auditLine); backlogErr != nil {
+			e.logger.Error(backlogErr, "adding line to backlog")
 		}
+		return nil
+	}
+	if err != nil {
+		e.logger.Error(
+			err, "unable to get container ID",
+			"processID", auditLine.ProcessID,
+		)
+		if backlogErr := e.addToBacklog(auditLine); backlogErr != nil {
+			e.logger.Error(backlogErr, "adding line to backlog")
+		}
+		return nil
+	}
+
+	e.logger.V(config.VerboseLevel).Info("Get container info for: " + cID)
+	info, err := e.getContainerInfo(nodeName, cID)
+	if err != nil {
+		e.logger.Error(
+			err, "container ID not found in cluster",
+			"processID", auditLine.ProcessID,
+			"containerID", cID,
+		)
+		if backlogErr := e.addToBacklog(auditLine); backlogErr != nil {
+			e.logger.Error(backlogErr, "adding line to backlog")
+		}
+		return nil
+	}
 
-		// check if there's anything in the cache for this processID
-		e.dispatchBacklog(metricsClient, nodeName, info, auditLine.ProcessID)
+	return info
+}
+
+// dispatchResolvedAuditLine dispatches the audit line together with the
+// backlog of its process.
+func (e *Enricher) dispatchResolvedAuditLine(
+	metricsClient apimetrics.Metrics_AuditIncClient,
+	nodeName string,
+	auditLine *types.AuditLine,
+	info *types.ContainerInfo,
+) {
+	if err := e.dispatchAuditLine(metricsClient, nodeName, auditLine, info); err != nil {
+		e.logger.Error(
+			err, "dispatch audit line")
+		return
 	}
 
-	return fmt.Errorf("enricher failed: %w", e.Reason(tailFile))
+	// check if there's anything in the cache for this processID
+	e.dispatchBacklog(metricsClient, nodeName, info, auditLine.ProcessID)
 }
 
 func (e *Enricher) startGrpcServer() error {
@@ -364,6 +516,7 @@ func (e *Enricher) dispatchAuditLine(
 		return fmt.Errorf("unknown audit line type %s", auditLine.AuditType)
 	}
 
+	e.publishAuditLine(nodeName, auditLine, info)
 	return nil
 }
 
@@ -373,7 +526,7 @@ func (e *Enricher) dispatchSelinuxLine(
 	auditLine *types.AuditLine,
 	info *types.ContainerInfo,
 ) {
-	e.logger.Info("audit",
+	values := []interface{}{
 		"timestamp", auditLine.TimestampID,
 		"type", auditLine.AuditType,
 		"profile", info.RecordProfile,
@@ -385,7 +538,35 @@ func (e *Enricher) dispatchSelinuxLine(
 		"scontext", auditLine.Scontext,
 		"tcontext", auditLine.Tcontext,
 		"tclass", auditLine.Tclass,
-	)
+	}
+	values = append(values, correlatedValues(auditLine)...)
+
+	e.logger.Info("audit", values...)
+	e.logCommandLine(auditLine)
+
+	e.export(&sink.Event{
+		Timestamp: auditTimestamp(auditLine.TimestampID),
+		Type:      auditLine.AuditType,
+		Node:      nodeName,
+		Namespace: info.Namespace,
+		Pod:       info.PodName,
+		Container: info.ContainerName,
+		PID:       auditLine.ProcessID,
+		Profile:   auditLine.Scontext,
+		Perm:      auditLine.Perm,
+		Tcontext:  auditLine.Tcontext,
+		Tclass:    auditLine.Tclass,
+	})
+
+	if info.RecordProfile == "" {
+		if ref, ok := selinuxProfileRef(auditLine.Scontext); ok {
+			e.reportProfileViolation(
+				ref, info,
+				fmt.Sprintf("{ %s } on %s %s", auditLine.Perm, auditLine.Tclass, auditLine.Tcontext),
+				auditTimestamp(auditLine.TimestampID),
+			)
+		}
+	}
 
 	if err := e.SendMetric(
 		metricsClient,
@@ -404,7 +585,7 @@ func (e *Enricher) dispatchSelinuxLine(
 		e.logger.Error(err, "unable to update metrics")
 	}
 
-	if info.RecordProfile != "" {
+	if e.isRecording(info) {
 		for _, perm := range strings.Split(auditLine.Perm, " ") {
 			avc := &apienricher.AvcResponse_SelinuxAvc{
 				Perm:     perm,
@@ -442,7 +623,7 @@ func (e *Enricher) dispatchSeccompLine(
 		return
 	}
 
-	e.logger.Info("audit",
+	values := []interface{}{
 		"timestamp", auditLine.TimestampID,
 		"type", auditLine.AuditType,
 		"node", nodeName,
@@ -453,7 +634,32 @@ func (e *Enricher) dispatchSeccompLine(
 		"pid", auditLine.ProcessID,
 		"syscallID", auditLine.SystemCallID,
 		"syscallName", syscallName,
-	)
+	}
+	values = append(values, correlatedValues(auditLine)...)
+
+	e.logger.Info("audit", values...)
+	e.logCommandLine(auditLine)
+
+	e.export(&sink.Event{
+		Timestamp:  auditTimestamp(auditLine.TimestampID),
+		Type:       auditLine.AuditType,
+		Node:       nodeName,
+		Namespace:  info.Namespace,
+		Pod:        info.PodName,
+		Container:  info.ContainerName,
+		Executable: auditLine.Executable,
+		PID:        auditLine.ProcessID,
+		Syscall:    syscallName,
+		Profile:    info.SeccompProfile,
+	})
+
+	if info.RecordProfile == "" && isSeccompDenial(auditLine) {
+		if ref, ok := seccompProfileRef(info.SeccompProfile); ok {
+			e.reportProfileViolation(
+				ref, info, "syscall "+syscallName, auditTimestamp(auditLine.TimestampID),
+			)
+		}
+	}
 
 	if err := e.SendMetric(
 		metricsClient,
@@ -471,15 +677,63 @@ func (e *Enricher) dispatchSeccompLine(
 		e.logger.Error(err, "unable to update metrics")
 	}
 
-	if info.RecordProfile != "" {
+	if e.isRecording(info) {
 		s, _ := e.syscalls.LoadOrStore(info.RecordProfile, sets.New[string]())
 		stringSet, ok := s.(sets.Set[string])
 		if ok {
 			stringSet.Insert(syscallName)
 		}
+
+		if auditLine.Executable != "" {
+			v, _ := e.executables.LoadOrStore(info.RecordProfile, map[string]sets.Set[string]{})
+			executables, ok := v.(map[string]sets.Set[string])
+			if ok {
+				if _, found := executables[auditLine.Executable]; !found {
+					executables[auditLine.Executable] = sets.New[string]()
+				}
+				executables[auditLine.Executable].Insert(syscallName)
+			}
+		}
+
+		v, _ := e.statistics.LoadOrStore(info.RecordProfile, map[string]*syscallStatistics{})
+		if statistics, ok := v.(map[string]*syscallStatistics); ok {
+			seen := auditTimestamp(auditLine.TimestampID)
+			if stats, found := statistics[syscallName]; found {
+				stats.count++
+				stats.lastSeen = seen
+			} else {
+				statistics[syscallName] = &syscallStatistics{
+					count: 1, firstSeen: seen, lastSeen: seen,
+				}
+			}
+		}
 	}
 }
 
+// syscallStatistics contains the usage data of a recorded syscall.
+type syscallStatistics struct {
+	count     uint64
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+// auditTimestamp returns the time of an audit event from its timestamp ID,
+// for example "1668191154.949:64". The current time is returned if the ID
+// cannot be parsed.
+func auditTimestamp(timestampID string) time.Time {
+	timestamp, _, _ := strings.Cut(timestampID, ":")
+	seconds, millis, _ := strings.Cut(timestamp, ".")
+	sec, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Now()
+	}
+	msec, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		msec = 0
+	}
+	return time.Unix(sec, msec*int64(time.Millisecond))
+}
+
 func (e *Enricher) dispatchApparmorLine(
 	nodeName string,
 	auditLine *types.AuditLine,
@@ -503,8 +757,59 @@ func (e *Enricher) dispatchApparmorLine(
 	if auditLine.ExtraInfo != "" {
 		values = append(values, "extra", auditLine.ExtraInfo)
 	}
+	values = append(values, correlatedValues(auditLine)...)
 
 	e.logger.Info("audit", values...)
+	e.logCommandLine(auditLine)
+
+	e.export(&sink.Event{
+		Timestamp:  auditTimestamp(auditLine.TimestampID),
+		Type:       auditLine.AuditType,
+		Node:       nodeName,
+		Namespace:  info.Namespace,
+		Pod:        info.PodName,
+		Container:  info.ContainerName,
+		Executable: auditLine.Executable,
+		PID:        auditLine.ProcessID,
+		Profile:    auditLine.Profile,
+		Apparmor:   auditLine.Apparmor,
+		Operation:  auditLine.Operation,
+		Name:       auditLine.Name,
+	})
+
+	if e.isRecording(info) {
+		access := &apienricher.ApparmorResponse_ApparmorAccess{
+			Operation:     auditLine.Operation,
+			Name:          auditLine.Name,
+			RequestedMask: auditLine.RequestedMask,
+			Capability:    auditLine.Capability,
+			Family:        auditLine.Family,
+			SockType:      auditLine.SockType,
+		}
+		jsonBytes, err := protojson.Marshal(access)
+		if err != nil {
+			e.logger.Error(err, "marshall protobuf")
+		}
+
+		a, _ := e.apparmor.LoadOrStore(info.RecordProfile, sets.New[string]())
+		stringSet, ok := a.(sets.Set[string])
+		if ok {
+			stringSet.Insert(string(jsonBytes))
+		}
+	}
+}
+
+// isRecording returns true if the audit lines of the container have to be
+// recorded into its profile.
+func (e *Enricher) isRecording(info *types.ContainerInfo) bool {
+	return info.RecordProfile != "" && e.stoppedRecordings.Get(info.RecordProfile) == nil
+}
+
+// export passes the event to the sinks, if configured.
+func (e *Enricher) export(event *sink.Event) {
+	if e.exporter != nil {
+		e.exporter.Export(event)
+	}
 }
 
 // LogFilePath returns either the path to the audit logs or falls back to
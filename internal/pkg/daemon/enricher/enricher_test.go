@@ -20,17 +20,26 @@ limitations under the License.
 package enricher
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/nxadm/tail"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apienricher "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/sink"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 )
 
@@ -335,3 +344,397 @@ func TestRun(t *testing.T) {
 		tc.assert(mock, lineChan, err)
 	}
 }
+
+func TestApparmor(t *testing.T) {
+	t.Parallel()
+
+	const profile = "profile_ctr_nonce_1"
+	sut := New(logr.Discard())
+	request := &apienricher.ApparmorRequest{Profile: profile}
+
+	_, err := sut.Apparmor(context.Background(), request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	info := &types.ContainerInfo{RecordProfile: profile}
+	for i := 0; i < 2; i++ {
+		sut.dispatchApparmorLine(node, &types.AuditLine{
+			AuditType:     types.AuditTypeApparmor,
+			Operation:     "exec",
+			Name:          executable,
+			RequestedMask: "x",
+		}, info)
+	}
+	sut.dispatchApparmorLine(node, &types.AuditLine{}, &types.ContainerInfo{})
+
+	res, err := sut.Apparmor(context.Background(), request)
+	require.Nil(t, err)
+	require.Len(t, res.GetAccess(), 1)
+	require.Equal(t, "exec", res.GetAccess()[0].GetOperation())
+	require.Equal(t, executable, res.GetAccess()[0].GetName())
+
+	_, err = sut.ResetApparmor(context.Background(), request)
+	require.Nil(t, err)
+	_, err = sut.Apparmor(context.Background(), request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSyscallsPerExecutable(t *testing.T) {
+	t.Parallel()
+
+	const profile = "profile_ctr_nonce_1"
+	sut := New(logr.Discard())
+	mock := &enricherfakes.FakeImpl{}
+	sut.impl = mock
+	request := &apienricher.SyscallsRequest{Profile: profile}
+
+	info := &types.ContainerInfo{RecordProfile: profile}
+	for _, line := range []struct {
+		executable string
+		syscallID  int32
+	}{
+		{executable: "/bin/sh", syscallID: 0},
+		{executable: "/bin/sh", syscallID: 1},
+		{executable: "/usr/bin/debug", syscallID: 0},
+		{executable: "", syscallID: 2},
+	} {
+		sut.dispatchSeccompLine(nil, node, &types.AuditLine{
+			AuditType:    types.AuditTypeSeccomp,
+			Executable:   line.executable,
+			SystemCallID: line.syscallID,
+		}, info)
+	}
+
+	res, err := sut.Syscalls(context.Background(), request)
+	require.Nil(t, err)
+	require.Len(t, res.GetSyscalls(), 3)
+	require.Len(t, res.GetExecutables(), 2)
+	require.Equal(t, "/bin/sh", res.GetExecutables()[0].GetExecutable())
+	require.Len(t, res.GetExecutables()[0].GetSyscalls(), 2)
+	require.Equal(t, "/usr/bin/debug", res.GetExecutables()[1].GetExecutable())
+	require.Len(t, res.GetExecutables()[1].GetSyscalls(), 1)
+
+	_, err = sut.ResetSyscalls(context.Background(), request)
+	require.Nil(t, err)
+	_, err = sut.Syscalls(context.Background(), request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, found := sut.executables.Load(profile)
+	require.False(t, found)
+}
+
+func TestSyscallStatistics(t *testing.T) {
+	t.Parallel()
+
+	const profile = "profile_ctr_nonce_1"
+	sut := New(logr.Discard())
+	mock := &enricherfakes.FakeImpl{}
+	sut.impl = mock
+	request := &apienricher.SyscallsRequest{Profile: profile}
+
+	info := &types.ContainerInfo{RecordProfile: profile}
+	for _, line := range []struct {
+		timestampID string
+		syscallID   int32
+	}{
+		{timestampID: "1668191154.949:64", syscallID: 0},
+		{timestampID: "1668191155.000:65", syscallID: 1},
+		{timestampID: "1668191160.123:66", syscallID: 0},
+	} {
+		sut.dispatchSeccompLine(nil, node, &types.AuditLine{
+			AuditType:    types.AuditTypeSeccomp,
+			TimestampID:  line.timestampID,
+			SystemCallID: line.syscallID,
+		}, info)
+	}
+
+	res, err := sut.Syscalls(context.Background(), request)
+	require.Nil(t, err)
+	require.Len(t, res.GetStatistics(), 2)
+
+	counts := map[uint64]*apienricher.SyscallStatistics{}
+	for _, stats := range res.GetStatistics() {
+		counts[stats.GetCount()] = stats
+	}
+	require.Contains(t, counts, uint64(2))
+	require.Equal(t, time.UnixMilli(1668191154949).UnixNano(), counts[2].GetFirstSeen())
+	require.Equal(t, time.UnixMilli(1668191160123).UnixNano(), counts[2].GetLastSeen())
+	require.Contains(t, counts, uint64(1))
+	require.Equal(t, counts[1].GetFirstSeen(), counts[1].GetLastSeen())
+
+	_, err = sut.ResetSyscalls(context.Background(), request)
+	require.Nil(t, err)
+	_, found := sut.statistics.Load(profile)
+	require.False(t, found)
+}
+
+func TestStopRecording(t *testing.T) {
+	t.Parallel()
+
+	const profile = "profile_ctr_nonce_1"
+	sut := New(logr.Discard())
+	mock := &enricherfakes.FakeImpl{}
+	sut.impl = mock
+	request := &apienricher.SyscallsRequest{Profile: profile}
+
+	info := &types.ContainerInfo{RecordProfile: profile}
+	line := &types.AuditLine{AuditType: types.AuditTypeSeccomp, Executable: executable}
+	sut.dispatchSeccompLine(nil, node, line, info)
+
+	_, err := sut.Syscalls(context.Background(), request)
+	require.Nil(t, err)
+
+	_, err = sut.StopRecording(context.Background(), &apienricher.StopRecordingRequest{Profile: profile})
+	require.Nil(t, err)
+	_, err = sut.Syscalls(context.Background(), request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// the container is still running, but its lines are not recorded any more
+	sut.dispatchSeccompLine(nil, node, line, info)
+	sut.dispatchApparmorLine(node, &types.AuditLine{
+		AuditType: types.AuditTypeApparmor, Operation: "exec", Name: executable,
+	}, info)
+	_, err = sut.Syscalls(context.Background(), request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = sut.Apparmor(context.Background(), &apienricher.ApparmorRequest{Profile: profile})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRunBpfViolations(t *testing.T) {
+	t.Parallel()
+
+	// failure on ObserveViolations
+	mock := &enricherfakes.FakeImpl{}
+	mock.GetenvReturns(node)
+	mock.DialReturns(nil, func() {}, nil)
+	mock.ObserveViolationsReturns(errTest)
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+	sut.ObserveBpfViolations()
+	require.ErrorIs(t, sut.Run(), errTest)
+
+	// success
+	lineChan := make(chan *tail.Line)
+	violations := make(chan chan<- *bpfrecorder.Violation, 1)
+	mock = &enricherfakes.FakeImpl{}
+	mock.GetenvReturns(node)
+	mock.DialReturns(nil, func() {}, nil)
+	mock.LinesReturns(lineChan)
+	mock.ObserveViolationsCalls(func(
+		_ context.Context, _ logr.Logger, c chan<- *bpfrecorder.Violation,
+	) error {
+		violations <- c
+		return nil
+	})
+	mock.ContainerIDForPIDReturns(containerID, nil)
+	mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod,
+			Namespace: namespace,
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{{
+				ContainerID: crioPrefix + containerID,
+			}},
+		},
+	}}}, nil)
+
+	sut = New(logr.Discard())
+	sut.impl = mock
+	sut.ObserveBpfViolations()
+	go func() { require.NotNil(t, sut.Run()) }()
+
+	(<-violations) <- &bpfrecorder.Violation{
+		PID:        1,
+		SyscallID:  10,
+		Executable: executable,
+		Timestamp:  time.Now(),
+	}
+	for mock.SendMetricCallCount() != 1 {
+		// Wait for the violation to be dispatched
+	}
+	close(lineChan)
+
+	_, res := mock.SendMetricArgsForCall(0)
+	require.Equal(t, pod, res.Pod)
+	require.Equal(t, executable, res.Executable)
+	require.NotNil(t, res.SeccompReq)
+	require.Equal(t, syscall, res.SeccompReq.Syscall)
+}
+
+func TestViolationAuditLine(t *testing.T) {
+	t.Parallel()
+
+	line := violationAuditLine(&bpfrecorder.Violation{
+		PID:        42,
+		SyscallID:  10,
+		Executable: executable,
+		Action:     0x00050000,
+		Timestamp:  time.UnixMilli(1624537480360),
+	}, 7)
+	require.Equal(t, types.AuditTypeSeccomp, line.AuditType)
+	require.Equal(t, 42, line.ProcessID)
+	require.EqualValues(t, 10, line.SystemCallID)
+	require.Equal(t, executable, line.Executable)
+	require.Equal(t, "0x50000", line.Code)
+	require.Equal(t, "1624537480.360:7", line.TimestampID)
+	require.True(t, auditTimestamp(line.TimestampID).Equal(time.UnixMilli(1624537480360)))
+}
+
+func TestRunLogSources(t *testing.T) {
+	t.Parallel()
+
+	for _, source := range []string{LogSourceJournald, LogSourceAuditNetlink} {
+		source := source
+		t.Run(source, func(t *testing.T) {
+			t.Parallel()
+
+			// failure on opening the source
+			mock := &enricherfakes.FakeImpl{}
+			mock.GetenvReturns(node)
+			mock.DialReturns(nil, func() {}, nil)
+			mock.FollowJournalReturns(nil, errTest)
+			mock.ListenAuditNetlinkReturns(nil, errTest)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+			require.NoError(t, sut.UseLogSource(source))
+			require.ErrorIs(t, sut.Run(), errTest)
+
+			// success
+			lineChan := make(chan *tail.Line)
+			mock = &enricherfakes.FakeImpl{}
+			mock.GetenvReturns(node)
+			mock.DialReturns(nil, func() {}, nil)
+			mock.FollowJournalReturns(lineChan, nil)
+			mock.ListenAuditNetlinkReturns(lineChan, nil)
+			mock.ContainerIDForPIDReturns(containerID, nil)
+			mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pod,
+					Namespace: namespace,
+				},
+				Status: v1.PodStatus{
+					ContainerStatuses: []v1.ContainerStatus{{
+						ContainerID: crioPrefix + containerID,
+					}},
+				},
+			}}}, nil)
+
+			sut = New(logr.Discard())
+			sut.impl = mock
+			require.NoError(t, sut.UseLogSource(source))
+			errs := make(chan error)
+			go func() { errs <- sut.Run() }()
+
+			lineChan <- &tail.Line{Text: seccompLine}
+			close(lineChan)
+			require.ErrorIs(t, <-errs, errLogSourceClosed)
+
+			require.Equal(t, 1, mock.SendMetricCallCount())
+			require.Zero(t, mock.TailFileCallCount())
+		})
+	}
+}
+
+func TestExportTo(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	require.ErrorIs(t, sut.ExportTo([]sink.Config{{Type: "wrong"}}), sink.ErrInvalidConfig)
+	require.Nil(t, sut.exporter)
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, sut.ExportTo([]sink.Config{{Type: sink.TypeFile, Path: path}}))
+	ctx, cancel := context.WithCancel(context.Background())
+	sut.exporter.Start(ctx)
+
+	sut.dispatchSeccompLine(nil, node, &types.AuditLine{
+		AuditType:    types.AuditTypeSeccomp,
+		ProcessID:    42,
+		TimestampID:  "1624537480.360:8477",
+		Executable:   executable,
+		SystemCallID: 10,
+	}, &types.ContainerInfo{
+		PodName:        pod,
+		ContainerName:  "container",
+		Namespace:      namespace,
+		SeccompProfile: "operator/namespace/profile.json",
+	})
+
+	// The event gets flushed on shutdown
+	cancel()
+	event := &sink.Event{}
+	require.Eventually(t, func() bool {
+		content, err := os.ReadFile(path)
+		return err == nil && json.Unmarshal(content, event) == nil
+	}, time.Minute, 10*time.Millisecond)
+
+	require.Equal(t, types.AuditTypeSeccomp, event.Type)
+	require.Equal(t, node, event.Node)
+	require.Equal(t, namespace, event.Namespace)
+	require.Equal(t, pod, event.Pod)
+	require.Equal(t, "container", event.Container)
+	require.Equal(t, executable, event.Executable)
+	require.Equal(t, 42, event.PID)
+	require.Equal(t, syscall, event.Syscall)
+	require.Equal(t, "operator/namespace/profile.json", event.Profile)
+	require.True(t, event.Timestamp.Equal(time.UnixMilli(1624537480360)))
+}
+
+func TestSeccompProfile(t *testing.T) {
+	t.Parallel()
+
+	localhostProfile := "operator/namespace/profile.json"
+	podProfile := &v1.PodSecurityContext{SeccompProfile: &v1.SeccompProfile{
+		Type: v1.SeccompProfileTypeRuntimeDefault,
+	}}
+	containerProfile := &v1.SecurityContext{SeccompProfile: &v1.SeccompProfile{
+		Type:             v1.SeccompProfileTypeLocalhost,
+		LocalhostProfile: &localhostProfile,
+	}}
+
+	for _, tc := range []struct {
+		name string
+		spec v1.PodSpec
+		want string
+	}{
+		{
+			name: "no profile",
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "container"}}},
+			want: "",
+		},
+		{
+			name: "pod profile",
+			spec: v1.PodSpec{
+				SecurityContext: podProfile,
+				Containers:      []v1.Container{{Name: "container"}},
+			},
+			want: string(v1.SeccompProfileTypeRuntimeDefault),
+		},
+		{
+			name: "container profile",
+			spec: v1.PodSpec{
+				SecurityContext: podProfile,
+				Containers: []v1.Container{
+					{Name: "other"},
+					{Name: "container", SecurityContext: containerProfile},
+				},
+			},
+			want: localhostProfile,
+		},
+		{
+			name: "init container profile",
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{{Name: "container", SecurityContext: containerProfile}},
+			},
+			want: localhostProfile,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, seccompProfile(&v1.Pod{Spec: tc.spec}, "container"))
+		})
+	}
+}
@@ -23,13 +23,17 @@ import (
 	"net"
 	"sync"
 
+	"github.com/go-logr/logr"
 	ttlcache "github.com/jellydator/ttlcache/v3"
 	"github.com/nxadm/tail"
 	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	api_metrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 )
 
@@ -112,6 +116,18 @@ type FakeImpl struct {
 		arg1 *ttlcache.Cache[string, []*types.AuditLine]
 		arg2 string
 	}
+	FollowJournalStub        func() (chan *tail.Line, error)
+	followJournalMutex       sync.RWMutex
+	followJournalArgsForCall []struct {
+	}
+	followJournalReturns struct {
+		result1 chan *tail.Line
+		result2 error
+	}
+	followJournalReturnsOnCall map[int]struct {
+		result1 chan *tail.Line
+		result2 error
+	}
 	GetFromBacklogStub        func(*ttlcache.Cache[string, []*types.AuditLine], string) []*types.AuditLine
 	getFromBacklogMutex       sync.RWMutex
 	getFromBacklogArgsForCall []struct {
@@ -187,6 +203,43 @@ type FakeImpl struct {
 		result1 net.Listener
 		result2 error
 	}
+	ListenAuditNetlinkStub        func() (chan *tail.Line, error)
+	listenAuditNetlinkMutex       sync.RWMutex
+	listenAuditNetlinkArgsForCall []struct {
+	}
+	listenAuditNetlinkReturns struct {
+		result1 chan *tail.Line
+		result2 error
+	}
+	listenAuditNetlinkReturnsOnCall map[int]struct {
+		result1 chan *tail.Line
+		result2 error
+	}
+	NewClientStub        func(*rest.Config) (client.Client, error)
+	newClientMutex       sync.RWMutex
+	newClientArgsForCall []struct {
+		arg1 *rest.Config
+	}
+	newClientReturns struct {
+		result1 client.Client
+		result2 error
+	}
+	newClientReturnsOnCall map[int]struct {
+		result1 client.Client
+		result2 error
+	}
+	NewEventRecorderStub        func(kubernetes.Interface, string) record.EventRecorder
+	newEventRecorderMutex       sync.RWMutex
+	newEventRecorderArgsForCall []struct {
+		arg1 kubernetes.Interface
+		arg2 string
+	}
+	newEventRecorderReturns struct {
+		result1 record.EventRecorder
+	}
+	newEventRecorderReturnsOnCall map[int]struct {
+		result1 record.EventRecorder
+	}
 	NewForConfigStub        func(*rest.Config) (*kubernetes.Clientset, error)
 	newForConfigMutex       sync.RWMutex
 	newForConfigArgsForCall []struct {
@@ -200,6 +253,19 @@ type FakeImpl struct {
 		result1 *kubernetes.Clientset
 		result2 error
 	}
+	ObserveViolationsStub        func(context.Context, logr.Logger, chan<- *bpfrecorder.Violation) error
+	observeViolationsMutex       sync.RWMutex
+	observeViolationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 logr.Logger
+		arg3 chan<- *bpfrecorder.Violation
+	}
+	observeViolationsReturns struct {
+		result1 error
+	}
+	observeViolationsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReasonStub        func(*tail.Tail) error
 	reasonMutex       sync.RWMutex
 	reasonArgsForCall []struct {
@@ -661,6 +727,62 @@ func (fake *FakeImpl) FlushBacklogArgsForCall(i int) (*ttlcache.Cache[string, []
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeImpl) FollowJournal() (chan *tail.Line, error) {
+	fake.followJournalMutex.Lock()
+	ret, specificReturn := fake.followJournalReturnsOnCall[len(fake.followJournalArgsForCall)]
+	fake.followJournalArgsForCall = append(fake.followJournalArgsForCall, struct {
+	}{})
+	stub := fake.FollowJournalStub
+	fakeReturns := fake.followJournalReturns
+	fake.recordInvocation("FollowJournal", []interface{}{})
+	fake.followJournalMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) FollowJournalCallCount() int {
+	fake.followJournalMutex.RLock()
+	defer fake.followJournalMutex.RUnlock()
+	return len(fake.followJournalArgsForCall)
+}
+
+func (fake *FakeImpl) FollowJournalCalls(stub func() (chan *tail.Line, error)) {
+	fake.followJournalMutex.Lock()
+	defer fake.followJournalMutex.Unlock()
+	fake.FollowJournalStub = stub
+}
+
+func (fake *FakeImpl) FollowJournalReturns(result1 chan *tail.Line, result2 error) {
+	fake.followJournalMutex.Lock()
+	defer fake.followJournalMutex.Unlock()
+	fake.FollowJournalStub = nil
+	fake.followJournalReturns = struct {
+		result1 chan *tail.Line
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) FollowJournalReturnsOnCall(i int, result1 chan *tail.Line, result2 error) {
+	fake.followJournalMutex.Lock()
+	defer fake.followJournalMutex.Unlock()
+	fake.FollowJournalStub = nil
+	if fake.followJournalReturnsOnCall == nil {
+		fake.followJournalReturnsOnCall = make(map[int]struct {
+			result1 chan *tail.Line
+			result2 error
+		})
+	}
+	fake.followJournalReturnsOnCall[i] = struct {
+		result1 chan *tail.Line
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) GetFromBacklog(arg1 *ttlcache.Cache[string, []*types.AuditLine], arg2 string) []*types.AuditLine {
 	fake.getFromBacklogMutex.Lock()
 	ret, specificReturn := fake.getFromBacklogReturnsOnCall[len(fake.getFromBacklogArgsForCall)]
@@ -1032,6 +1154,188 @@ func (fake *FakeImpl) ListenReturnsOnCall(i int, result1 net.Listener, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ListenAuditNetlink() (chan *tail.Line, error) {
+	fake.listenAuditNetlinkMutex.Lock()
+	ret, specificReturn := fake.listenAuditNetlinkReturnsOnCall[len(fake.listenAuditNetlinkArgsForCall)]
+	fake.listenAuditNetlinkArgsForCall = append(fake.listenAuditNetlinkArgsForCall, struct {
+	}{})
+	stub := fake.ListenAuditNetlinkStub
+	fakeReturns := fake.listenAuditNetlinkReturns
+	fake.recordInvocation("ListenAuditNetlink", []interface{}{})
+	fake.listenAuditNetlinkMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListenAuditNetlinkCallCount() int {
+	fake.listenAuditNetlinkMutex.RLock()
+	defer fake.listenAuditNetlinkMutex.RUnlock()
+	return len(fake.listenAuditNetlinkArgsForCall)
+}
+
+func (fake *FakeImpl) ListenAuditNetlinkCalls(stub func() (chan *tail.Line, error)) {
+	fake.listenAuditNetlinkMutex.Lock()
+	defer fake.listenAuditNetlinkMutex.Unlock()
+	fake.ListenAuditNetlinkStub = stub
+}
+
+func (fake *FakeImpl) ListenAuditNetlinkReturns(result1 chan *tail.Line, result2 error) {
+	fake.listenAuditNetlinkMutex.Lock()
+	defer fake.listenAuditNetlinkMutex.Unlock()
+	fake.ListenAuditNetlinkStub = nil
+	fake.listenAuditNetlinkReturns = struct {
+		result1 chan *tail.Line
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListenAuditNetlinkReturnsOnCall(i int, result1 chan *tail.Line, result2 error) {
+	fake.listenAuditNetlinkMutex.Lock()
+	defer fake.listenAuditNetlinkMutex.Unlock()
+	fake.ListenAuditNetlinkStub = nil
+	if fake.listenAuditNetlinkReturnsOnCall == nil {
+		fake.listenAuditNetlinkReturnsOnCall = make(map[int]struct {
+			result1 chan *tail.Line
+			result2 error
+		})
+	}
+	fake.listenAuditNetlinkReturnsOnCall[i] = struct {
+		result1 chan *tail.Line
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClient(arg1 *rest.Config) (client.Client, error) {
+	fake.newClientMutex.Lock()
+	ret, specificReturn := fake.newClientReturnsOnCall[len(fake.newClientArgsForCall)]
+	fake.newClientArgsForCall = append(fake.newClientArgsForCall, struct {
+		arg1 *rest.Config
+	}{arg1})
+	stub := fake.NewClientStub
+	fakeReturns := fake.newClientReturns
+	fake.recordInvocation("NewClient", []interface{}{arg1})
+	fake.newClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) NewClientCallCount() int {
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	return len(fake.newClientArgsForCall)
+}
+
+func (fake *FakeImpl) NewClientCalls(stub func(*rest.Config) (client.Client, error)) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = stub
+}
+
+func (fake *FakeImpl) NewClientArgsForCall(i int) *rest.Config {
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	argsForCall := fake.newClientArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) NewClientReturns(result1 client.Client, result2 error) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = nil
+	fake.newClientReturns = struct {
+		result1 client.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClientReturnsOnCall(i int, result1 client.Client, result2 error) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = nil
+	if fake.newClientReturnsOnCall == nil {
+		fake.newClientReturnsOnCall = make(map[int]struct {
+			result1 client.Client
+			result2 error
+		})
+	}
+	fake.newClientReturnsOnCall[i] = struct {
+		result1 client.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewEventRecorder(arg1 kubernetes.Interface, arg2 string) record.EventRecorder {
+	fake.newEventRecorderMutex.Lock()
+	ret, specificReturn := fake.newEventRecorderReturnsOnCall[len(fake.newEventRecorderArgsForCall)]
+	fake.newEventRecorderArgsForCall = append(fake.newEventRecorderArgsForCall, struct {
+		arg1 kubernetes.Interface
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.NewEventRecorderStub
+	fakeReturns := fake.newEventRecorderReturns
+	fake.recordInvocation("NewEventRecorder", []interface{}{arg1, arg2})
+	fake.newEventRecorderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) NewEventRecorderCallCount() int {
+	fake.newEventRecorderMutex.RLock()
+	defer fake.newEventRecorderMutex.RUnlock()
+	return len(fake.newEventRecorderArgsForCall)
+}
+
+func (fake *FakeImpl) NewEventRecorderCalls(stub func(kubernetes.Interface, string) record.EventRecorder) {
+	fake.newEventRecorderMutex.Lock()
+	defer fake.newEventRecorderMutex.Unlock()
+	fake.NewEventRecorderStub = stub
+}
+
+func (fake *FakeImpl) NewEventRecorderArgsForCall(i int) (kubernetes.Interface, string) {
+	fake.newEventRecorderMutex.RLock()
+	defer fake.newEventRecorderMutex.RUnlock()
+	argsForCall := fake.newEventRecorderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) NewEventRecorderReturns(result1 record.EventRecorder) {
+	fake.newEventRecorderMutex.Lock()
+	defer fake.newEventRecorderMutex.Unlock()
+	fake.NewEventRecorderStub = nil
+	fake.newEventRecorderReturns = struct {
+		result1 record.EventRecorder
+	}{result1}
+}
+
+func (fake *FakeImpl) NewEventRecorderReturnsOnCall(i int, result1 record.EventRecorder) {
+	fake.newEventRecorderMutex.Lock()
+	defer fake.newEventRecorderMutex.Unlock()
+	fake.NewEventRecorderStub = nil
+	if fake.newEventRecorderReturnsOnCall == nil {
+		fake.newEventRecorderReturnsOnCall = make(map[int]struct {
+			result1 record.EventRecorder
+		})
+	}
+	fake.newEventRecorderReturnsOnCall[i] = struct {
+		result1 record.EventRecorder
+	}{result1}
+}
+
 func (fake *FakeImpl) NewForConfig(arg1 *rest.Config) (*kubernetes.Clientset, error) {
 	fake.newForConfigMutex.Lock()
 	ret, specificReturn := fake.newForConfigReturnsOnCall[len(fake.newForConfigArgsForCall)]
@@ -1096,6 +1400,69 @@ func (fake *FakeImpl) NewForConfigReturnsOnCall(i int, result1 *kubernetes.Clien
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ObserveViolations(arg1 context.Context, arg2 logr.Logger, arg3 chan<- *bpfrecorder.Violation) error {
+	fake.observeViolationsMutex.Lock()
+	ret, specificReturn := fake.observeViolationsReturnsOnCall[len(fake.observeViolationsArgsForCall)]
+	fake.observeViolationsArgsForCall = append(fake.observeViolationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 logr.Logger
+		arg3 chan<- *bpfrecorder.Violation
+	}{arg1, arg2, arg3})
+	stub := fake.ObserveViolationsStub
+	fakeReturns := fake.observeViolationsReturns
+	fake.recordInvocation("ObserveViolations", []interface{}{arg1, arg2, arg3})
+	fake.observeViolationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) ObserveViolationsCallCount() int {
+	fake.observeViolationsMutex.RLock()
+	defer fake.observeViolationsMutex.RUnlock()
+	return len(fake.observeViolationsArgsForCall)
+}
+
+func (fake *FakeImpl) ObserveViolationsCalls(stub func(context.Context, logr.Logger, chan<- *bpfrecorder.Violation) error) {
+	fake.observeViolationsMutex.Lock()
+	defer fake.observeViolationsMutex.Unlock()
+	fake.ObserveViolationsStub = stub
+}
+
+func (fake *FakeImpl) ObserveViolationsArgsForCall(i int) (context.Context, logr.Logger, chan<- *bpfrecorder.Violation) {
+	fake.observeViolationsMutex.RLock()
+	defer fake.observeViolationsMutex.RUnlock()
+	argsForCall := fake.observeViolationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ObserveViolationsReturns(result1 error) {
+	fake.observeViolationsMutex.Lock()
+	defer fake.observeViolationsMutex.Unlock()
+	fake.ObserveViolationsStub = nil
+	fake.observeViolationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ObserveViolationsReturnsOnCall(i int, result1 error) {
+	fake.observeViolationsMutex.Lock()
+	defer fake.observeViolationsMutex.Unlock()
+	fake.ObserveViolationsStub = nil
+	if fake.observeViolationsReturnsOnCall == nil {
+		fake.observeViolationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.observeViolationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Reason(arg1 *tail.Tail) error {
 	fake.reasonMutex.Lock()
 	ret, specificReturn := fake.reasonReturnsOnCall[len(fake.reasonArgsForCall)]
@@ -1488,6 +1855,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.dialMutex.RUnlock()
 	fake.flushBacklogMutex.RLock()
 	defer fake.flushBacklogMutex.RUnlock()
+	fake.followJournalMutex.RLock()
+	defer fake.followJournalMutex.RUnlock()
 	fake.getFromBacklogMutex.RLock()
 	defer fake.getFromBacklogMutex.RUnlock()
 	fake.getenvMutex.RLock()
@@ -1500,8 +1869,16 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.listPodsMutex.RUnlock()
 	fake.listenMutex.RLock()
 	defer fake.listenMutex.RUnlock()
+	fake.listenAuditNetlinkMutex.RLock()
+	defer fake.listenAuditNetlinkMutex.RUnlock()
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	fake.newEventRecorderMutex.RLock()
+	defer fake.newEventRecorderMutex.RUnlock()
 	fake.newForConfigMutex.RLock()
 	defer fake.newForConfigMutex.RUnlock()
+	fake.observeViolationsMutex.RLock()
+	defer fake.observeViolationsMutex.RUnlock()
 	fake.reasonMutex.RLock()
 	defer fake.reasonMutex.RUnlock()
 	fake.removeAllMutex.RLock()
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"runtime"
 
+	"github.com/jellydator/ttlcache/v3"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -35,6 +36,8 @@ const (
 	ErrorNoSyscalls = "no syscalls recorded for profile"
 	// ErrorNoAvcs is returned when no AVCs are recorded for a profile.
 	ErrorNoAvcs = "no avcs recorded for profile"
+	// ErrorNoApparmor is returned when no AppArmor accesses are recorded for a profile.
+	ErrorNoApparmor = "no apparmor accesses recorded for profile"
 )
 
 // Syscalls returns the syscalls for a provided profile.
@@ -51,16 +54,67 @@ func (e *Enricher) Syscalls(
 		return nil, errors.New("syscalls are no string set")
 	}
 	return &api.SyscallsResponse{
-		Syscalls: stringSet.UnsortedList(),
-		GoArch:   runtime.GOARCH,
+		Syscalls:    stringSet.UnsortedList(),
+		GoArch:      runtime.GOARCH,
+		Executables: e.executableSyscalls(r.GetProfile()),
+		Statistics:  e.syscallStatistics(r.GetProfile()),
 	}, nil
 }
 
+// syscallStatistics returns the call count and timestamps per syscall for a
+// provided profile, sorted by the syscall name.
+func (e *Enricher) syscallStatistics(profile string) []*api.SyscallStatistics {
+	value, ok := e.statistics.Load(profile)
+	if !ok {
+		return nil
+	}
+	statistics, ok := value.(map[string]*syscallStatistics)
+	if !ok {
+		return nil
+	}
+
+	result := make([]*api.SyscallStatistics, 0, len(statistics))
+	for _, name := range sets.List(sets.KeySet(statistics)) {
+		stats := statistics[name]
+		result = append(result, &api.SyscallStatistics{
+			Name:      name,
+			Count:     stats.count,
+			FirstSeen: stats.firstSeen.UnixNano(),
+			LastSeen:  stats.lastSeen.UnixNano(),
+		})
+	}
+	return result
+}
+
+// executableSyscalls returns the syscalls per executable for a provided
+// profile, sorted by the executable path.
+func (e *Enricher) executableSyscalls(profile string) []*api.ExecutableSyscalls {
+	value, ok := e.executables.Load(profile)
+	if !ok {
+		return nil
+	}
+	executables, ok := value.(map[string]sets.Set[string])
+	if !ok {
+		return nil
+	}
+
+	result := make([]*api.ExecutableSyscalls, 0, len(executables))
+	for _, executable := range sets.List(sets.KeySet(executables)) {
+		result = append(result, &api.ExecutableSyscalls{
+			Executable: executable,
+			Syscalls:   sets.List(executables[executable]),
+		})
+	}
+	return result
+}
+
 // ResetSyscalls removes the syscalls for a provided profile.
 func (e *Enricher) ResetSyscalls(
 	ctx context.Context, r *api.SyscallsRequest,
 ) (*api.EmptyResponse, error) {
 	e.syscalls.Delete(r.GetProfile())
+	e.executables.Delete(r.GetProfile())
+	e.statistics.Delete(r.GetProfile())
 	return &api.EmptyResponse{}, nil
 }
 
@@ -99,3 +153,53 @@ func (e *Enricher) ResetAvcs(
 	e.avcs.Delete(r.GetProfile())
 	return &api.EmptyResponse{}, nil
 }
+
+// Apparmor returns the AppArmor accesses for a provided profile.
+func (e *Enricher) Apparmor(
+	ctx context.Context, r *api.ApparmorRequest,
+) (*api.ApparmorResponse, error) {
+	apparmor, ok := e.apparmor.Load(r.GetProfile())
+	if !ok {
+		st := status.New(codes.NotFound, ErrorNoApparmor)
+		return nil, st.Err()
+	}
+
+	accessList := make([]*api.ApparmorResponse_ApparmorAccess, 0)
+	stringSet, ok := apparmor.(sets.Set[string])
+	if !ok {
+		return nil, errors.New("apparmor accesses are no string set")
+	}
+	jsonList := stringSet.UnsortedList()
+	for i := range jsonList {
+		access := &api.ApparmorResponse_ApparmorAccess{}
+		err := protojson.Unmarshal([]byte(jsonList[i]), access)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshall JSON: %w", err)
+		}
+		accessList = append(accessList, access)
+	}
+
+	return &api.ApparmorResponse{Access: accessList}, nil
+}
+
+// ResetApparmor removes the AppArmor accesses for a provided profile.
+func (e *Enricher) ResetApparmor(
+	ctx context.Context, r *api.ApparmorRequest,
+) (*api.EmptyResponse, error) {
+	e.apparmor.Delete(r.GetProfile())
+	return &api.EmptyResponse{}, nil
+}
+
+// StopRecording stops recording the provided profile and removes the data
+// recorded for it, while its container keeps running.
+func (e *Enricher) StopRecording(
+	ctx context.Context, r *api.StopRecordingRequest,
+) (*api.EmptyResponse, error) {
+	e.stoppedRecordings.Set(r.GetProfile(), true, ttlcache.DefaultTTL)
+	e.syscalls.Delete(r.GetProfile())
+	e.executables.Delete(r.GetProfile())
+	e.statistics.Delete(r.GetProfile())
+	e.avcs.Delete(r.GetProfile())
+	e.apparmor.Delete(r.GetProfile())
+	return &api.EmptyResponse{}, nil
+}
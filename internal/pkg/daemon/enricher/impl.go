@@ -18,18 +18,28 @@ package enricher
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"os"
 
+	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/nxadm/tail"
 	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
@@ -60,6 +70,11 @@ type impl interface {
 	Chown(string, int, int) error
 	Stat(string) (os.FileInfo, error)
 	RemoveAll(string) error
+	ObserveViolations(context.Context, logr.Logger, chan<- *bpfrecorder.Violation) error
+	FollowJournal() (chan *tail.Line, error)
+	ListenAuditNetlink() (chan *tail.Line, error)
+	NewClient(c *rest.Config) (client.Client, error)
+	NewEventRecorder(c kubernetes.Interface, nodeName string) record.EventRecorder
 }
 
 func (d *defaultImpl) Getenv(key string) string {
@@ -164,3 +179,39 @@ func (d *defaultImpl) Stat(name string) (os.FileInfo, error) {
 func (d *defaultImpl) RemoveAll(path string) error {
 	return os.RemoveAll(path)
 }
+
+func (d *defaultImpl) ObserveViolations(
+	ctx context.Context, logger logr.Logger, violations chan<- *bpfrecorder.Violation,
+) error {
+	return bpfrecorder.New(logger).ObserveViolations(ctx, violations)
+}
+
+func (d *defaultImpl) FollowJournal() (chan *tail.Line, error) {
+	return followJournal()
+}
+
+func (d *defaultImpl) ListenAuditNetlink() (chan *tail.Line, error) {
+	return listenAuditNetlink()
+}
+
+func (d *defaultImpl) NewClient(c *rest.Config) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		seccompprofileapi.AddToScheme,
+		selinuxprofileapi.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			return nil, fmt.Errorf("add to scheme: %w", err)
+		}
+	}
+	return client.New(c, client.Options{Scheme: scheme})
+}
+
+func (d *defaultImpl) NewEventRecorder(c kubernetes.Interface, nodeName string) record.EventRecorder {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: c.CoreV1().Events("")})
+	return broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{
+		Component: "log-enricher",
+		Host:      nodeName,
+	})
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	// violationEventInterval is the minimum interval between two events
+	// for the same container, profile and denial.
+	violationEventInterval = 5 * time.Minute
+
+	// violationFlushInterval is the interval in which the violation
+	// summaries get written into the profile status.
+	violationFlushInterval = 10 * time.Second
+
+	reasonProfileViolation = "ProfileViolation"
+
+	// Seccomp actions which do not deny the syscall.
+	seccompActionLog   = "0x7ffc0000"
+	seccompActionAllow = "0x7fff0000"
+)
+
+const (
+	kindSeccompProfile        = "SeccompProfile"
+	kindClusterSeccompProfile = "ClusterSeccompProfile"
+	kindSelinuxProfile        = "SelinuxProfile"
+)
+
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;get;patch;update
+
+// profileRef references a profile bound to a container.
+type profileRef struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (p profileRef) String() string {
+	if p.namespace == "" {
+		return fmt.Sprintf("%s %s", p.kind, p.name)
+	}
+	return fmt.Sprintf("%s %s/%s", p.kind, p.namespace, p.name)
+}
+
+// seccompProfileRef returns the seccomp profile for the localhost profile
+// path of a container, like "operator/namespace/name.json".
+func seccompProfileRef(localhostProfile string) (ref profileRef, ok bool) {
+	const (
+		pathParts   = 3
+		operatorDir = "operator"
+	)
+	parts := strings.Split(localhostProfile, "/")
+	if len(parts) != pathParts || parts[0] != operatorDir ||
+		!strings.HasSuffix(parts[2], seccompprofileapi.ExtJSON) {
+		return ref, false
+	}
+
+	ref.name = strings.TrimSuffix(parts[2], seccompprofileapi.ExtJSON)
+	if parts[1] == seccompprofileapi.ClusterProfilesDir {
+		ref.kind = kindClusterSeccompProfile
+	} else {
+		ref.kind = kindSeccompProfile
+		ref.namespace = parts[1]
+	}
+	return ref, true
+}
+
+// selinuxProfileRef returns the SELinux profile for the source context of an
+// AVC, which contains the type "name_namespace.process".
+func selinuxProfileRef(scontext string) (ref profileRef, ok bool) {
+	const typeIndex = 2
+	parts := strings.Split(scontext, ":")
+	if len(parts) <= typeIndex || !strings.HasSuffix(parts[typeIndex], ".process") {
+		return ref, false
+	}
+
+	usage := strings.TrimSuffix(parts[typeIndex], ".process")
+	i := strings.LastIndex(usage, "_")
+	if i <= 0 || i == len(usage)-1 {
+		return ref, false
+	}
+
+	return profileRef{
+		kind:      kindSelinuxProfile,
+		namespace: usage[i+1:],
+		name:      usage[:i],
+	}, true
+}
+
+// isSeccompDenial returns true if the seccomp action of the audit line
+// denied the syscall. Audit lines without action, like the ones of the BPF
+// violation observer, are always denials.
+func isSeccompDenial(auditLine *types.AuditLine) bool {
+	return auditLine.Code != seccompActionLog && auditLine.Code != seccompActionAllow
+}
+
+// reportProfileViolation emits a rate limited Warning event on the pod and
+// adds the denial to the violation summary of the profile.
+func (e *Enricher) reportProfileViolation(
+	ref profileRef,
+	info *types.ContainerInfo,
+	denied string,
+	timestamp time.Time,
+) {
+	pod := info.Namespace + "/" + info.PodName
+
+	eventKey := strings.Join([]string{pod, info.ContainerName, ref.String(), denied}, "|")
+	if e.recorder != nil && e.violationEventCache.Get(eventKey) == nil {
+		e.violationEventCache.Set(eventKey, true, ttlcache.DefaultTTL)
+		e.recorder.Eventf(
+			&v1.ObjectReference{
+				Kind:       "Pod",
+				APIVersion: "v1",
+				Namespace:  info.Namespace,
+				Name:       info.PodName,
+				UID:        k8stypes.UID(info.PodUID),
+			},
+			v1.EventTypeWarning,
+			reasonProfileViolation,
+			"%s denied %s for container %s",
+			ref, denied, info.ContainerName,
+		)
+	}
+
+	e.profileViolationsMutex.Lock()
+	defer e.profileViolationsMutex.Unlock()
+
+	summary, ok := e.profileViolations[ref]
+	if !ok {
+		summary = &profilebasev1alpha1.ViolationSummary{}
+		e.profileViolations[ref] = summary
+	}
+	summary.Count++
+	if !timestamp.Before(summary.LastSeen.Time) {
+		summary.LastDenied = denied
+		summary.LastPod = pod
+		summary.LastSeen = metav1.NewTime(timestamp)
+	}
+}
+
+// flushProfileViolations periodically writes the collected violation
+// summaries into the profile status.
+func (e *Enricher) flushProfileViolations(ctx context.Context) {
+	ticker := time.NewTicker(violationFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.profileViolationsMutex.Lock()
+			violations := e.profileViolations
+			e.profileViolations = map[profileRef]*profilebasev1alpha1.ViolationSummary{}
+			e.profileViolationsMutex.Unlock()
+
+			for ref, summary := range violations {
+				if err := e.updateProfileViolations(ctx, ref, summary); err != nil {
+					e.logger.Error(err, "Unable to update profile violations", "profile", ref.String())
+				}
+			}
+		}
+	}
+}
+
+// updateProfileViolations merges the summary into the violation summary of
+// the profile status, which is shared by the enrichers of all nodes.
+func (e *Enricher) updateProfileViolations(
+	ctx context.Context, ref profileRef, summary *profilebasev1alpha1.ViolationSummary,
+) error {
+	if err := util.Retry(func() error {
+		obj, violations, err := e.getViolationsProfile(ctx, ref)
+		if err != nil {
+			return err
+		}
+
+		merged := summary.DeepCopy()
+		if current := *violations; current != nil {
+			merged.Count += current.Count
+			if current.LastSeen.After(summary.LastSeen.Time) {
+				merged.LastDenied = current.LastDenied
+				merged.LastPod = current.LastPod
+				merged.LastSeen = current.LastSeen
+			}
+		}
+		*violations = merged
+
+		if err := e.client.Status().Update(ctx, obj); err != nil {
+			return fmt.Errorf("update %s status: %w", ref, err)
+		}
+		return nil
+	}, kerrors.IsConflict); err != nil {
+		if kerrors.IsNotFound(err) {
+			// The workload uses a profile which is not managed (anymore).
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// getViolationsProfile retrieves the referenced profile together with its
+// violation summary. SelinuxProfiles and RawSelinuxProfiles share the same
+// SELinux type naming, which is why a RawSelinuxProfile is looked up if no
+// SelinuxProfile exists.
+func (e *Enricher) getViolationsProfile(
+	ctx context.Context, ref profileRef,
+) (client.Object, **profilebasev1alpha1.ViolationSummary, error) {
+	key := util.NamespacedName(ref.name, ref.namespace)
+
+	switch ref.kind {
+	case kindSeccompProfile:
+		profile := &seccompprofileapi.SeccompProfile{}
+		if err := e.client.Get(ctx, key, profile); err != nil {
+			return nil, nil, fmt.Errorf("get %s: %w", ref, err)
+		}
+		return profile, &profile.Status.Violations, nil
+
+	case kindClusterSeccompProfile:
+		profile := &seccompprofileapi.ClusterSeccompProfile{}
+		if err := e.client.Get(ctx, key, profile); err != nil {
+			return nil, nil, fmt.Errorf("get %s: %w", ref, err)
+		}
+		return profile, &profile.Status.Violations, nil
+	}
+
+	profile := &selinuxprofileapi.SelinuxProfile{}
+	err := e.client.Get(ctx, key, profile)
+	if err == nil {
+		return profile, &profile.Status.Violations, nil
+	} else if !kerrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("get %s: %w", ref, err)
+	}
+
+	rawProfile := &selinuxprofileapi.RawSelinuxProfile{}
+	if err := e.client.Get(ctx, key, rawProfile); err != nil {
+		return nil, nil, fmt.Errorf("get Raw%s: %w", ref, err)
+	}
+	return rawProfile, &rawProfile.Status.Violations, nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+func TestSeccompProfileRef(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		localhostProfile string
+		want             profileRef
+		wantOk           bool
+	}{
+		{
+			localhostProfile: "operator/namespace/profile.json",
+			want:             profileRef{kind: kindSeccompProfile, namespace: "namespace", name: "profile"},
+			wantOk:           true,
+		},
+		{
+			localhostProfile: "operator/_cluster/profile.json",
+			want:             profileRef{kind: kindClusterSeccompProfile, name: "profile"},
+			wantOk:           true,
+		},
+		{localhostProfile: "RuntimeDefault"},
+		{localhostProfile: "custom/namespace/profile.json"},
+		{localhostProfile: "operator/namespace/profile"},
+	} {
+		ref, ok := seccompProfileRef(tc.localhostProfile)
+		require.Equal(t, tc.wantOk, ok, tc.localhostProfile)
+		require.Equal(t, tc.want, ref, tc.localhostProfile)
+	}
+}
+
+func TestSelinuxProfileRef(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		scontext string
+		want     profileRef
+		wantOk   bool
+	}{
+		{
+			scontext: "system_u:system_r:errorlogger_my-namespace.process:s0:c4,c808",
+			want:     profileRef{kind: kindSelinuxProfile, namespace: "my-namespace", name: "errorlogger"},
+			wantOk:   true,
+		},
+		{scontext: "system_u:system_r:container_t:s0:c4,c808"},
+		{scontext: "system_u:system_r:errorlogger.process:s0"},
+		{scontext: "unconfined"},
+	} {
+		ref, ok := selinuxProfileRef(tc.scontext)
+		require.Equal(t, tc.wantOk, ok, tc.scontext)
+		require.Equal(t, tc.want, ref, tc.scontext)
+	}
+}
+
+func TestReportProfileViolation(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	recorder := record.NewFakeRecorder(10)
+	sut.recorder = recorder
+
+	info := &types.ContainerInfo{
+		PodName:        pod,
+		Namespace:      namespace,
+		ContainerName:  "container",
+		SeccompProfile: "operator/" + namespace + "/profile.json",
+	}
+	line := &types.AuditLine{
+		AuditType:    types.AuditTypeSeccomp,
+		TimestampID:  "1624537480.360:8477",
+		SystemCallID: 10,
+		Code:         "0x50001",
+	}
+
+	// Logged syscalls are no violations
+	sut.dispatchSeccompLine(nil, node, &types.AuditLine{
+		AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Code: seccompActionLog,
+	}, info)
+	require.Empty(t, sut.profileViolations)
+
+	// The event is only emitted once for the same denial
+	sut.dispatchSeccompLine(nil, node, line, info)
+	sut.dispatchSeccompLine(nil, node, line, info)
+	require.Len(t, recorder.Events, 1)
+	require.Contains(t, <-recorder.Events, "Warning ProfileViolation SeccompProfile "+namespace+"/profile denied syscall "+syscall)
+
+	ref := profileRef{kind: kindSeccompProfile, namespace: namespace, name: "profile"}
+	require.Len(t, sut.profileViolations, 1)
+	require.EqualValues(t, 2, sut.profileViolations[ref].Count)
+	require.Equal(t, "syscall "+syscall, sut.profileViolations[ref].LastDenied)
+	require.Equal(t, namespace+"/"+pod, sut.profileViolations[ref].LastPod)
+	require.True(t, sut.profileViolations[ref].LastSeen.Equal(&metav1.Time{Time: time.UnixMilli(1624537480360)}))
+
+	// Recorded containers are ignored
+	info.RecordProfile = "recording"
+	sut.dispatchSeccompLine(nil, node, line, info)
+	require.EqualValues(t, 2, sut.profileViolations[ref].Count)
+}
+
+func TestUpdateProfileViolations(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+	require.NoError(t, selinuxprofileapi.AddToScheme(scheme))
+
+	lastSeen := metav1.NewTime(time.UnixMilli(1624537480360).UTC())
+	seccompProfile := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: namespace},
+		Status: seccompprofileapi.SeccompProfileStatus{
+			Violations: &profilebasev1alpha1.ViolationSummary{
+				Count:      3,
+				LastDenied: "syscall read",
+				LastPod:    namespace + "/other",
+				LastSeen:   lastSeen,
+			},
+		},
+	}
+	selinuxProfile := &selinuxprofileapi.SelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: namespace},
+	}
+
+	rawSelinuxProfile := &selinuxprofileapi.RawSelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "raw", Namespace: namespace},
+	}
+
+	sut := New(logr.Discard())
+	sut.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		seccompProfile, selinuxProfile, rawSelinuxProfile,
+	).Build()
+
+	// An older denial only increases the count
+	ctx := context.Background()
+	require.NoError(t, sut.updateProfileViolations(ctx,
+		profileRef{kind: kindSeccompProfile, namespace: namespace, name: "profile"},
+		&profilebasev1alpha1.ViolationSummary{
+			Count:      2,
+			LastDenied: "syscall write",
+			LastPod:    namespace + "/" + pod,
+			LastSeen:   metav1.NewTime(lastSeen.Add(-time.Second)),
+		},
+	))
+	require.NoError(t, sut.client.Get(ctx, util.NamespacedName("profile", namespace), seccompProfile))
+	require.EqualValues(t, 5, seccompProfile.Status.Violations.Count)
+	require.Equal(t, "syscall read", seccompProfile.Status.Violations.LastDenied)
+
+	// A newer denial gets set
+	selinuxRef := profileRef{kind: kindSelinuxProfile, namespace: namespace, name: "profile"}
+	require.NoError(t, sut.updateProfileViolations(ctx, selinuxRef, &profilebasev1alpha1.ViolationSummary{
+		Count:      1,
+		LastDenied: "{ read } on file system_u:object_r:var_lib_t:s0",
+		LastPod:    namespace + "/" + pod,
+		LastSeen:   lastSeen,
+	}))
+	require.NoError(t, sut.client.Get(ctx, util.NamespacedName("profile", namespace), selinuxProfile))
+	require.EqualValues(t, 1, selinuxProfile.Status.Violations.Count)
+	require.Equal(t, namespace+"/"+pod, selinuxProfile.Status.Violations.LastPod)
+
+	// RawSelinuxProfiles use the same SELinux types as SelinuxProfiles
+	rawRef := profileRef{kind: kindSelinuxProfile, namespace: namespace, name: "raw"}
+	require.NoError(t, sut.updateProfileViolations(ctx, rawRef, &profilebasev1alpha1.ViolationSummary{
+		Count:      4,
+		LastDenied: "{ write } on file system_u:object_r:var_lib_t:s0",
+		LastPod:    namespace + "/" + pod,
+		LastSeen:   lastSeen,
+	}))
+	require.NoError(t, sut.client.Get(ctx, util.NamespacedName("raw", namespace), rawSelinuxProfile))
+	require.EqualValues(t, 4, rawSelinuxProfile.Status.Violations.Count)
+
+	// Unmanaged profiles are ignored
+	require.NoError(t, sut.updateProfileViolations(ctx,
+		profileRef{kind: kindSelinuxProfile, namespace: namespace, name: "unknown"},
+		&profilebasev1alpha1.ViolationSummary{Count: 1},
+	))
+	require.NoError(t, sut.updateProfileViolations(ctx,
+		profileRef{kind: kindClusterSeccompProfile, name: "unknown"},
+		&profilebasev1alpha1.ViolationSummary{Count: 1},
+	))
+}
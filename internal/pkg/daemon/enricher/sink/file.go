@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// fileSink appends the events as JSON lines to a file.
+type fileSink struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	const perm = 0o640
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, perm)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	return &fileSink{file: file}, nil
+}
+
+func (f *fileSink) Export(_ context.Context, events []*Event) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	info, err := f.file.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("stat file: %w", err)
+	}
+	size := info.Size()
+
+	for i, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			return i, fmt.Errorf("marshal event: %w", err)
+		}
+		line = append(line, '\n')
+
+		n, err := f.file.Write(line)
+		if err != nil {
+			// Remove the partially written line, because the event gets
+			// written again on retry.
+			if n > 0 {
+				if truncateErr := f.file.Truncate(size); truncateErr != nil {
+					return i, fmt.Errorf("write event: %w, truncate file: %w", err, truncateErr)
+				}
+			}
+			return i, fmt.Errorf("write event: %w", err)
+		}
+		size += int64(n)
+	}
+	return len(events), nil
+}
+
+func (f *fileSink) Close() error {
+	return f.file.Close()
+}
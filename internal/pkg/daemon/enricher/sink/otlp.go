@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+const (
+	otlpScopeName   = "security-profiles-operator/log-enricher"
+	otlpServiceName = "security-profiles-operator"
+
+	// otlpSeverityWarn is the OpenTelemetry severity number for warnings.
+	otlpSeverityWarn = 13
+)
+
+// otlpSink sends the events as OpenTelemetry logs by using the JSON
+// encoding of OTLP/HTTP.
+type otlpSink struct {
+	endpoint string
+	client   *http.Client
+}
+
+func newOTLPSink(endpoint string) *otlpSink {
+	return &otlpSink{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (o *otlpSink) Export(ctx context.Context, events []*Event) (int, error) {
+	if err := postJSON(ctx, o.client, o.endpoint, otlpLogsRequest(events)); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+func (o *otlpSink) Close() error {
+	o.client.CloseIdleConnections()
+	return nil
+}
+
+// The following types are the JSON representation of the
+// ExportLogsServiceRequest message of the OTLP protocol.
+type (
+	otlpRequest struct {
+		ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+	}
+
+	otlpResourceLogs struct {
+		Resource  otlpResource    `json:"resource"`
+		ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	}
+
+	otlpScopeLogs struct {
+		Scope      otlpScope       `json:"scope"`
+		LogRecords []otlpLogRecord `json:"logRecords"`
+	}
+
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+
+	otlpLogRecord struct {
+		TimeUnixNano   string         `json:"timeUnixNano"`
+		SeverityNumber int            `json:"severityNumber"`
+		SeverityText   string         `json:"severityText"`
+		Body           otlpAnyValue   `json:"body"`
+		Attributes     []otlpKeyValue `json:"attributes"`
+	}
+
+	otlpKeyValue struct {
+		Key   string       `json:"key"`
+		Value otlpAnyValue `json:"value"`
+	}
+
+	otlpAnyValue struct {
+		StringValue *string `json:"stringValue,omitempty"`
+		IntValue    *string `json:"intValue,omitempty"`
+	}
+)
+
+// otlpLogsRequest converts the events into OpenTelemetry log records, which
+// are grouped by node.
+func otlpLogsRequest(events []*Event) *otlpRequest {
+	req := &otlpRequest{}
+	nodes := map[string]int{}
+
+	for _, event := range events {
+		i, ok := nodes[event.Node]
+		if !ok {
+			req.ResourceLogs = append(req.ResourceLogs, otlpResourceLogs{
+				Resource: otlpResource{Attributes: []otlpKeyValue{
+					otlpString("service.name", otlpServiceName),
+					otlpString("k8s.node.name", event.Node),
+				}},
+				ScopeLogs: []otlpScopeLogs{{Scope: otlpScope{Name: otlpScopeName}}},
+			})
+			i = len(req.ResourceLogs) - 1
+			nodes[event.Node] = i
+		}
+
+		attributes := []otlpKeyValue{
+			otlpString("spo.audit.type", event.Type),
+			otlpString("k8s.namespace.name", event.Namespace),
+			otlpString("k8s.pod.name", event.Pod),
+			otlpString("k8s.container.name", event.Container),
+		}
+		for _, attr := range []struct{ key, value string }{
+			{"process.executable.path", event.Executable},
+			{"spo.syscall", event.Syscall},
+			{"spo.profile", event.Profile},
+			{"spo.selinux.perm", event.Perm},
+			{"spo.selinux.tcontext", event.Tcontext},
+			{"spo.selinux.tclass", event.Tclass},
+			{"spo.apparmor", event.Apparmor},
+			{"spo.apparmor.operation", event.Operation},
+			{"spo.apparmor.name", event.Name},
+		} {
+			if attr.value != "" {
+				attributes = append(attributes, otlpString(attr.key, attr.value))
+			}
+		}
+		if event.PID != 0 {
+			pid := strconv.Itoa(event.PID)
+			attributes = append(attributes, otlpKeyValue{
+				Key: "process.pid", Value: otlpAnyValue{IntValue: &pid},
+			})
+		}
+
+		body := event.Type
+		scopeLogs := &req.ResourceLogs[i].ScopeLogs[0]
+		scopeLogs.LogRecords = append(scopeLogs.LogRecords, otlpLogRecord{
+			TimeUnixNano:   strconv.FormatInt(event.Timestamp.UnixNano(), 10),
+			SeverityNumber: otlpSeverityWarn,
+			SeverityText:   "WARN",
+			Body:           otlpAnyValue{StringValue: &body},
+			Attributes:     attributes,
+		})
+	}
+
+	return req
+}
+
+func otlpString(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: &value}}
+}
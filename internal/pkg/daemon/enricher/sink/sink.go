@@ -0,0 +1,269 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sink exports enriched audit events to external systems.
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	// TypeFile appends the events as JSON lines to a file.
+	TypeFile = "File"
+
+	// TypeWebhook sends the events as JSON array via HTTP POST.
+	TypeWebhook = "Webhook"
+
+	// TypeOTLP sends the events as OpenTelemetry logs via OTLP/HTTP.
+	TypeOTLP = "OTLP"
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultFlushInterval = 5 * time.Second
+	defaultMaxRetries    = 3
+	defaultQueueSize     = 10000
+
+	retryBackoffDuration = 500 * time.Millisecond
+	retryBackoffFactor   = 2
+)
+
+// ErrInvalidConfig is returned if a sink is configured incorrectly.
+var ErrInvalidConfig = errors.New("invalid sink configuration")
+
+// Event is an enriched audit event.
+type Event struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Type       string    `json:"type"`
+	Node       string    `json:"node"`
+	Namespace  string    `json:"namespace"`
+	Pod        string    `json:"pod"`
+	Container  string    `json:"container"`
+	Executable string    `json:"executable,omitempty"`
+	PID        int       `json:"pid,omitempty"`
+	Syscall    string    `json:"syscall,omitempty"`
+	Profile    string    `json:"profile,omitempty"`
+
+	// SELinux specific fields.
+	Perm     string `json:"perm,omitempty"`
+	Tcontext string `json:"tcontext,omitempty"`
+	Tclass   string `json:"tclass,omitempty"`
+
+	// AppArmor specific fields.
+	Apparmor  string `json:"apparmor,omitempty"`
+	Operation string `json:"operation,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Sink exports batches of events.
+type Sink interface {
+	// Export exports a batch of events. It returns the number of leading
+	// events which have been exported completely, also on failure, so that
+	// only the remaining events get retried.
+	Export(ctx context.Context, events []*Event) (int, error)
+
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+// Config is the configuration of a single sink.
+type Config struct {
+	// Type is the type of the sink, either File, Webhook or OTLP.
+	Type string `json:"type"`
+
+	// Path is the file to write to for the File sink.
+	Path string `json:"path,omitempty"`
+
+	// Endpoint is the URL for the Webhook and OTLP sinks.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// BatchSize is the maximum number of events exported at once.
+	BatchSize int `json:"batchSize,omitempty"`
+
+	// FlushInterval is the maximum time an event waits for its batch to be
+	// exported.
+	FlushInterval metav1.Duration `json:"flushInterval,omitempty"`
+
+	// MaxRetries is the number of retries for exporting a batch before it
+	// gets dropped.
+	MaxRetries *int `json:"maxRetries,omitempty"`
+}
+
+// Exporter batches events and exports them to all configured sinks.
+type Exporter struct {
+	logger    logr.Logger
+	exporters []*exporter
+}
+
+// exporter batches the events of a single sink.
+type exporter struct {
+	Sink
+	logger        logr.Logger
+	events        chan *Event
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+}
+
+// New creates an Exporter for the provided sink configurations.
+func New(logger logr.Logger, configs []Config) (*Exporter, error) {
+	e := &Exporter{logger: logger}
+
+	for i := range configs {
+		cfg := &configs[i]
+		sink, err := newSink(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("create %s sink: %w", cfg.Type, err)
+		}
+
+		exp := &exporter{
+			Sink:          sink,
+			logger:        logger.WithValues("sink", cfg.Type),
+			events:        make(chan *Event, defaultQueueSize),
+			batchSize:     cfg.BatchSize,
+			flushInterval: cfg.FlushInterval.Duration,
+			maxRetries:    defaultMaxRetries,
+		}
+		if exp.batchSize <= 0 {
+			exp.batchSize = defaultBatchSize
+		}
+		if exp.flushInterval <= 0 {
+			exp.flushInterval = defaultFlushInterval
+		}
+		if cfg.MaxRetries != nil && *cfg.MaxRetries >= 0 {
+			exp.maxRetries = *cfg.MaxRetries
+		}
+		e.exporters = append(e.exporters, exp)
+	}
+
+	return e, nil
+}
+
+func newSink(cfg *Config) (Sink, error) {
+	switch cfg.Type {
+	case TypeFile:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("%w: path required", ErrInvalidConfig)
+		}
+		if !filepath.IsAbs(cfg.Path) {
+			return nil, fmt.Errorf("%w: path %q is not absolute", ErrInvalidConfig, cfg.Path)
+		}
+		return newFileSink(cfg.Path)
+
+	case TypeWebhook, TypeOTLP:
+		if cfg.Endpoint == "" {
+			return nil, fmt.Errorf("%w: endpoint required", ErrInvalidConfig)
+		}
+		if cfg.Type == TypeWebhook {
+			return newWebhookSink(cfg.Endpoint), nil
+		}
+		return newOTLPSink(cfg.Endpoint), nil
+
+	default:
+		return nil, fmt.Errorf(
+			"%w: unknown type %q: expected %s, %s or %s",
+			ErrInvalidConfig, cfg.Type, TypeFile, TypeWebhook, TypeOTLP,
+		)
+	}
+}
+
+// Start exports the events to the sinks until the context is done. The
+// remaining events are flushed before closing the sinks.
+func (e *Exporter) Start(ctx context.Context) {
+	for _, exp := range e.exporters {
+		go exp.run(ctx)
+	}
+}
+
+// Export queues the event for all sinks. The event gets dropped for sinks
+// which do not keep up.
+func (e *Exporter) Export(event *Event) {
+	for _, exp := range e.exporters {
+		select {
+		case exp.events <- event:
+		default:
+			exp.logger.Info("Dropping event because of a full queue")
+		}
+	}
+}
+
+func (e *exporter) run(ctx context.Context) {
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, e.batchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			// Flush the already queued events as well.
+			for len(e.events) > 0 {
+				batch = append(batch, <-e.events)
+			}
+			e.flush(context.Background(), batch)
+			if err := e.Close(); err != nil {
+				e.logger.Error(err, "Unable to close sink")
+			}
+			return
+
+		case event := <-e.events:
+			batch = append(batch, event)
+			if len(batch) >= e.batchSize {
+				e.flush(ctx, batch)
+				batch = batch[:0]
+			}
+
+		case <-ticker.C:
+			e.flush(ctx, batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+// flush exports the batch and retries the events which have not been
+// exported yet with an exponential backoff on failure. They get dropped if
+// all retries fail.
+func (e *exporter) flush(ctx context.Context, batch []*Event) {
+	if len(batch) == 0 {
+		return
+	}
+
+	backoff := wait.Backoff{
+		Duration: retryBackoffDuration,
+		Factor:   retryBackoffFactor,
+		Steps:    e.maxRetries + 1,
+	}
+	remaining := batch
+	if err := util.RetryEx(&backoff, func() error {
+		exported, err := e.Export(ctx, remaining)
+		remaining = remaining[exported:]
+		return err
+	}, func(error) bool {
+		return ctx.Err() == nil
+	}); err != nil {
+		e.logger.Error(err, "Unable to export events, dropping them", "count", len(remaining))
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testEvent() *Event {
+	return &Event{
+		Timestamp:  time.UnixMilli(1624537480360),
+		Type:       "seccomp",
+		Node:       "node",
+		Namespace:  "namespace",
+		Pod:        "pod",
+		Container:  "container",
+		Executable: "/bin/busybox",
+		PID:        42,
+		Syscall:    "mprotect",
+		Profile:    "localhost/profile.json",
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		config    Config
+		shouldErr bool
+	}{
+		{
+			name:   "file",
+			config: Config{Type: TypeFile, Path: filepath.Join(t.TempDir(), "events.jsonl")},
+		},
+		{
+			name:      "file without path",
+			config:    Config{Type: TypeFile},
+			shouldErr: true,
+		},
+		{
+			name:      "file with relative path",
+			config:    Config{Type: TypeFile, Path: "events.jsonl"},
+			shouldErr: true,
+		},
+		{
+			name:   "webhook",
+			config: Config{Type: TypeWebhook, Endpoint: "http://localhost"},
+		},
+		{
+			name:      "webhook without endpoint",
+			config:    Config{Type: TypeWebhook},
+			shouldErr: true,
+		},
+		{
+			name:      "otlp without endpoint",
+			config:    Config{Type: TypeOTLP},
+			shouldErr: true,
+		},
+		{
+			name:      "unknown type",
+			config:    Config{Type: "wrong"},
+			shouldErr: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(logr.Discard(), []Config{tc.config})
+			if tc.shouldErr {
+				require.ErrorIs(t, err, ErrInvalidConfig)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sut, err := New(logr.Discard(), []Config{{
+		Type:          TypeFile,
+		Path:          path,
+		BatchSize:     2,
+		FlushInterval: metav1.Duration{Duration: time.Hour},
+	}})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sut.Start(ctx)
+	for i := 0; i < 3; i++ {
+		sut.Export(testEvent())
+	}
+
+	// The first batch gets flushed because of its size, the remaining event
+	// on shutdown.
+	require.Eventually(t, func() bool {
+		content, err := os.ReadFile(path)
+		return err == nil && len(content) > 0
+	}, time.Minute, 10*time.Millisecond)
+	cancel()
+
+	require.Eventually(t, func() bool {
+		file, err := os.Open(path)
+		require.NoError(t, err)
+		defer file.Close()
+
+		lines := 0
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			event := &Event{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), event))
+			require.Equal(t, testEvent().Syscall, event.Syscall)
+			require.True(t, testEvent().Timestamp.Equal(event.Timestamp))
+			lines++
+		}
+		return lines == 3
+	}, time.Minute, 10*time.Millisecond)
+}
+
+func TestWebhookSink(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutex    sync.Mutex
+		requests int
+		received []*Event
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		// Fail the first request to test the retry
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		events := []*Event{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&events))
+		received = append(received, events...)
+	}))
+	defer server.Close()
+
+	maxRetries := 1
+	sut, err := New(logr.Discard(), []Config{{
+		Type:          TypeWebhook,
+		Endpoint:      server.URL,
+		FlushInterval: metav1.Duration{Duration: 10 * time.Millisecond},
+		MaxRetries:    &maxRetries,
+	}})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sut.Start(ctx)
+	sut.Export(testEvent())
+
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(received) == 1
+	}, time.Minute, 10*time.Millisecond)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.Equal(t, 2, requests)
+	require.Equal(t, testEvent().Pod, received[0].Pod)
+	require.Equal(t, testEvent().Profile, received[0].Profile)
+}
+
+func TestOTLPLogsRequest(t *testing.T) {
+	t.Parallel()
+
+	other := testEvent()
+	other.Node = "other"
+	other.PID = 0
+	req := otlpLogsRequest([]*Event{testEvent(), testEvent(), other})
+
+	require.Len(t, req.ResourceLogs, 2)
+	require.Len(t, req.ResourceLogs[0].ScopeLogs[0].LogRecords, 2)
+	require.Len(t, req.ResourceLogs[1].ScopeLogs[0].LogRecords, 1)
+	require.Equal(t, "other", *req.ResourceLogs[1].Resource.Attributes[1].Value.StringValue)
+
+	record := req.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	require.Equal(t, "1624537480360000000", record.TimeUnixNano)
+	require.Equal(t, "seccomp", *record.Body.StringValue)
+
+	attributes := map[string]otlpAnyValue{}
+	for _, attr := range record.Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	require.Equal(t, "pod", *attributes["k8s.pod.name"].StringValue)
+	require.Equal(t, "mprotect", *attributes["spo.syscall"].StringValue)
+	require.Equal(t, "localhost/profile.json", *attributes["spo.profile"].StringValue)
+	require.Equal(t, "42", *attributes["process.pid"].IntValue)
+	require.NotContains(t, attributes, "spo.selinux.perm")
+
+	for _, attr := range req.ResourceLogs[1].ScopeLogs[0].LogRecords[0].Attributes {
+		require.NotEqual(t, "process.pid", attr.Key)
+	}
+}
+
+// partialSink exports a single event per call and fails afterwards.
+type partialSink struct {
+	exported []*Event
+}
+
+func (p *partialSink) Export(_ context.Context, events []*Event) (int, error) {
+	p.exported = append(p.exported, events[0])
+	if len(events) > 1 {
+		return 1, errors.New("partial export")
+	}
+	return 1, nil
+}
+
+func (p *partialSink) Close() error {
+	return nil
+}
+
+func TestFlushRetriesRemainingEvents(t *testing.T) {
+	t.Parallel()
+
+	sink := &partialSink{}
+	sut := &exporter{Sink: sink, logger: logr.Discard(), maxRetries: 2}
+	batch := []*Event{testEvent(), testEvent(), testEvent()}
+
+	sut.flush(context.Background(), batch)
+	require.Equal(t, batch, sink.exported)
+
+	// The remaining events get dropped once all retries failed
+	sink.exported = nil
+	sut.maxRetries = 0
+	sut.flush(context.Background(), batch)
+	require.Equal(t, batch[:1], sink.exported)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const httpTimeout = 30 * time.Second
+
+// errUnexpectedStatus is returned if an HTTP endpoint does not accept the
+// events.
+var errUnexpectedStatus = errors.New("unexpected HTTP status")
+
+// webhookSink sends the events as JSON array via HTTP POST.
+type webhookSink struct {
+	endpoint string
+	client   *http.Client
+}
+
+func newWebhookSink(endpoint string) *webhookSink {
+	return &webhookSink{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (w *webhookSink) Export(ctx context.Context, events []*Event) (int, error) {
+	if err := postJSON(ctx, w.client, w.endpoint, events); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+func (w *webhookSink) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
+
+// postJSON sends the JSON encoded payload to the endpoint.
+func postJSON(ctx context.Context, client *http.Client, endpoint string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body to be able to reuse the connection.
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s", errUnexpectedStatus, resp.Status)
+	}
+	return nil
+}
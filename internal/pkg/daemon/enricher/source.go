@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nxadm/tail"
+)
+
+const (
+	// LogSourceFile tails the audit log file or falls back to syslog.
+	LogSourceFile = "File"
+
+	// LogSourceJournald follows the audit and kernel messages of the systemd
+	// journal.
+	LogSourceJournald = "Journald"
+
+	// LogSourceAuditNetlink listens to the audit netlink multicast group of
+	// the kernel, which works without auditd and syslog.
+	LogSourceAuditNetlink = "AuditNetlink"
+)
+
+// ErrInvalidLogSource is returned if an unknown log source is used.
+var ErrInvalidLogSource = errors.New("invalid log source")
+
+// errLogSourceClosed is returned if a log source stops providing lines.
+var errLogSourceClosed = errors.New("log source closed")
+
+// UseLogSource configures the source of the audit lines, which has to be
+// one of LogSourceFile, LogSourceJournald or LogSourceAuditNetlink.
+func (e *Enricher) UseLogSource(source string) error {
+	switch source {
+	case LogSourceFile, LogSourceJournald, LogSourceAuditNetlink:
+		e.logSource = source
+		return nil
+	default:
+		return fmt.Errorf(
+			"%w: %q: expected %s, %s or %s",
+			ErrInvalidLogSource, source, LogSourceFile, LogSourceJournald, LogSourceAuditNetlink,
+		)
+	}
+}
+
+// openLogSource starts the configured log source and returns its lines
+// together with a function to retrieve the reason why the lines have been
+// closed.
+func (e *Enricher) openLogSource() (lines chan *tail.Line, reason func() error, err error) {
+	switch e.logSource {
+	case LogSourceJournald:
+		e.logger.Info("Reading from the systemd journal")
+		lines, err = e.FollowJournal()
+		if err != nil {
+			return nil, nil, fmt.Errorf("follow journal: %w", err)
+		}
+		return lines, e.logSourceClosed, nil
+
+	case LogSourceAuditNetlink:
+		e.logger.Info("Reading from the audit netlink socket")
+		lines, err = e.ListenAuditNetlink()
+		if err != nil {
+			return nil, nil, fmt.Errorf("listen to audit netlink socket: %w", err)
+		}
+		return lines, e.logSourceClosed, nil
+	}
+
+	// Use auditd logs as main source or syslog as fallback.
+	filePath := LogFilePath()
+
+	// If the file does not exist, then tail will wait for it to appear
+	tailFile, err := e.TailFile(
+		filePath,
+		tail.Config{
+			ReOpen: true,
+			Follow: true,
+			Location: &tail.SeekInfo{
+				Offset: 0,
+				Whence: io.SeekEnd,
+			},
+		},
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tailing file: %w", err)
+	}
+
+	e.logger.Info("Reading from file " + filePath)
+	return e.Lines(tailFile), func() error { return e.Reason(tailFile) }, nil
+}
+
+func (e *Enricher) logSourceClosed() error {
+	return fmt.Errorf("%w: %s", errLogSourceClosed, e.logSource)
+}
+
+// journalEntryLine converts a JSON journal entry into an audit log line. It
+// returns an empty line for entries without a message.
+func journalEntryLine(entry []byte) (string, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(entry, &fields); err != nil {
+		return "", fmt.Errorf("unmarshal journal entry: %w", err)
+	}
+
+	// Binary fields are encoded as array of bytes, which are not relevant
+	// for the enricher.
+	field := func(name string) string {
+		var value string
+		if err := json.Unmarshal(fields[name], &value); err != nil {
+			return ""
+		}
+		return value
+	}
+
+	message := field("MESSAGE")
+	if message == "" {
+		return "", nil
+	}
+
+	// Kernel messages are already in the syslog format, like:
+	// audit: type=1326 audit(1624537480.360:8477): auid=4294967295 ...
+	if field("_TRANSPORT") != "audit" {
+		return message, nil
+	}
+
+	// Audit messages are stored without their type and timestamp, like:
+	// SECCOMP auid=4294967295 ...
+	// with the type name and record ID stored in dedicated fields.
+	const (
+		microsPerSecond = 1000000
+		microsPerMilli  = 1000
+	)
+	micros, err := strconv.ParseInt(field("_SOURCE_REALTIME_TIMESTAMP"), 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parse journal entry timestamp: %w", err)
+	}
+	_, message, _ = strings.Cut(message, " ")
+
+	return fmt.Sprintf(
+		"type=%s msg=audit(%d.%03d:%s): %s",
+		field("_AUDIT_TYPE_NAME"),
+		micros/microsPerSecond,
+		micros%microsPerSecond/microsPerMilli,
+		field("_AUDIT_ID"),
+		message,
+	), nil
+}
+
+// auditTypeNames are the names of the audit message types relevant for the
+// enricher, as printed by auditd.
+var auditTypeNames = map[uint16]string{
+	1107: "USER_AVC",
+	1300: "SYSCALL",
+	1302: "PATH",
+	1307: "CWD",
+	1309: "EXECVE",
+	1320: "EOE",
+	1326: "SECCOMP",
+	1327: "PROCTITLE",
+	1400: "AVC",
+	1500: "APPARMOR",
+	1501: "APPARMOR_AUDIT",
+	1502: "APPARMOR_ALLOWED",
+	1503: "APPARMOR_DENIED",
+	1504: "APPARMOR_HINT",
+	1505: "APPARMOR_STATUS",
+	1506: "APPARMOR_ERROR",
+	1507: "APPARMOR_KILL",
+}
+
+// auditNetlinkLine converts the data of an audit netlink message into an
+// audit log line, in the same format as written by auditd.
+func auditNetlinkLine(msgType uint16, data []byte) string {
+	name, ok := auditTypeNames[msgType]
+	if !ok {
+		name = fmt.Sprintf("UNKNOWN[%d]", msgType)
+	}
+	return fmt.Sprintf("type=%s msg=%s", name, strings.TrimRight(string(data), "\x00\n"))
+}
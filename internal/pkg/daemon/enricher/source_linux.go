@@ -0,0 +1,256 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"github.com/nxadm/tail"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// hostRoot is the root file system of the host, which is accessible
+	// because the enricher runs in the host PID namespace.
+	hostRoot = "/proc/1/root"
+
+	// journalBufferSize is the maximum size of a single journal entry.
+	journalBufferSize = 1024 * 1024
+)
+
+// journalctlPaths are the locations of the journalctl binary on the host.
+var journalctlPaths = []string{"/usr/bin/journalctl", "/bin/journalctl"}
+
+// followJournal runs the journalctl binary of the host to follow the audit
+// and kernel messages of the systemd journal. Every entry is converted into
+// an audit log line.
+func followJournal() (chan *tail.Line, error) {
+	journalctl := ""
+	for _, path := range journalctlPaths {
+		if _, err := os.Stat(filepath.Join(hostRoot, path)); err == nil {
+			journalctl = path
+			break
+		}
+	}
+	if journalctl == "" {
+		return nil, fmt.Errorf("journalctl not found in %s", hostRoot)
+	}
+
+	//nolint:gosec // the arguments are not user controlled
+	cmd := exec.Command(
+		journalctl,
+		"--follow",
+		"--lines=0",
+		"--output=json",
+		"_TRANSPORT=audit",
+		"_TRANSPORT=kernel",
+	)
+	cmd.SysProcAttr = &unix.SysProcAttr{Chroot: hostRoot}
+	cmd.Dir = "/"
+	cmd.Stderr = os.Stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("create journalctl stdout pipe: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("start journalctl: %w", err)
+	}
+
+	lines := make(chan *tail.Line)
+	go func() {
+		defer close(lines)
+
+		scanner := bufio.NewScanner(stdout)
+		scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), journalBufferSize)
+		for scanner.Scan() {
+			line, err := journalEntryLine(scanner.Bytes())
+			if err != nil {
+				lines <- &tail.Line{Err: err, Time: time.Now()}
+				continue
+			}
+			if line != "" {
+				lines <- &tail.Line{Text: line, Time: time.Now()}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			lines <- &tail.Line{Err: fmt.Errorf("read journal: %w", err), Time: time.Now()}
+		}
+		if err := cmd.Wait(); err != nil {
+			lines <- &tail.Line{Err: fmt.Errorf("run journalctl: %w", err), Time: time.Now()}
+		}
+	}()
+
+	return lines, nil
+}
+
+const (
+	// hostNetworkNamespace is the network namespace of the host, which is
+	// the only one receiving the audit netlink multicast messages.
+	hostNetworkNamespace = "/proc/1/ns/net"
+
+	// auditNetlinkGroupReadLog is the audit netlink multicast group for
+	// reading the audit messages (AUDIT_NLGRP_READLOG).
+	auditNetlinkGroupReadLog = 1
+
+	// auditNetlinkBufferSize is the maximum size of an audit netlink message.
+	auditNetlinkBufferSize = 64 * 1024
+)
+
+// listenAuditNetlink subscribes to the audit netlink multicast group and
+// converts every received message into an audit log line. This requires the
+// CAP_AUDIT_READ capability.
+func listenAuditNetlink() (chan *tail.Line, error) {
+	fd, err := auditNetlinkSocket()
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(chan *tail.Line)
+	go func() {
+		defer close(lines)
+		defer unix.Close(fd)
+
+		buf := make([]byte, auditNetlinkBufferSize)
+		for {
+			n, _, err := unix.Recvfrom(fd, buf, 0)
+			if err != nil {
+				if errors.Is(err, unix.EINTR) || errors.Is(err, unix.ENOBUFS) {
+					// ENOBUFS indicates that messages got dropped
+					// because of a full socket buffer.
+					continue
+				}
+				lines <- &tail.Line{Err: fmt.Errorf("receive audit message: %w", err), Time: time.Now()}
+				return
+			}
+
+			for data := buf[:n]; len(data) >= unix.NLMSG_HDRLEN; {
+				//nolint:gosec // the buffer is large enough for the header
+				header := (*unix.NlMsghdr)(unsafe.Pointer(&data[0]))
+				length := int(header.Len)
+				if length < unix.NLMSG_HDRLEN || length > len(data) {
+					lines <- &tail.Line{Err: errInvalidAuditMessage, Time: time.Now()}
+					break
+				}
+				lines <- &tail.Line{
+					Text: auditNetlinkLine(header.Type, data[unix.NLMSG_HDRLEN:length]),
+					Time: time.Now(),
+				}
+				if length = nlmsgAlign(length); length > len(data) {
+					break
+				}
+				data = data[length:]
+			}
+		}
+	}()
+
+	return lines, nil
+}
+
+// errInvalidAuditMessage is returned if an audit netlink message is truncated.
+var errInvalidAuditMessage = errors.New("invalid audit netlink message")
+
+// auditNetlinkSocket creates the audit netlink socket in the host network
+// namespace. The namespace is only switched for a dedicated OS thread.
+func auditNetlinkSocket() (int, error) {
+	type result struct {
+		fd  int
+		err error
+	}
+	results := make(chan result)
+
+	go func() {
+		// The thread gets terminated on exit if it is not unlocked, which
+		// is the case when restoring the network namespace fails.
+		runtime.LockOSThread()
+
+		fd, restore, err := auditNetlinkSocketInHostNetwork()
+		results <- result{fd, err}
+		if restore {
+			runtime.UnlockOSThread()
+		}
+	}()
+
+	res := <-results
+	return res.fd, res.err
+}
+
+func auditNetlinkSocketInHostNetwork() (fd int, restored bool, err error) {
+	current, err := os.Open(fmt.Sprintf("/proc/self/task/%d/ns/net", unix.Gettid()))
+	if err != nil {
+		return -1, true, fmt.Errorf("open current network namespace: %w", err)
+	}
+	defer current.Close()
+
+	host, err := os.Open(hostNetworkNamespace)
+	if err != nil {
+		return -1, true, fmt.Errorf("open host network namespace: %w", err)
+	}
+	defer host.Close()
+
+	if err := setns(host); err != nil {
+		return -1, true, fmt.Errorf("enter host network namespace: %w", err)
+	}
+
+	fd, err = unix.Socket(
+		unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_AUDIT,
+	)
+	if err == nil {
+		if err = unix.Bind(fd, &unix.SockaddrNetlink{
+			Family: unix.AF_NETLINK,
+			Groups: auditNetlinkGroupReadLog,
+		}); err != nil {
+			unix.Close(fd)
+			err = fmt.Errorf("bind audit netlink socket: %w", err)
+		}
+	} else {
+		err = fmt.Errorf("create audit netlink socket: %w", err)
+	}
+
+	if restoreErr := setns(current); restoreErr != nil {
+		if err == nil {
+			unix.Close(fd)
+		}
+		return -1, false, fmt.Errorf("restore network namespace: %w", restoreErr)
+	}
+	if err != nil {
+		return -1, true, err
+	}
+
+	return fd, true, nil
+}
+
+// nlmsgAlign rounds the length of a netlink message up to its alignment.
+func nlmsgAlign(length int) int {
+	const alignTo = 4
+	return (length + alignTo - 1) &^ (alignTo - 1)
+}
+
+func setns(ns *os.File) error {
+	return unix.Setns(int(ns.Fd()), unix.CLONE_NEWNET)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+func TestUseLogSource(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	require.Equal(t, LogSourceFile, sut.logSource)
+
+	for _, source := range []string{LogSourceFile, LogSourceJournald, LogSourceAuditNetlink} {
+		require.NoError(t, sut.UseLogSource(source))
+		require.Equal(t, source, sut.logSource)
+	}
+
+	require.ErrorIs(t, sut.UseLogSource("wrong"), ErrInvalidLogSource)
+	require.Equal(t, LogSourceAuditNetlink, sut.logSource)
+}
+
+func TestJournalEntryLine(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		entry     string
+		want      string
+		wantType  string
+		shouldErr bool
+	}{
+		{
+			name: "audit transport",
+			//nolint:lll // no need to wrap
+			entry: `{"_TRANSPORT":"audit","_AUDIT_TYPE":"1326","_AUDIT_TYPE_NAME":"SECCOMP","_AUDIT_ID":"8477","_SOURCE_REALTIME_TIMESTAMP":"1624537480360123","MESSAGE":"SECCOMP auid=1000 uid=0 gid=0 ses=1 pid=2060394 comm=\"sleep\" exe=\"/bin/busybox\" sig=0 arch=c000003e syscall=10 compat=0 ip=0x5a0fa6 code=0x7ffc0000"}`,
+			//nolint:lll // no need to wrap
+			want:     `type=SECCOMP msg=audit(1624537480.360:8477): auid=1000 uid=0 gid=0 ses=1 pid=2060394 comm="sleep" exe="/bin/busybox" sig=0 arch=c000003e syscall=10 compat=0 ip=0x5a0fa6 code=0x7ffc0000`,
+			wantType: types.AuditTypeSeccomp,
+		},
+		{
+			name: "kernel transport",
+			//nolint:lll // no need to wrap
+			entry: `{"_TRANSPORT":"kernel","MESSAGE":"audit: type=1326 audit(1611996299.149:466250): auid=4294967295 uid=0 gid=0 ses=4294967295 pid=615549 comm=\"sh\" exe=\"/bin/busybox\" sig=0 arch=c000003e syscall=1 compat=0 ip=0x7f61a81c5923 code=0x7ffc0000"}`,
+			//nolint:lll // no need to wrap
+			want:     `audit: type=1326 audit(1611996299.149:466250): auid=4294967295 uid=0 gid=0 ses=4294967295 pid=615549 comm="sh" exe="/bin/busybox" sig=0 arch=c000003e syscall=1 compat=0 ip=0x7f61a81c5923 code=0x7ffc0000`,
+			wantType: types.AuditTypeSeccomp,
+		},
+		{
+			name:  "binary message",
+			entry: `{"_TRANSPORT":"kernel","MESSAGE":[97,117,100,105,116]}`,
+		},
+		{
+			name:      "invalid timestamp",
+			entry:     `{"_TRANSPORT":"audit","_AUDIT_TYPE_NAME":"SECCOMP","MESSAGE":"SECCOMP pid=1"}`,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid json",
+			entry:     `{`,
+			shouldErr: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			line, err := journalEntryLine([]byte(tc.entry))
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, line)
+
+			if tc.wantType != "" {
+				require.True(t, IsAuditLine(line))
+				auditLine, err := ExtractAuditLine(line)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantType, auditLine.AuditType)
+			}
+		})
+	}
+}
+
+func TestAuditNetlinkLine(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		msgType  uint16
+		data     string
+		want     string
+		wantType string
+	}{
+		{
+			name:    "seccomp",
+			msgType: 1326,
+			//nolint:lll // no need to wrap
+			data: "audit(1624537480.360:8477): auid=1000 uid=0 gid=0 ses=1 pid=2060394 comm=\"sleep\" exe=\"/bin/busybox\" sig=0 arch=c000003e syscall=10 compat=0 ip=0x5a0fa6 code=0x7ffc0000\x00",
+			//nolint:lll // no need to wrap
+			want:     `type=SECCOMP msg=audit(1624537480.360:8477): auid=1000 uid=0 gid=0 ses=1 pid=2060394 comm="sleep" exe="/bin/busybox" sig=0 arch=c000003e syscall=10 compat=0 ip=0x5a0fa6 code=0x7ffc0000`,
+			wantType: types.AuditTypeSeccomp,
+		},
+		{
+			name:    "selinux",
+			msgType: 1400,
+			//nolint:lll // no need to wrap
+			data: `audit(1613173578.156:2945): avc:  denied  { read } for  pid=75593 comm="security-profil" name="token" dev="tmpfs" ino=612459 scontext=system_u:system_r:container_t:s0:c4,c808 tcontext=system_u:object_r:var_lib_t:s0 tclass=lnk_file permissive=0`,
+			//nolint:lll // no need to wrap
+			want:     `type=AVC msg=audit(1613173578.156:2945): avc:  denied  { read } for  pid=75593 comm="security-profil" name="token" dev="tmpfs" ino=612459 scontext=system_u:system_r:container_t:s0:c4,c808 tcontext=system_u:object_r:var_lib_t:s0 tclass=lnk_file permissive=0`,
+			wantType: types.AuditTypeSelinux,
+		},
+		{
+			name:    "unknown type",
+			msgType: 1305,
+			data:    "audit(1613173578.156:2945): op=set audit_enabled=1\n",
+			want:    "type=UNKNOWN[1305] msg=audit(1613173578.156:2945): op=set audit_enabled=1",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			line := auditNetlinkLine(tc.msgType, []byte(tc.data))
+			require.Equal(t, tc.want, line)
+
+			if tc.wantType != "" {
+				auditLine, err := ExtractAuditLine(line)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantType, auditLine.AuditType)
+			}
+		})
+	}
+}
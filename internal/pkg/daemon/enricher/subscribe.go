@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+// subscriberBufferSize is the number of audit events buffered per subscriber
+// before events get dropped for it.
+const subscriberBufferSize = 1024
+
+// Subscribe streams every enriched audit line matching the request filters
+// until the client disconnects.
+func (e *Enricher) Subscribe(
+	r *api.SubscribeRequest, stream api.Enricher_SubscribeServer,
+) error {
+	events := make(chan *api.AuditEvent, subscriberBufferSize)
+	e.subscribersMutex.Lock()
+	e.subscribers[events] = r
+	e.subscribersMutex.Unlock()
+
+	defer func() {
+		e.subscribersMutex.Lock()
+		delete(e.subscribers, events)
+		e.subscribersMutex.Unlock()
+	}()
+
+	e.logger.Info("Subscribing to audit events",
+		"namespace", r.Namespace,
+		"pod", r.Pod,
+		"type", r.Type,
+		"profile", r.Profile,
+	)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return fmt.Errorf("send audit event: %w", err)
+			}
+		}
+	}
+}
+
+// hasSubscribers returns true if at least one client is subscribed to audit
+// events.
+func (e *Enricher) hasSubscribers() bool {
+	e.subscribersMutex.RLock()
+	defer e.subscribersMutex.RUnlock()
+	return len(e.subscribers) > 0
+}
+
+// publishAuditLine sends the enriched audit line to all matching
+// subscribers. The event gets dropped for subscribers which do not keep up.
+func (e *Enricher) publishAuditLine(
+	nodeName string, auditLine *types.AuditLine, info *types.ContainerInfo,
+) {
+	if !e.hasSubscribers() {
+		return
+	}
+
+	event := auditEvent(nodeName, auditLine, info)
+
+	e.subscribersMutex.RLock()
+	defer e.subscribersMutex.RUnlock()
+
+	for events, filter := range e.subscribers {
+		if !subscriptionMatches(filter, event) {
+			continue
+		}
+		select {
+		case events <- event:
+		default:
+			e.logger.V(config.VerboseLevel).Info(
+				"Dropping audit event for slow subscriber",
+				"type", event.Type, "timestamp", event.TimestampId,
+			)
+		}
+	}
+}
+
+// subscriptionMatches returns true if the event passes all filters of the
+// subscription.
+func subscriptionMatches(filter *api.SubscribeRequest, event *api.AuditEvent) bool {
+	if filter.Namespace != "" && filter.Namespace != event.Namespace {
+		return false
+	}
+	if filter.Pod != "" && filter.Pod != event.Pod {
+		return false
+	}
+	if filter.Type != "" && filter.Type != event.Type {
+		return false
+	}
+	if filter.Profile != "" && !subscriptionProfileMatches(filter.Profile, event) {
+		return false
+	}
+	return true
+}
+
+// subscriptionProfileMatches returns true if the profile filter, which is
+// either "name" or "namespace/name", references the recording, AppArmor,
+// seccomp or SELinux profile of the event.
+func subscriptionProfileMatches(profile string, event *api.AuditEvent) bool {
+	if profile == event.RecordProfile || profile == event.ApparmorProfile {
+		return true
+	}
+
+	refs := []profileRef{}
+	if ref, ok := seccompProfileRef(event.SeccompProfile); ok {
+		refs = append(refs, ref)
+	}
+	if ref, ok := selinuxProfileRef(event.Scontext); ok {
+		refs = append(refs, ref)
+	}
+
+	namespace, name, namespaced := strings.Cut(profile, "/")
+	if !namespaced {
+		name = profile
+	}
+	for _, ref := range refs {
+		if ref.name == name && (!namespaced || ref.namespace == namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// auditEvent converts an enriched audit line into its API representation.
+func auditEvent(
+	nodeName string, auditLine *types.AuditLine, info *types.ContainerInfo,
+) *api.AuditEvent {
+	event := &api.AuditEvent{
+		Timestamp:       time.Now().UnixNano(),
+		TimestampId:     auditLine.TimestampID,
+		Type:            auditLine.AuditType,
+		Node:            nodeName,
+		Namespace:       info.Namespace,
+		Pod:             info.PodName,
+		Container:       info.ContainerName,
+		ContainerId:     info.ContainerID,
+		RecordProfile:   info.RecordProfile,
+		SeccompProfile:  info.SeccompProfile,
+		Pid:             int32(auditLine.ProcessID),
+		Executable:      auditLine.Executable,
+		SyscallId:       auditLine.SystemCallID,
+		Code:            auditLine.Code,
+		Perm:            auditLine.Perm,
+		Scontext:        auditLine.Scontext,
+		Tcontext:        auditLine.Tcontext,
+		Tclass:          auditLine.Tclass,
+		Apparmor:        auditLine.Apparmor,
+		Operation:       auditLine.Operation,
+		ApparmorProfile: auditLine.Profile,
+		Name:            auditLine.Name,
+		RequestedMask:   auditLine.RequestedMask,
+	}
+
+	if auditLine.AuditType == types.AuditTypeSeccomp {
+		if name, err := syscallName(auditLine.SystemCallID); err == nil {
+			event.Syscall = name
+		}
+	}
+
+	return event
+}
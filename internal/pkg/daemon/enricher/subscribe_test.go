@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+type fakeSubscribeServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *api.AuditEvent
+}
+
+func (f *fakeSubscribeServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeSubscribeServer) Send(event *api.AuditEvent) error {
+	f.events <- event
+	return nil
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	all := &fakeSubscribeServer{ctx: ctx, events: make(chan *api.AuditEvent, 1)}
+	other := &fakeSubscribeServer{ctx: ctx, events: make(chan *api.AuditEvent, 1)}
+
+	var wg sync.WaitGroup
+	for server, request := range map[*fakeSubscribeServer]*api.SubscribeRequest{
+		all:   {},
+		other: {Namespace: "other"},
+	} {
+		server, request := server, request
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Nil(t, sut.Subscribe(request, server))
+		}()
+	}
+	require.Eventually(t, func() bool {
+		sut.subscribersMutex.RLock()
+		defer sut.subscribersMutex.RUnlock()
+		return len(sut.subscribers) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Nil(t, sut.dispatchAuditLine(nil, node, &types.AuditLine{
+		AuditType:    types.AuditTypeSeccomp,
+		ProcessID:    42,
+		TimestampID:  "1624537480.360:8477",
+		Executable:   executable,
+		SystemCallID: 10,
+		Code:         "0x7ffc0000",
+	}, &types.ContainerInfo{
+		PodName:        pod,
+		ContainerName:  "container",
+		Namespace:      namespace,
+		ContainerID:    containerID,
+		SeccompProfile: "operator/namespace/profile.json",
+	}))
+
+	select {
+	case received := <-all.events:
+		require.Equal(t, types.AuditTypeSeccomp, received.Type)
+		require.Equal(t, "1624537480.360:8477", received.TimestampId)
+		require.Equal(t, node, received.Node)
+		require.Equal(t, namespace, received.Namespace)
+		require.Equal(t, pod, received.Pod)
+		require.Equal(t, containerID, received.ContainerId)
+		require.Equal(t, "operator/namespace/profile.json", received.SeccompProfile)
+		require.Equal(t, executable, received.Executable)
+		require.EqualValues(t, 42, received.Pid)
+		require.Equal(t, syscall, received.Syscall)
+		require.Equal(t, "0x7ffc0000", received.Code)
+		require.NotZero(t, received.Timestamp)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no audit event received")
+	}
+	require.Empty(t, other.events)
+
+	cancel()
+	wg.Wait()
+	require.False(t, sut.hasSubscribers())
+}
+
+func TestSubscriptionMatches(t *testing.T) {
+	t.Parallel()
+
+	event := &api.AuditEvent{
+		Type:            types.AuditTypeApparmor,
+		Namespace:       namespace,
+		Pod:             pod,
+		RecordProfile:   "record",
+		SeccompProfile:  "operator/namespace/profile.json",
+		ApparmorProfile: "apparmor",
+	}
+	selinuxEvent := &api.AuditEvent{
+		Type:     types.AuditTypeSelinux,
+		Scontext: "system_u:system_r:selinux-profile_namespace.process:s0:c4,c808",
+	}
+	clusterEvent := &api.AuditEvent{
+		Type:           types.AuditTypeSeccomp,
+		SeccompProfile: "operator/" + seccompprofileapi.ClusterProfilesDir + "/cluster-profile.json",
+	}
+
+	for _, tc := range []struct {
+		filter *api.SubscribeRequest
+		event  *api.AuditEvent
+		want   bool
+	}{
+		{filter: &api.SubscribeRequest{}, want: true},
+		{filter: &api.SubscribeRequest{Namespace: namespace, Pod: pod}, want: true},
+		{filter: &api.SubscribeRequest{Namespace: "other"}, want: false},
+		{filter: &api.SubscribeRequest{Pod: "other"}, want: false},
+		{filter: &api.SubscribeRequest{Type: types.AuditTypeApparmor}, want: true},
+		{filter: &api.SubscribeRequest{Type: types.AuditTypeSeccomp}, want: false},
+		{filter: &api.SubscribeRequest{Profile: "record"}, want: true},
+		{filter: &api.SubscribeRequest{Profile: "profile"}, want: true},
+		{filter: &api.SubscribeRequest{Profile: "namespace/profile"}, want: true},
+		{filter: &api.SubscribeRequest{Profile: "other/profile"}, want: false},
+		{filter: &api.SubscribeRequest{Profile: "operator/namespace/profile.json"}, want: false},
+		{filter: &api.SubscribeRequest{Profile: "apparmor"}, want: true},
+		{filter: &api.SubscribeRequest{Profile: "other"}, want: false},
+		{filter: &api.SubscribeRequest{Profile: "selinux-profile"}, event: selinuxEvent, want: true},
+		{filter: &api.SubscribeRequest{Profile: "namespace/selinux-profile"}, event: selinuxEvent, want: true},
+		{filter: &api.SubscribeRequest{Profile: "other/selinux-profile"}, event: selinuxEvent, want: false},
+		{filter: &api.SubscribeRequest{Profile: "cluster-profile"}, event: clusterEvent, want: true},
+		{filter: &api.SubscribeRequest{Profile: "namespace/cluster-profile"}, event: clusterEvent, want: false},
+	} {
+		e := tc.event
+		if e == nil {
+			e = event
+		}
+		require.Equal(t, tc.want, subscriptionMatches(tc.filter, e), "filter: %v", tc.filter)
+	}
+}
@@ -32,6 +32,9 @@ type AuditLine struct {
 	// seccomp
 	SystemCallID int32
 	Executable   string
+	// Code is the seccomp action which caused the audit message, for
+	// example "0x7ffc0000" for SCMP_ACT_LOG.
+	Code string
 
 	// selinux
 	Scontext string
@@ -49,12 +52,37 @@ type AuditLine struct {
 	// ExtraInfo may contain addition information such as:
 	// requested_mask, denied_mask, fsuid=65534, ouid and target.
 	ExtraInfo string
+	// RequestedMask is the access requested by the operation, for example "r".
+	RequestedMask string
+	// Capability is the name of the requested capability.
+	Capability string
+	// Family is the network address family of the operation.
+	Family string
+	// SockType is the network socket type of the operation.
+	SockType string
+
+	// correlated records of the same audit event
+	// Exit is the return value of the syscall from the SYSCALL record, nil if
+	// no SYSCALL record has been found.
+	Exit *int64
+	// Cwd is the working directory of the process from the CWD record.
+	Cwd string
+	// Paths are the file paths accessed by the syscall from the PATH records.
+	Paths []string
+	// Arguments are the command line arguments from the EXECVE record.
+	Arguments []string
+	// Proctitle is the command line of the process from the PROCTITLE record.
+	Proctitle string
 }
 
 type ContainerInfo struct {
 	PodName       string
+	PodUID        string
 	ContainerName string
 	Namespace     string
 	ContainerID   string
 	RecordProfile string
+	// SeccompProfile is the seccomp profile of the container, either the
+	// path of a localhost profile or the profile type.
+	SeccompProfile string
 }
@@ -47,6 +47,7 @@ type defaultImpl struct{}
 type impl interface {
 	NewClient(ctrl.Manager) (client.Client, error)
 	ClientGet(context.Context, client.Client, client.ObjectKey, client.Object) error
+	ClientUpdateStatus(context.Context, client.Client, client.Object) error
 	NewControllerManagedBy(
 		manager.Manager, string, func(obj runtime.Object) bool,
 		func(obj runtime.Object) bool, reconcile.Reconciler) error
@@ -55,13 +56,18 @@ type impl interface {
 	GetPod(context.Context, client.Client, client.ObjectKey) (*corev1.Pod, error)
 	GetSPOD(context.Context, client.Client) (*spodapi.SecurityProfilesOperatorDaemon, error)
 	DialBpfRecorder() (*grpc.ClientConn, context.CancelFunc, error)
-	StartBpfRecorder(context.Context, bpfrecorderapi.BpfRecorderClient) error
-	StopBpfRecorder(context.Context, bpfrecorderapi.BpfRecorderClient) error
+	StartBpfRecorder(context.Context, bpfrecorderapi.BpfRecorderClient, *bpfrecorderapi.SessionRequest) error
+	StopBpfRecorder(context.Context, bpfrecorderapi.BpfRecorderClient, *bpfrecorderapi.SessionRequest) error
 	SyscallsForProfile(
 		context.Context,
 		bpfrecorderapi.BpfRecorderClient,
 		*bpfrecorderapi.ProfileRequest,
 	) (*bpfrecorderapi.SyscallsResponse, error)
+	CapabilitiesForProfile(
+		context.Context,
+		bpfrecorderapi.BpfRecorderClient,
+		*bpfrecorderapi.ProfileRequest,
+	) (*bpfrecorderapi.CapabilitiesResponse, error)
 	CreateOrUpdate(
 		context.Context, client.Client, client.Object, controllerutil.MutateFn,
 	) (controllerutil.OperationResult, error)
@@ -78,6 +84,15 @@ type impl interface {
 	ResetAvcs(
 		context.Context, enricherapi.EnricherClient, *enricherapi.AvcRequest,
 	) error
+	Apparmor(
+		context.Context, enricherapi.EnricherClient, *enricherapi.ApparmorRequest,
+	) (*enricherapi.ApparmorResponse, error)
+	ResetApparmor(
+		context.Context, enricherapi.EnricherClient, *enricherapi.ApparmorRequest,
+	) error
+	StopRecording(
+		context.Context, enricherapi.EnricherClient, *enricherapi.StopRecordingRequest,
+	) error
 	DialEnricher() (*grpc.ClientConn, context.CancelFunc, error)
 }
 
@@ -91,6 +106,12 @@ func (*defaultImpl) ClientGet(
 	return c.Get(ctx, key, obj)
 }
 
+func (*defaultImpl) ClientUpdateStatus(
+	ctx context.Context, c client.Client, obj client.Object,
+) error {
+	return c.Status().Update(ctx, obj)
+}
+
 func (*defaultImpl) NewControllerManagedBy(
 	m manager.Manager,
 	name string,
@@ -139,16 +160,16 @@ func (*defaultImpl) DialBpfRecorder() (*grpc.ClientConn, context.CancelFunc, err
 }
 
 func (*defaultImpl) StartBpfRecorder(
-	ctx context.Context, c bpfrecorderapi.BpfRecorderClient,
+	ctx context.Context, c bpfrecorderapi.BpfRecorderClient, req *bpfrecorderapi.SessionRequest,
 ) error {
-	_, err := c.Start(ctx, &bpfrecorderapi.EmptyRequest{})
+	_, err := c.StartSession(ctx, req)
 	return err
 }
 
 func (*defaultImpl) StopBpfRecorder(
-	ctx context.Context, c bpfrecorderapi.BpfRecorderClient,
+	ctx context.Context, c bpfrecorderapi.BpfRecorderClient, req *bpfrecorderapi.SessionRequest,
 ) error {
-	_, err := c.Stop(ctx, &bpfrecorderapi.EmptyRequest{})
+	_, err := c.StopSession(ctx, req)
 	return err
 }
 
@@ -160,6 +181,14 @@ func (*defaultImpl) SyscallsForProfile(
 	return c.SyscallsForProfile(ctx, req)
 }
 
+func (*defaultImpl) CapabilitiesForProfile(
+	ctx context.Context,
+	c bpfrecorderapi.BpfRecorderClient,
+	req *bpfrecorderapi.ProfileRequest,
+) (*bpfrecorderapi.CapabilitiesResponse, error) {
+	return c.CapabilitiesForProfile(ctx, req)
+}
+
 func (*defaultImpl) CreateOrUpdate(
 	ctx context.Context,
 	c client.Client,
@@ -199,6 +228,26 @@ func (*defaultImpl) ResetAvcs(
 	return err
 }
 
+func (*defaultImpl) Apparmor(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.ApparmorRequest,
+) (*enricherapi.ApparmorResponse, error) {
+	return c.Apparmor(ctx, in)
+}
+
+func (*defaultImpl) ResetApparmor(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.ApparmorRequest,
+) error {
+	_, err := c.ResetApparmor(ctx, in)
+	return err
+}
+
+func (*defaultImpl) StopRecording(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.StopRecordingRequest,
+) error {
+	_, err := c.StopRecording(ctx, in)
+	return err
+}
+
 func (*defaultImpl) DialEnricher() (*grpc.ClientConn, context.CancelFunc, error) {
 	return enricher.Dial()
 }
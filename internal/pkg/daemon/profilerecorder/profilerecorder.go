@@ -46,6 +46,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	bpfrecorderapi "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
@@ -70,10 +71,14 @@ const (
 
 	reasonProfileRecording      string = "ProfileRecording"
 	reasonProfileCreated        string = "ProfileCreated"
+	reasonProfileSnapshot       string = "ProfileSnapshot"
 	reasonProfileCreationFailed string = "CannotCreateProfile"
 	reasonAnnotationParsing     string = "AnnotationParsing"
 
 	seContextRequiredParts = 3
+
+	// startupProfileSuffix is appended to the name of startup profiles.
+	startupProfileSuffix = "-startup"
 )
 
 var errNameNotValid = errors.New("recording name is not valid DNS1123 subdomain, check profileRecording events")
@@ -103,6 +108,10 @@ type podToWatch struct {
 	baseName types.NamespacedName
 	recorder profilerecording1alpha1.ProfileRecorder
 	profiles []profileToCollect
+	// started is the time when the recording of the pod started.
+	started time.Time
+	// snapshots tracks the time of the last snapshot per profile.
+	snapshots map[string]time.Time
 }
 
 // Name returns the name of the controller.
@@ -117,6 +126,7 @@ func (r *RecorderReconciler) SchemeBuilder() *scheme.Builder {
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/status,verbs=get;update;patch
 
 // Setup is the initialization of the controller.
 func (r *RecorderReconciler) Setup(
@@ -196,6 +206,7 @@ func (r *RecorderReconciler) isPodWithTraceAnnotation(obj runtime.Object) bool {
 
 	for key := range p.Annotations {
 		if strings.HasPrefix(key, config.SelinuxProfileRecordLogsAnnotationKey) ||
+			strings.HasPrefix(key, config.AppArmorProfileRecordLogsAnnotationKey) ||
 			strings.HasPrefix(key, config.SeccompProfileRecordLogsAnnotationKey) ||
 			strings.HasPrefix(key, config.SeccompProfileRecordBpfAnnotationKey) {
 			return true
@@ -265,7 +276,7 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 			profiles = logProfiles
 			recorder = profilerecording1alpha1.ProfileRecorderLogs
 		} else if len(bpfProfiles) > 0 {
-			if err := r.startBpfRecorder(ctx); err != nil {
+			if err := r.startBpfRecorder(ctx, req.NamespacedName.String(), bpfProfiles); err != nil {
 				logger.Error(err, "unable to start bpf recorder")
 				return reconcile.Result{}, err
 			}
@@ -289,11 +300,31 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 
 		r.podsToWatch.Store(
 			req.NamespacedName.String(),
-			podToWatch{baseName, recorder, profiles},
+			podToWatch{baseName: baseName, recorder: recorder, profiles: profiles, started: time.Now()},
 		)
 		r.record.Event(pod, util.EventTypeNormal, reasonProfileRecording, "Recording profiles")
 	}
 
+	if pod.Status.Phase == corev1.PodRunning {
+		res, collErr := r.collectStoppedRecordings(ctx, req.NamespacedName)
+		if errors.Is(collErr, errNameNotValid) {
+			logger.Error(collErr, "cannot collect profile")
+			// not reconcilable, no need to requeue
+			return reconcile.Result{}, nil
+		} else if collErr != nil {
+			return reconcile.Result{}, fmt.Errorf("collect profile for stopped recording: %w", collErr)
+		}
+
+		snapshotRes, err := r.snapshotProfiles(ctx, req.NamespacedName)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("snapshot profiles for running pod: %w", err)
+		}
+
+		return reconcile.Result{
+			RequeueAfter: shorterRequeue(res.RequeueAfter, snapshotRes.RequeueAfter),
+		}, nil
+	}
+
 	if pod.Status.Phase == corev1.PodSucceeded {
 		collErr := r.collectProfile(ctx, req.NamespacedName)
 		if errors.Is(collErr, errNameNotValid) {
@@ -336,7 +367,11 @@ func (r *RecorderReconciler) getBpfRecorderClient(
 	return bpfRecorderClient, cancel, nil
 }
 
-func (r *RecorderReconciler) startBpfRecorder(ctx context.Context) error {
+// startBpfRecorder opens the recording session for a pod. Starting an
+// already running session only adds the profiles to it.
+func (r *RecorderReconciler) startBpfRecorder(
+	ctx context.Context, session string, profiles []profileToCollect,
+) error {
 	recorderClient, cancel, err := r.getBpfRecorderClient(ctx)
 	if err != nil {
 		return fmt.Errorf("get bpf recorder client: %w", err)
@@ -345,11 +380,18 @@ func (r *RecorderReconciler) startBpfRecorder(ctx context.Context) error {
 
 	ctx, cancel = context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel()
-	r.log.Info("Starting BPF recorder on node")
-	return r.StartBpfRecorder(ctx, recorderClient)
+	request := &bpfrecorderapi.SessionRequest{Name: session}
+	for _, profile := range profiles {
+		request.Profiles = append(request.Profiles, profile.name)
+	}
+
+	r.log.Info("Starting BPF recorder session on node", "session", session)
+	return r.StartBpfRecorder(ctx, recorderClient, request)
 }
 
-func (r *RecorderReconciler) stopBpfRecorder(ctx context.Context) error {
+// stopBpfRecorder closes the recording session for a pod, which drops the
+// recorded data of the session on the node.
+func (r *RecorderReconciler) stopBpfRecorder(ctx context.Context, session string) error {
 	recorderClient, cancel1, err := r.getBpfRecorderClient(ctx)
 	if err != nil {
 		return fmt.Errorf("get bpf recorder client: %w", err)
@@ -358,8 +400,8 @@ func (r *RecorderReconciler) stopBpfRecorder(ctx context.Context) error {
 
 	ctx, cancel2 := context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel2()
-	r.log.Info("Stopping BPF recorder on node")
-	return r.StopBpfRecorder(ctx, recorderClient)
+	r.log.Info("Stopping BPF recorder session on node", "session", session)
+	return r.StopBpfRecorder(ctx, recorderClient, &bpfrecorderapi.SessionRequest{Name: session})
 }
 
 func (r *RecorderReconciler) collectProfile(
@@ -377,29 +419,581 @@ func (r *RecorderReconciler) collectProfile(
 		return errors.New("type assert pod to watch")
 	}
 
-	replicaSuffix := ""
-	if podToWatch.baseName.Name != podName.Name && strings.HasPrefix(podName.Name, podToWatch.baseName.Name) {
-		// this is a replica, we need to strip the suffix from the pod name
-		replicaSuffix = strings.TrimPrefix(podName.Name, podToWatch.baseName.Name)
+	if err := r.collectProfiles(ctx, podName, &podToWatch, podToWatch.profiles); err != nil {
+		return err
+	}
+
+	if err := r.finishRecording(ctx, n, &podToWatch); err != nil {
+		return err
+	}
+
+	r.podsToWatch.Delete(n)
+	return nil
+}
+
+// collectStoppedRecordings collects the profiles of a running pod for all
+// time bounded recordings whose window already ended. The pod gets requeued
+// until the window of the next time bounded recording ends.
+func (r *RecorderReconciler) collectStoppedRecordings(
+	ctx context.Context, podName types.NamespacedName,
+) (reconcile.Result, error) {
+	n := podName.String()
+
+	value, ok := r.podsToWatch.Load(n)
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	podToWatch, ok := value.(podToWatch)
+	if !ok {
+		return reconcile.Result{}, errors.New("type assert pod to watch")
+	}
+
+	var (
+		now          = time.Now()
+		stopTimes    = map[string]*time.Time{}
+		stopped      []profileToCollect
+		running      []profileToCollect
+		requeueAfter time.Duration
+	)
+
+	for _, prf := range podToWatch.profiles {
+		parsedProfileAnnotation, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("parse profile raw annotation: %w", err)
+		}
+
+		recordingName := parsedProfileAnnotation.profileName
+		stopTime, found := stopTimes[recordingName]
+		if !found {
+			var (
+				recordingStopTime time.Time
+				bounded           bool
+			)
+			recordingStopTime, bounded, err = r.recordingStopTime(ctx, recordingName, podName.Namespace)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("get stop time of recording %s: %w", recordingName, err)
+			}
+			if bounded {
+				stopTime = &recordingStopTime
+			}
+			stopTimes[recordingName] = stopTime
+		}
+
+		switch {
+		case stopTime == nil:
+			running = append(running, prf)
+		case now.Before(*stopTime):
+			running = append(running, prf)
+			requeueAfter = shorterRequeue(requeueAfter, stopTime.Sub(now))
+		default:
+			stopped = append(stopped, prf)
+		}
+	}
+
+	if len(stopped) == 0 {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	r.log.Info("Recording window ended, collecting profiles", "pod", n)
+	if err := r.collectProfiles(ctx, podName, &podToWatch, stopped); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderLogs {
+		if err := r.stopLogRecordings(ctx, stopped); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if len(running) > 0 {
+		podToWatch.profiles = running
+		r.podsToWatch.Store(n, podToWatch)
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if err := r.finishRecording(ctx, n, &podToWatch); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.podsToWatch.Delete(n)
+	return reconcile.Result{}, nil
+}
+
+// stopLogRecordings stops recording the provided profiles in the log enricher
+// while the pod keeps running, which also drops their remaining data.
+func (r *RecorderReconciler) stopLogRecordings(
+	ctx context.Context, profiles []profileToCollect,
+) error {
+	conn, cancel, err := r.DialEnricher()
+	if err != nil {
+		return fmt.Errorf("connecting to local GRPC server: %w", err)
+	}
+	defer cancel()
+	enricherClient := enricherapi.NewEnricherClient(conn)
+
+	for _, prf := range profiles {
+		r.log.Info("Stopping log enricher recording", "profile", prf.name)
+		if err := r.StopRecording(
+			ctx, enricherClient, &enricherapi.StopRecordingRequest{Profile: prf.name},
+		); err != nil {
+			return fmt.Errorf("stop recording profile %s: %w", prf.name, err)
+		}
+	}
+
+	return nil
+}
+
+// recordingStopTime returns the point in time at which the provided
+// recording ends and whether the recording is time bounded at all.
+func (r *RecorderReconciler) recordingStopTime(
+	ctx context.Context, recordingName, namespace string,
+) (stopTime time.Time, bounded bool, err error) {
+	recording := profilerecording1alpha1.ProfileRecording{}
+	if err := r.ClientGet(
+		ctx, r.client, client.ObjectKey{Name: recordingName, Namespace: namespace}, &recording,
+	); err != nil {
+		if kerrors.IsNotFound(err) {
+			// the recording is gone, collect the profiles once the pod
+			// terminates as usual
+			return stopTime, false, nil
+		}
+		return stopTime, false, fmt.Errorf("get profile recording: %w", err)
+	}
+
+	stopTime, bounded = recording.StopTime()
+	return stopTime, bounded, nil
+}
+
+func (r *RecorderReconciler) collectProfiles(
+	ctx context.Context,
+	podName types.NamespacedName,
+	podToWatch *podToWatch,
+	profiles []profileToCollect,
+) error {
+	recordingNames := recordingNamesForProfiles(profiles)
+	for _, recordingName := range recordingNames {
+		r.setRecordingPhase(
+			ctx, recordingName, podName.Namespace,
+			profilerecording1alpha1.RecordingConditionCollecting,
+			profilerecording1alpha1.ReasonCollectingProfiles, "",
+		)
+	}
+
+	if err := r.collectRecordedProfiles(ctx, podName, podToWatch, profiles); err != nil {
+		for _, recordingName := range recordingNames {
+			r.setRecordingPhase(
+				ctx, recordingName, podName.Namespace,
+				profilerecording1alpha1.RecordingConditionFailed,
+				profilerecording1alpha1.ReasonCollectionFailed, err.Error(),
+			)
+		}
+		return err
 	}
 
+	for _, recordingName := range recordingNames {
+		if err := r.updateRecordingStatus(
+			ctx, recordingName, podName.Namespace, setCollectedPhase,
+		); err != nil {
+			r.log.Error(err, "Unable to update recording status", "recording", recordingName)
+		}
+	}
+
+	return nil
+}
+
+func (r *RecorderReconciler) collectRecordedProfiles(
+	ctx context.Context,
+	podName types.NamespacedName,
+	podToWatch *podToWatch,
+	profiles []profileToCollect,
+) error {
+	replicaSuffix := podToWatch.replicaSuffix(podName)
+
 	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderLogs {
 		if err := r.collectLogProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
+			ctx, replicaSuffix, podName, profiles,
 		); err != nil {
 			return fmt.Errorf("collect log profile: %w", err)
 		}
-	}
+	}
+
+	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderBpf {
+		if err := r.collectBpfProfiles(
+			ctx, replicaSuffix, podName, profiles,
+		); err != nil {
+			return fmt.Errorf("collect bpf profile: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// replicaSuffix returns the suffix of the pod name if the pod is a replica
+// of a replicated controller.
+func (p *podToWatch) replicaSuffix(podName types.NamespacedName) string {
+	if p.baseName.Name != podName.Name && strings.HasPrefix(podName.Name, p.baseName.Name) {
+		// this is a replica, we need to strip the suffix from the pod name
+		return strings.TrimPrefix(podName.Name, p.baseName.Name)
+	}
+	return ""
+}
+
+// shorterRequeue returns the shorter of both requeue durations, where zero
+// means no requeue at all.
+func shorterRequeue(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// snapshotProfiles writes the syscalls recorded so far to the seccomp
+// profiles of a running pod, if its recording requests periodic snapshots.
+// The pod gets requeued until the next snapshot is due.
+func (r *RecorderReconciler) snapshotProfiles(
+	ctx context.Context, podName types.NamespacedName,
+) (reconcile.Result, error) {
+	n := podName.String()
+
+	value, ok := r.podsToWatch.Load(n)
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	podToWatch, ok := value.(podToWatch)
+	if !ok {
+		return reconcile.Result{}, errors.New("type assert pod to watch")
+	}
+
+	var (
+		now          = time.Now()
+		intervals    = map[string]time.Duration{}
+		due          []profileToCollect
+		requeueAfter time.Duration
+	)
+
+	for _, prf := range podToWatch.profiles {
+		if prf.kind != profilerecording1alpha1.ProfileRecordingKindSeccompProfile {
+			continue
+		}
+
+		parsedProfileAnnotation, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("parse profile raw annotation: %w", err)
+		}
+
+		recordingName := parsedProfileAnnotation.profileName
+		interval, found := intervals[recordingName]
+		if !found {
+			interval, err = r.recordingSnapshotInterval(ctx, recordingName, podName.Namespace)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf(
+					"get snapshot interval of recording %s: %w", recordingName, err,
+				)
+			}
+			intervals[recordingName] = interval
+		}
+
+		if interval <= 0 {
+			continue
+		}
+
+		lastSnapshot, ok := podToWatch.snapshots[prf.name]
+		if !ok {
+			lastSnapshot = podToWatch.started
+		}
+
+		if wait := lastSnapshot.Add(interval).Sub(now); wait > 0 {
+			requeueAfter = shorterRequeue(requeueAfter, wait)
+			continue
+		}
+
+		due = append(due, prf)
+		requeueAfter = shorterRequeue(requeueAfter, interval)
+	}
+
+	if len(due) == 0 {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if err := r.snapshotSeccompProfiles(ctx, podName, &podToWatch, due); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if podToWatch.snapshots == nil {
+		podToWatch.snapshots = map[string]time.Time{}
+	}
+	for _, prf := range due {
+		podToWatch.snapshots[prf.name] = now
+	}
+	r.podsToWatch.Store(n, podToWatch)
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// recordingSnapshotInterval returns the snapshot interval of the provided
+// recording or zero if it does not request snapshots.
+func (r *RecorderReconciler) recordingSnapshotInterval(
+	ctx context.Context, recordingName, namespace string,
+) (time.Duration, error) {
+	recording := profilerecording1alpha1.ProfileRecording{}
+	if err := r.ClientGet(
+		ctx, r.client, client.ObjectKey{Name: recordingName, Namespace: namespace}, &recording,
+	); err != nil {
+		if kerrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("get profile recording: %w", err)
+	}
+
+	if recording.Spec.SnapshotInterval == nil {
+		return 0, nil
+	}
+	return recording.Spec.SnapshotInterval.Duration, nil
+}
+
+// snapshotSeccompProfiles retrieves the syscalls recorded so far for the
+// provided profiles without resetting them and writes them to the profiles.
+func (r *RecorderReconciler) snapshotSeccompProfiles(
+	ctx context.Context,
+	podName types.NamespacedName,
+	podToWatch *podToWatch,
+	profiles []profileToCollect,
+) error {
+	var getRecorded func(profileID string) (recorded *recordedSeccompProfile, found bool, err error)
+
+	switch podToWatch.recorder {
+	case profilerecording1alpha1.ProfileRecorderLogs:
+		conn, cancel, err := r.DialEnricher()
+		if err != nil {
+			return fmt.Errorf("connecting to local GRPC server: %w", err)
+		}
+		defer cancel()
+		enricherClient := enricherapi.NewEnricherClient(conn)
+
+		getRecorded = func(profileID string) (*recordedSeccompProfile, bool, error) {
+			response, err := r.Syscalls(ctx, enricherClient, &enricherapi.SyscallsRequest{Profile: profileID})
+			if err != nil {
+				if grpcstatus.Convert(err).Code() == grpccodes.NotFound {
+					return nil, false, nil
+				}
+				return nil, false, fmt.Errorf("retrieve syscalls for profile %s: %w", profileID, err)
+			}
+			// Sorting avoids needless profile updates between snapshots.
+			syscalls := response.GetSyscalls()
+			sort.Strings(syscalls)
+			return &recordedSeccompProfile{
+				syscalls:    syscalls,
+				goArch:      response.GoArch,
+				executables: executableSyscalls(response.GetExecutables()),
+				statistics:  recordedStatistics(response.GetStatistics()),
+			}, true, nil
+		}
+
+	case profilerecording1alpha1.ProfileRecorderBpf:
+		recorderClient, cancel, err := r.getBpfRecorderClient(ctx)
+		if err != nil {
+			return fmt.Errorf("get bpf recorder client: %w", err)
+		}
+		defer cancel()
+
+		getRecorded = func(profileID string) (*recordedSeccompProfile, bool, error) {
+			request := &bpfrecorderapi.ProfileRequest{Name: profileID, Snapshot: true}
+			capabilities, err := r.CapabilitiesForProfile(ctx, recorderClient, request)
+			if err != nil {
+				if grpcstatus.Convert(err).Message() == bpfrecorder.ErrNotFound.Error() {
+					return nil, false, nil
+				}
+				return nil, false, fmt.Errorf("get capabilities for profile: %w", err)
+			}
+			response, err := r.SyscallsForProfile(ctx, recorderClient, request)
+			if err != nil {
+				if grpcstatus.Convert(err).Message() == bpfrecorder.ErrNotFound.Error() {
+					return nil, false, nil
+				}
+				return nil, false, fmt.Errorf("get syscalls for profile: %w", err)
+			}
+			return &recordedSeccompProfile{
+				syscalls:     response.GetSyscalls(),
+				syscallArgs:  response.GetSyscallArgs(),
+				goArch:       response.GoArch,
+				capabilities: capabilities.GetCapabilities(),
+				executables:  executableSyscalls(response.GetExecutables()),
+				statistics:   recordedStatistics(response.GetStatistics()),
+			}, true, nil
+		}
+
+	default:
+		return fmt.Errorf("unsupported recorder %s", podToWatch.recorder)
+	}
+
+	replicaSuffix := podToWatch.replicaSuffix(podName)
+	for _, prf := range profiles {
+		parsedProfileName, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			return fmt.Errorf("parse profile raw annotation: %w", err)
+		}
+
+		profileNamespacedName := createProfileName(
+			parsedProfileName.cntName, replicaSuffix,
+			podName.Namespace, parsedProfileName.profileName)
+
+		labels, err := profileLabels(
+			ctx,
+			r,
+			parsedProfileName.profileName,
+			parsedProfileName.cntName,
+			profileNamespacedName.Namespace)
+		if err != nil {
+			return fmt.Errorf("creating profile labels: %w", err)
+		}
+
+		err = r.setRecordingFinalizers(ctx, labels, parsedProfileName.profileName, profileNamespacedName.Namespace)
+		if err != nil {
+			return fmt.Errorf("setting finalizer on profilerecording: %w", err)
+		}
+
+		recorded, found, err := getRecorded(prf.name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			r.log.Info("No syscalls recorded yet, skipping snapshot", "profile", prf.name)
+			continue
+		}
+
+		r.log.Info("Taking profile snapshot", "name", profileNamespacedName, "kind", prf.kind)
+		if err := r.writeSeccompProfiles(
+			ctx, parsedProfileName.profileName, profileNamespacedName, labels,
+			recorded, true,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordingNamesForProfiles returns the unique names of the recordings the
+// provided profiles belong to.
+func recordingNamesForProfiles(profiles []profileToCollect) []string {
+	names := sets.New[string]()
+	for _, prf := range profiles {
+		parsedProfileAnnotation, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			// handled when collecting the profile
+			continue
+		}
+		names.Insert(parsedProfileAnnotation.profileName)
+	}
+	return sets.List(names)
+}
+
+// setCollectedPhase sets the phase of a recording after its profiles have
+// been collected.
+func setCollectedPhase(recording *profilerecording1alpha1.ProfileRecording) {
+	switch {
+	case len(recording.Status.ActiveWorkloads) > 0 && !recording.IsStopped(time.Now()):
+		recording.Status.SetPhase(
+			profilerecording1alpha1.RecordingConditionRecording,
+			profilerecording1alpha1.ReasonRecordingInProgress, "",
+		)
+	case recording.Status.HasPartialProfiles():
+		recording.Status.SetPhase(
+			profilerecording1alpha1.RecordingConditionMerging,
+			profilerecording1alpha1.ReasonPartialProfiles,
+			"partial profiles are merged once the recording is deleted",
+		)
+	default:
+		recording.Status.SetPhase(
+			profilerecording1alpha1.RecordingConditionCompleted,
+			profilerecording1alpha1.ReasonProfilesCollected, "",
+		)
+	}
+}
+
+// setRecordingPhase sets the phase of a recording. Failing to do so is only
+// logged because the status must not block the collection of the profiles.
+func (r *RecorderReconciler) setRecordingPhase(
+	ctx context.Context,
+	recordingName, namespace string,
+	phase spodv1alpha1.ConditionType,
+	reason spodv1alpha1.ConditionReason,
+	message string,
+) {
+	if err := r.updateRecordingStatus(
+		ctx, recordingName, namespace,
+		func(recording *profilerecording1alpha1.ProfileRecording) {
+			recording.Status.SetPhase(phase, reason, message)
+		},
+	); err != nil {
+		r.log.Error(err, "Unable to update recording status", "recording", recordingName)
+	}
+}
+
+// addRecordedProfile adds the provided profile and the capabilities used
+// while recording it to the status of the recording which produced it.
+func (r *RecorderReconciler) addRecordedProfile(
+	ctx context.Context, recordingName string, profile client.Object, capabilities []string,
+) error {
+	labels := profile.GetLabels()
+	_, partial := labels[profilebase.ProfilePartialLabel]
+
+	return r.updateRecordingStatus(
+		ctx, recordingName, profile.GetNamespace(),
+		func(recording *profilerecording1alpha1.ProfileRecording) {
+			recording.Status.AddProfile(profilerecording1alpha1.RecordedProfile{
+				Name:         profile.GetName(),
+				Container:    labels[profilerecording1alpha1.ProfileToContainerLabel],
+				Partial:      partial,
+				Capabilities: capabilities,
+			})
+		},
+	)
+}
+
+// updateRecordingStatus applies the provided mutation to the status of the
+// recording and retries on conflicts with the recorders of other nodes.
+func (r *RecorderReconciler) updateRecordingStatus(
+	ctx context.Context,
+	recordingName, namespace string,
+	mutate func(*profilerecording1alpha1.ProfileRecording),
+) error {
+	return util.Retry(func() error {
+		recording := &profilerecording1alpha1.ProfileRecording{}
+		if err := r.ClientGet(
+			ctx, r.client, client.ObjectKey{Name: recordingName, Namespace: namespace}, recording,
+		); err != nil {
+			if kerrors.IsNotFound(err) {
+				// nothing to update if the recording is already gone
+				return nil
+			}
+			return fmt.Errorf("get profile recording: %w", err)
+		}
 
-	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderBpf {
-		if err := r.collectBpfProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
-		); err != nil {
-			return fmt.Errorf("collect bpf profile: %w", err)
+		mutate(recording)
+
+		if err := r.ClientUpdateStatus(ctx, r.client, recording); err != nil {
+			return fmt.Errorf("update profile recording status: %w", err)
 		}
+		return nil
+	}, kerrors.IsConflict)
+}
+
+// finishRecording releases the recording session of a pod after all its
+// profiles got collected.
+func (r *RecorderReconciler) finishRecording(
+	ctx context.Context, session string, podToWatch *podToWatch,
+) error {
+	if podToWatch.recorder != profilerecording1alpha1.ProfileRecorderBpf {
+		return nil
 	}
 
-	r.podsToWatch.Delete(n)
+	if err := r.stopBpfRecorder(ctx, session); err != nil {
+		r.log.Error(err, "Unable to stop bpf recorder")
+		return fmt.Errorf("stop bpf recorder: %w", err)
+	}
 	return nil
 }
 
@@ -449,6 +1043,8 @@ func (r *RecorderReconciler) collectLogProfiles(
 			err = r.collectLogSeccompProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
 		case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
 			err = r.collectLogSelinuxProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
+		case profilerecording1alpha1.ProfileRecordingKindAppArmorProfile:
+			err = r.collectLogAppArmorProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
 		default:
 			err = fmt.Errorf("unrecognized kind %s", prf.kind)
 		}
@@ -501,7 +1097,268 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 		return fmt.Errorf("retrieve syscalls for profile %s: %w", profileID, err)
 	}
 
-	arch, err := r.goArchToSeccompArch(response.GoArch)
+	if err := r.writeSeccompProfiles(
+		ctx, parsedProfileName.profileName, profileNamespacedName, labels,
+		&recordedSeccompProfile{
+			syscalls:    response.GetSyscalls(),
+			goArch:      response.GoArch,
+			executables: executableSyscalls(response.GetExecutables()),
+			statistics:  recordedStatistics(response.GetStatistics()),
+		},
+		false,
+	); err != nil {
+		return err
+	}
+
+	// Reset the syscalls for further recordings
+	if err := r.ResetSyscalls(ctx, enricherClient, request); err != nil {
+		return fmt.Errorf("reset syscalls for profile %s: %w", profileID, err)
+	}
+
+	return nil
+}
+
+// recordedSeccompProfile contains the data recorded for a seccomp profile.
+type recordedSeccompProfile struct {
+	syscalls []string
+	// syscallArgs are the recorded argument values, only recorded by the bpf
+	// recorder
+	syscallArgs []*bpfrecorderapi.SyscallArguments
+	goArch      string
+	// capabilities used by the container, only recorded by the bpf recorder
+	capabilities []string
+	// executables maps the executables of the container to their syscalls
+	executables map[string][]string
+	// statistics contains the usage data per syscall
+	statistics map[string]syscallStatistics
+}
+
+// syscallStatistics contains the usage data of a recorded syscall.
+type syscallStatistics struct {
+	count uint64
+	// firstSeen and lastSeen are Unix timestamps in nanoseconds
+	firstSeen int64
+	lastSeen  int64
+}
+
+// splitStartup splits the recorded profile into a startup profile and a
+// steady state profile. The startup phase starts with the first recorded
+// syscall of the container, not with its start, and lasts for the provided
+// duration. The steady state profile omits the syscalls which have only been
+// used during the startup phase. The startup profile contains all recorded
+// syscalls, because the steady state filter gets layered on top of it and
+// seccomp evaluates every installed filter for each syscall. Syscalls without
+// timestamps are part of both profiles.
+func (p *recordedSeccompProfile) splitStartup(
+	duration time.Duration,
+) (startup, steady *recordedSeccompProfile) {
+	var start int64
+	for _, stats := range p.statistics {
+		if stats.firstSeen > 0 && (start == 0 || stats.firstSeen < start) {
+			start = stats.firstSeen
+		}
+	}
+	end := start + duration.Nanoseconds()
+
+	steadySyscalls := sets.New[string]()
+	for _, syscall := range p.syscalls {
+		stats, ok := p.statistics[syscall]
+		if !ok || stats.firstSeen == 0 || stats.lastSeen >= end {
+			steadySyscalls.Insert(syscall)
+		}
+	}
+
+	return p.withSyscalls(sets.New(p.syscalls...)), p.withSyscalls(steadySyscalls)
+}
+
+// withSyscalls returns a copy of the recorded profile which only contains
+// the provided syscalls.
+func (p *recordedSeccompProfile) withSyscalls(syscalls sets.Set[string]) *recordedSeccompProfile {
+	res := *p
+	res.syscalls = sets.List(syscalls)
+
+	res.syscallArgs = nil
+	for _, args := range p.syscallArgs {
+		if syscalls.Has(args.GetName()) {
+			res.syscallArgs = append(res.syscallArgs, args)
+		}
+	}
+
+	res.executables = map[string][]string{}
+	for executable, executableSyscalls := range p.executables {
+		filtered := []string{}
+		for _, syscall := range executableSyscalls {
+			if syscalls.Has(syscall) {
+				filtered = append(filtered, syscall)
+			}
+		}
+		if len(filtered) > 0 {
+			res.executables[executable] = filtered
+		}
+	}
+
+	res.statistics = map[string]syscallStatistics{}
+	for syscall, stats := range p.statistics {
+		if syscalls.Has(syscall) {
+			res.statistics[syscall] = stats
+		}
+	}
+
+	return &res
+}
+
+// recordedExecutable is implemented by the per executable syscalls of both
+// the enricher and the bpf recorder API.
+type recordedExecutable interface {
+	GetExecutable() string
+	GetSyscalls() []string
+}
+
+// executableSyscalls converts the recorded per executable syscalls into a map.
+func executableSyscalls[T recordedExecutable](executables []T) map[string][]string {
+	res := make(map[string][]string, len(executables))
+	for _, executable := range executables {
+		res[executable.GetExecutable()] = executable.GetSyscalls()
+	}
+	return res
+}
+
+// recordedSyscallStatistics is implemented by the syscall statistics of both
+// the enricher and the bpf recorder API.
+type recordedSyscallStatistics interface {
+	GetName() string
+	GetCount() uint64
+	GetFirstSeen() int64
+	GetLastSeen() int64
+}
+
+// recordedStatistics converts the recorded syscall statistics into a map.
+func recordedStatistics[T recordedSyscallStatistics](statistics []T) map[string]syscallStatistics {
+	res := make(map[string]syscallStatistics, len(statistics))
+	for _, stats := range statistics {
+		res[stats.GetName()] = syscallStatistics{
+			count:     stats.GetCount(),
+			firstSeen: stats.GetFirstSeen(),
+			lastSeen:  stats.GetLastSeen(),
+		}
+	}
+	return res
+}
+
+// seccompSyscallRules converts the recorded syscalls into seccomp rules.
+// Syscalls with recorded argument values are only allowed for those values,
+// while all other syscalls are allowed unconditionally.
+func seccompSyscallRules(
+	syscalls []string, syscallArgs []*bpfrecorderapi.SyscallArguments,
+) []*seccompprofileapi.Syscall {
+	withArgs := sets.New[string]()
+	argRules := []*seccompprofileapi.Syscall{}
+	for _, args := range syscallArgs {
+		if len(args.GetValues()) == 0 {
+			continue
+		}
+		withArgs.Insert(args.GetName())
+		for _, value := range args.GetValues() {
+			argRules = append(argRules, &seccompprofileapi.Syscall{
+				Action: seccomp.ActAllow,
+				Names:  []string{args.GetName()},
+				Args: []*seccompprofileapi.Arg{{
+					Index: uint(args.GetIndex()),
+					Value: value,
+					Op:    seccomp.OpEqualTo,
+				}},
+			})
+		}
+	}
+
+	unconditional := make([]string, 0, len(syscalls))
+	for _, syscall := range syscalls {
+		if !withArgs.Has(syscall) {
+			unconditional = append(unconditional, syscall)
+		}
+	}
+
+	if len(unconditional) == 0 {
+		return argRules
+	}
+
+	return append([]*seccompprofileapi.Syscall{{
+		Action: seccomp.ActAllow,
+		Names:  unconditional,
+	}}, argRules...)
+}
+
+// writeSeccompProfiles writes the recorded seccomp profile. If the recording
+// requests a startup duration, a separate startup profile is written in
+// addition to the steady state profile.
+func (r *RecorderReconciler) writeSeccompProfiles(
+	ctx context.Context,
+	recordingName string,
+	profileNamespacedName types.NamespacedName,
+	labels map[string]string,
+	recorded *recordedSeccompProfile,
+	snapshot bool,
+) error {
+	startupDuration, err := r.recordingStartupDuration(ctx, recordingName, profileNamespacedName.Namespace)
+	if err != nil {
+		return err
+	}
+	if startupDuration == 0 {
+		return r.writeSeccompProfile(ctx, recordingName, profileNamespacedName, labels, recorded, snapshot)
+	}
+
+	startup, steady := recorded.splitStartup(startupDuration)
+
+	startupName := types.NamespacedName{
+		Name:      profileNamespacedName.Name + startupProfileSuffix,
+		Namespace: profileNamespacedName.Namespace,
+	}
+	// The container label is suffixed as well to merge the startup profiles
+	// separately from the steady state profiles.
+	startupLabels := make(map[string]string, len(labels))
+	for key, value := range labels {
+		startupLabels[key] = value
+	}
+	startupLabels[profilerecording1alpha1.ProfileToContainerLabel] += startupProfileSuffix
+
+	if err := r.writeSeccompProfile(ctx, recordingName, startupName, startupLabels, startup, snapshot); err != nil {
+		return err
+	}
+	return r.writeSeccompProfile(ctx, recordingName, profileNamespacedName, labels, steady, snapshot)
+}
+
+// recordingStartupDuration returns the startup duration of the provided
+// recording or zero if it does not request a startup profile.
+func (r *RecorderReconciler) recordingStartupDuration(
+	ctx context.Context, recordingName, namespace string,
+) (time.Duration, error) {
+	recording := profilerecording1alpha1.ProfileRecording{}
+	if err := r.ClientGet(
+		ctx, r.client, client.ObjectKey{Name: recordingName, Namespace: namespace}, &recording,
+	); err != nil {
+		if kerrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("get profile recording: %w", err)
+	}
+
+	if recording.Spec.StartupDuration == nil {
+		return 0, nil
+	}
+	return recording.Spec.StartupDuration.Duration, nil
+}
+
+// writeSeccompProfile creates or updates the seccomp profile for the
+// provided recorded data and references it in the status of the recording.
+func (r *RecorderReconciler) writeSeccompProfile(
+	ctx context.Context,
+	recordingName string,
+	profileNamespacedName types.NamespacedName,
+	labels map[string]string,
+	recorded *recordedSeccompProfile,
+	snapshot bool,
+) error {
+	arch, err := r.goArchToSeccompArch(recorded.goArch)
 	if err != nil {
 		return fmt.Errorf("get seccomp arch: %w", err)
 	}
@@ -509,10 +1366,20 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 	profileSpec := seccompprofileapi.SeccompProfileSpec{
 		DefaultAction: seccomp.ActErrno,
 		Architectures: []seccompprofileapi.Arch{arch},
-		Syscalls: []*seccompprofileapi.Syscall{{
-			Action: seccomp.ActAllow,
-			Names:  response.GetSyscalls(),
-		}},
+		Syscalls:      seccompSyscallRules(recorded.syscalls, recorded.syscallArgs),
+	}
+
+	executables, err := util.ExecutableSyscallsAnnotation(recorded.executables)
+	if err != nil {
+		return fmt.Errorf("build executable syscalls annotation: %w", err)
+	}
+	syscallCounts := make(map[string]uint64, len(recorded.statistics))
+	for syscall, stats := range recorded.statistics {
+		syscallCounts[syscall] = stats.count
+	}
+	counts, err := util.SyscallCountsAnnotation(syscallCounts)
+	if err != nil {
+		return fmt.Errorf("build syscall counts annotation: %w", err)
 	}
 
 	profile := &seccompprofileapi.SeccompProfile{
@@ -527,6 +1394,8 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 	res, err := r.CreateOrUpdate(ctx, r.client, profile,
 		func() error {
 			profile.Spec = profileSpec
+			setProfileAnnotation(profile, profilerecording1alpha1.ProfileExecutableSyscallsAnnotation, executables)
+			setProfileAnnotation(profile, profilerecording1alpha1.ProfileSyscallCountsAnnotation, counts)
 			return nil
 		},
 	)
@@ -536,17 +1405,38 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 		return fmt.Errorf("create seccompProfile resource: %w", err)
 	}
 
-	r.log.Info("Created/updated profile", "action", res, "name", profileNamespacedName.Name)
-	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "seccomp profile created")
+	if snapshot {
+		r.log.Info("Created/updated profile snapshot", "action", res, "name", profileNamespacedName)
+		if res != controllerutil.OperationResultNone {
+			r.record.Event(profile, util.EventTypeNormal, reasonProfileSnapshot, "seccomp profile snapshot updated")
+		}
+	} else {
+		r.log.Info("Created/updated profile", "action", res, "name", profileNamespacedName)
+		r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "seccomp profile created")
+	}
 
-	// Reset the syscalls for further recordings
-	if err := r.ResetSyscalls(ctx, enricherClient, request); err != nil {
-		return fmt.Errorf("reset syscalls for profile %s: %w", profileID, err)
+	if err := r.addRecordedProfile(ctx, recordingName, profile, recorded.capabilities); err != nil {
+		return fmt.Errorf("add profile %s to recording status: %w", profileNamespacedName, err)
 	}
 
 	return nil
 }
 
+// setProfileAnnotation sets an annotation with recorded data on the profile,
+// or removes it if nothing was recorded.
+func setProfileAnnotation(profile metav1.Object, key, value string) {
+	annotations := profile.GetAnnotations()
+	if value == "" {
+		delete(annotations, key)
+		return
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	profile.SetAnnotations(annotations)
+}
+
 func (r *RecorderReconciler) collectLogSelinuxProfile(
 	ctx context.Context,
 	enricherClient enricherapi.EnricherClient,
@@ -627,6 +1517,10 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 	r.log.Info("Created/updated selinux profile", "action", res, "name", profileNamespacedName)
 	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "selinuxprofile profile created")
 
+	if err := r.addRecordedProfile(ctx, parsedProfileName.profileName, profile, nil); err != nil {
+		return fmt.Errorf("add profile %s to recording status: %w", profileNamespacedName, err)
+	}
+
 	// Reset the selinuxprofile for further recordings
 	if err := r.ResetAvcs(ctx, enricherClient, request); err != nil {
 		return fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
@@ -653,6 +1547,87 @@ func (r *RecorderReconciler) formatSelinuxProfile(
 	return sePol, nil
 }
 
+func (r *RecorderReconciler) collectLogAppArmorProfile(
+	ctx context.Context,
+	enricherClient enricherapi.EnricherClient,
+	parsedProfileName *parsedAnnotation,
+	profileNamespacedName types.NamespacedName,
+	profileID string,
+) error {
+	labels, err := profileLabels(
+		ctx,
+		r,
+		parsedProfileName.profileName,
+		parsedProfileName.cntName,
+		profileNamespacedName.Namespace)
+	if err != nil {
+		return fmt.Errorf("creating profile labels: %w", err)
+	}
+
+	// Do this BEFORE reading the accesses to hopefully minimize the
+	// race window in case reading the accesses failed. In that case we just reconcile
+	// back here and loop through again
+	err = r.setRecordingFinalizers(ctx, labels, parsedProfileName.profileName, profileNamespacedName.Namespace)
+	if err != nil {
+		return fmt.Errorf("setting finalizer on profilerecording: %w", err)
+	}
+
+	// Retrieve the AppArmor accesses for the recording
+	request := &enricherapi.ApparmorRequest{Profile: profileID}
+	response, err := r.Apparmor(ctx, enricherClient, request)
+	if err != nil {
+		if grpcstatus.Convert(err).Code() == grpccodes.NotFound &&
+			grpcstatus.Convert(err).Message() == enricher.ErrorNoApparmor {
+			if err := r.ResetApparmor(ctx, enricherClient, request); err != nil {
+				return fmt.Errorf("reset apparmor accesses for profile %s: %w", profileNamespacedName, err)
+			}
+			r.log.Info("No AppArmor accesses found, resetting profile", "profileID", profileID)
+			return nil
+		}
+		return fmt.Errorf("retrieve apparmor accesses for profile %s: %w", profileID, err)
+	}
+
+	aaBuilder := newAppArmorProfileBuilder()
+	aaBuilder.AddAccessList(response.GetAccess())
+	appArmorProfileSpec := apparmorprofileapi.AppArmorProfileSpec{
+		Abstract: aaBuilder.Format(),
+	}
+
+	profile := &apparmorprofileapi.AppArmorProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      profileNamespacedName.Name,
+			Namespace: profileNamespacedName.Namespace,
+			Labels:    labels,
+		},
+		Spec: appArmorProfileSpec,
+	}
+
+	res, err := r.CreateOrUpdate(ctx, r.client, profile,
+		func() error {
+			profile.Spec = appArmorProfileSpec
+			return nil
+		},
+	)
+	if err != nil {
+		r.log.Error(err, "Cannot create apparmorprofile resource")
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+		return fmt.Errorf("create apparmorprofile resource: %w", err)
+	}
+	r.log.Info("Created/updated apparmor profile", "action", res, "name", profileNamespacedName)
+	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "apparmor profile created")
+
+	if err := r.addRecordedProfile(ctx, parsedProfileName.profileName, profile, nil); err != nil {
+		return fmt.Errorf("add profile %s to recording status: %w", profileNamespacedName, err)
+	}
+
+	// Reset the AppArmor accesses for further recordings
+	if err := r.ResetApparmor(ctx, enricherClient, request); err != nil {
+		return fmt.Errorf("reset apparmor accesses for profile %s: %w", profileNamespacedName, err)
+	}
+
+	return nil
+}
+
 func (r *RecorderReconciler) collectBpfProfiles(
 	ctx context.Context,
 	replicaSuffix string,
@@ -694,7 +1669,10 @@ func (r *RecorderReconciler) collectBpfProfiles(
 		}
 
 		r.log.Info("Collecting BPF profile", "name", profile.name, "kind", profile.kind)
-		response, err := r.SyscallsForProfile(
+
+		// Retrieve the capabilities first, because retrieving the syscalls
+		// finishes the recording of the container.
+		capabilities, err := r.CapabilitiesForProfile(
 			ctx, recorderClient, &bpfrecorderapi.ProfileRequest{Name: profile.name},
 		)
 		if err != nil {
@@ -704,54 +1682,41 @@ func (r *RecorderReconciler) collectBpfProfiles(
 			if grpcstatus.Convert(err).Message() == bpfrecorder.ErrNotFound.Error() {
 				r.log.Error(err, "Recorded profile not found", "name", profile.name)
 				continue
-			} else {
-				return fmt.Errorf("get syscalls for profile: %w", err)
 			}
+			return fmt.Errorf("get capabilities for profile: %w", err)
 		}
 
-		arch, err := r.goArchToSeccompArch(response.GoArch)
+		response, err := r.SyscallsForProfile(
+			ctx, recorderClient, &bpfrecorderapi.ProfileRequest{Name: profile.name},
+		)
 		if err != nil {
-			return fmt.Errorf("get seccomp arch: %w", err)
-		}
-
-		profileSpec := seccompprofileapi.SeccompProfileSpec{
-			DefaultAction: seccomp.ActErrno,
-			Architectures: []seccompprofileapi.Arch{arch},
-			Syscalls: []*seccompprofileapi.Syscall{{
-				Action: seccomp.ActAllow,
-				Names:  response.GetSyscalls(),
-			}},
-		}
-
-		profile := &seccompprofileapi.SeccompProfile{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      profileNamespacedName.Name,
-				Namespace: profileNamespacedName.Namespace,
-				Labels:    labels,
-			},
-			Spec: profileSpec,
+			// Recording was not found for this profile, this might be an init container
+			// which is not longer active. Let's skip here and keep processing the
+			// next profile.
+			if grpcstatus.Convert(err).Message() == bpfrecorder.ErrNotFound.Error() {
+				r.log.Error(err, "Recorded profile not found", "name", profile.name)
+				continue
+			} else {
+				return fmt.Errorf("get syscalls for profile: %w", err)
+			}
 		}
 
-		res, err := r.CreateOrUpdate(ctx, r.client, profile,
-			func() error {
-				profile.Spec = profileSpec
-				return nil
+		if err := r.writeSeccompProfiles(
+			ctx, parsedProfileName.profileName, profileNamespacedName, labels,
+			&recordedSeccompProfile{
+				syscalls:     response.GetSyscalls(),
+				syscallArgs:  response.GetSyscallArgs(),
+				goArch:       response.GoArch,
+				capabilities: capabilities.GetCapabilities(),
+				executables:  executableSyscalls(response.GetExecutables()),
+				statistics:   recordedStatistics(response.GetStatistics()),
 			},
-		)
-		if err != nil {
-			r.log.Error(err, "Cannot create seccompprofile resource")
-			r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
-			return fmt.Errorf("create seccompProfile resource: %w", err)
+			false,
+		); err != nil {
+			return err
 		}
-
-		r.log.Info("Created/updated profile", "action", res, "name", profileNamespacedName)
-		r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "seccomp profile created")
 	}
 
-	if err := r.stopBpfRecorder(ctx); err != nil {
-		r.log.Error(err, "Unable to stop bpf recorder")
-		return fmt.Errorf("stop bpf recorder: %w", err)
-	}
 	return nil
 }
 
@@ -802,6 +1767,8 @@ func parseLogAnnotations(annotations map[string]string) (res []profileToCollect,
 			collectProfile.kind = profilerecording1alpha1.ProfileRecordingKindSeccompProfile
 		} else if strings.HasPrefix(key, config.SelinuxProfileRecordLogsAnnotationKey) {
 			collectProfile.kind = profilerecording1alpha1.ProfileRecordingKindSelinuxProfile
+		} else if strings.HasPrefix(key, config.AppArmorProfileRecordLogsAnnotationKey) {
+			collectProfile.kind = profilerecording1alpha1.ProfileRecordingKindAppArmorProfile
 		} else {
 			continue
 		}
@@ -949,6 +1916,132 @@ func ctxt2type(ctx string) (string, error) {
 	return elems[2], nil
 }
 
+type appArmorProfileBuilder struct {
+	executables  sets.Set[string]
+	libraries    sets.Set[string]
+	readPaths    sets.Set[string]
+	writePaths   sets.Set[string]
+	capabilities sets.Set[string]
+	families     sets.Set[string]
+	allowTCP     bool
+	allowUDP     bool
+	allowRaw     bool
+}
+
+func newAppArmorProfileBuilder() *appArmorProfileBuilder {
+	return &appArmorProfileBuilder{
+		executables:  sets.New[string](),
+		libraries:    sets.New[string](),
+		readPaths:    sets.New[string](),
+		writePaths:   sets.New[string](),
+		capabilities: sets.New[string](),
+		families:     sets.New[string](),
+	}
+}
+
+func (ab *appArmorProfileBuilder) AddAccessList(accesses []*enricherapi.ApparmorResponse_ApparmorAccess) {
+	for _, access := range accesses {
+		ab.addAccess(access)
+	}
+}
+
+func (ab *appArmorProfileBuilder) addAccess(access *enricherapi.ApparmorResponse_ApparmorAccess) {
+	switch {
+	case access.Capability != "":
+		ab.capabilities.Insert(access.Capability)
+	case access.Family != "":
+		ab.addNetworkAccess(access.Family, access.SockType)
+	case !strings.HasPrefix(access.Name, "/"):
+		// Skip empty names as well as pseudo paths like "apparmor/.null" or
+		// "pipe:[1234]", which cannot be used in the abstract rules.
+		return
+	case access.Operation == "exec":
+		ab.executables.Insert(access.Name)
+	case access.Operation == "file_mmap":
+		ab.libraries.Insert(access.Name)
+	default:
+		if strings.Contains(access.RequestedMask, "r") {
+			ab.readPaths.Insert(access.Name)
+		}
+		if strings.ContainsAny(access.RequestedMask, "wacd") {
+			ab.writePaths.Insert(access.Name)
+		}
+	}
+}
+
+func (ab *appArmorProfileBuilder) addNetworkAccess(family, sockType string) {
+	if sockType == "raw" {
+		ab.allowRaw = true
+		return
+	}
+
+	if family != "inet" && family != "inet6" {
+		ab.families.Insert(family)
+		return
+	}
+
+	switch sockType {
+	case "stream":
+		ab.allowTCP = true
+	case "dgram":
+		ab.allowUDP = true
+	}
+}
+
+func (ab *appArmorProfileBuilder) Format() *apparmorprofileapi.AppArmorAbstract {
+	abstract := &apparmorprofileapi.AppArmorAbstract{}
+
+	if ab.executables.Len() > 0 || ab.libraries.Len() > 0 {
+		abstract.Executable = &apparmorprofileapi.AppArmorExecutablesRules{
+			AllowedExecutables: sortedList(ab.executables),
+			AllowedLibraries:   sortedList(ab.libraries),
+		}
+	}
+
+	if ab.readPaths.Len() > 0 || ab.writePaths.Len() > 0 {
+		abstract.Filesystem = &apparmorprofileapi.AppArmorFsRules{
+			ReadOnlyPaths:  sortedList(ab.readPaths.Difference(ab.writePaths)),
+			WriteOnlyPaths: sortedList(ab.writePaths.Difference(ab.readPaths)),
+			ReadWritePaths: sortedList(ab.readPaths.Intersection(ab.writePaths)),
+		}
+	}
+
+	if ab.allowTCP || ab.allowUDP || ab.allowRaw || ab.families.Len() > 0 {
+		network := &apparmorprofileapi.AppArmorNetworkRules{
+			Families: sortedList(ab.families),
+		}
+		if ab.allowRaw {
+			network.AllowRaw = &ab.allowRaw
+		}
+		if ab.allowTCP || ab.allowUDP {
+			network.Protocols = &apparmorprofileapi.AppArmorAllowedProtocols{}
+			if ab.allowTCP {
+				network.Protocols.AllowTCP = &ab.allowTCP
+			}
+			if ab.allowUDP {
+				network.Protocols.AllowUDP = &ab.allowUDP
+			}
+		}
+		abstract.Network = network
+	}
+
+	if ab.capabilities.Len() > 0 {
+		abstract.Capability = &apparmorprofileapi.AppArmorCapabilityRules{
+			AllowedCapabilities: sortedList(ab.capabilities),
+		}
+	}
+
+	return abstract
+}
+
+// sortedList returns the sorted items of the set or nil if it is empty.
+func sortedList(set sets.Set[string]) []string {
+	if set.Len() == 0 {
+		return nil
+	}
+	return sets.List(set)
+}
+
 func (r *RecorderReconciler) goArchToSeccompArch(goarch string) (seccompprofileapi.Arch, error) {
 	seccompArch, err := r.GoArchToSeccompArch(goarch)
 	if err != nil {
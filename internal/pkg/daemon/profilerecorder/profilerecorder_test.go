@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/containers/common/pkg/seccomp"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -34,16 +35,20 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	bpfrecorderapi "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
 	recordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder/profilerecorderfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
 )
 
 var errTest = errors.New("error")
@@ -491,6 +496,39 @@ func TestReconcile(t *testing.T) {
 				assert.NotNil(t, err)
 			},
 		},
+		{ //nolint:dupl // test duplicates are fine
+			// BPF CapabilitiesForProfile fails
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.CapabilitiesForProfileReturns(nil, errTest)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.NotNil(t, err)
+			},
+		},
 		{ // BPF DialBpfRecorder fails
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				mock.GetPodReturns(&corev1.Pod{
@@ -700,6 +738,140 @@ func TestReconcile(t *testing.T) {
 				assert.Nil(t, err)
 			},
 		},
+		{ // logs seccomp success collect for stopped recording
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodRunning},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.ClientGetCalls(func(
+					ctx context.Context,
+					c client.Client,
+					key types.NamespacedName,
+					obj client.Object,
+				) error {
+					if recording, ok := obj.(*recordingapi.ProfileRecording); ok {
+						recording.Spec.StopAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+					}
+					return nil
+				})
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.SyscallsReturns(
+					&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH}, nil,
+				)
+				mock.CreateOrUpdateReturns("", nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				_, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
+				assert.False(t, ok)
+			},
+		},
+		{ // logs seccomp failed StopRecording for stopped recording
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodRunning},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.ClientGetCalls(func(
+					ctx context.Context,
+					c client.Client,
+					key types.NamespacedName,
+					obj client.Object,
+				) error {
+					if recording, ok := obj.(*recordingapi.ProfileRecording); ok {
+						recording.Spec.StopAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+					}
+					return nil
+				})
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.SyscallsReturns(
+					&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH}, nil,
+				)
+				mock.CreateOrUpdateReturns("", nil)
+				mock.StopRecordingReturns(errTest)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.ErrorIs(t, err, errTest)
+				_, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
+				assert.True(t, ok)
+			},
+		},
+		{ // logs seccomp recording window not yet ended
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodRunning},
+				}, nil)
+				mock.ClientGetCalls(func(
+					ctx context.Context,
+					c client.Client,
+					key types.NamespacedName,
+					obj client.Object,
+				) error {
+					if recording, ok := obj.(*recordingapi.ProfileRecording); ok {
+						recording.Spec.Duration = &metav1.Duration{Duration: time.Hour}
+						recording.CreationTimestamp = metav1.Now()
+					}
+					return nil
+				})
+				mock.SyscallsReturns(nil, errTest)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				_, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
+				assert.True(t, ok)
+			},
+		},
 		{ // logs seccomp failed ResetSyscalls
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
@@ -1042,6 +1214,91 @@ func TestReconcile(t *testing.T) {
 				assert.Nil(t, err)
 			},
 		},
+		{ // logs apparmor success collect
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindAppArmorProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.AppArmorProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.ApparmorReturns(&enricherapi.ApparmorResponse{
+					Access: []*enricherapi.ApparmorResponse_ApparmorAccess{
+						{Operation: "exec", Name: "/usr/bin/sleep", RequestedMask: "x"},
+						{Operation: "capable", Capability: "chown"},
+					},
+				}, nil)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.Nil(t, err)
+					profile, ok := obj.(*apparmorprofileapi.AppArmorProfile)
+					assert.True(t, ok)
+					assert.Equal(t, []string{"/usr/bin/sleep"},
+						profile.Spec.Abstract.Executable.AllowedExecutables)
+					assert.Equal(t, []string{"chown"},
+						profile.Spec.Abstract.Capability.AllowedCapabilities)
+					return "", nil
+				})
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+			},
+		},
+		{ // logs apparmor failed ResetApparmor
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindAppArmorProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.AppArmorProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.ResetApparmorReturns(errTest)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.NotNil(t, err)
+			},
+		},
 		{ // logs selinux failed ResetAvcs
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
@@ -1189,6 +1446,423 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestRecordingStatus(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		syscallsErr    error
+		mergeStrategy  recordingapi.ProfileMergeStrategy
+		expectedPhase  spodapi.ConditionType
+		expectProfiles []recordingapi.RecordedProfile
+	}{
+		{
+			name:          "completed",
+			expectedPhase: recordingapi.RecordingConditionCompleted,
+			expectProfiles: []recordingapi.RecordedProfile{
+				{Name: "profile-replica-123-name", Container: "replica-123"},
+			},
+		},
+		{
+			name:          "partial profiles wait for merge",
+			mergeStrategy: recordingapi.ProfileMergeContainers,
+			expectedPhase: recordingapi.RecordingConditionMerging,
+			expectProfiles: []recordingapi.RecordedProfile{
+				{Name: "profile-replica-123-name", Container: "replica-123", Partial: true},
+			},
+		},
+		{
+			name:          "failed",
+			syscallsErr:   errTest,
+			expectedPhase: recordingapi.RecordingConditionFailed,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recording := &recordingapi.ProfileRecording{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "namespace"},
+				Spec:       recordingapi.ProfileRecordingSpec{MergeStrategy: tc.mergeStrategy},
+			}
+			scheme := apiruntime.NewScheme()
+			assert.Nil(t, recordingapi.AddToScheme(scheme))
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			sut := &RecorderReconciler{
+				impl:   mock,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+				client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(recording.DeepCopy()).Build(),
+			}
+
+			profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+			sut.podsToWatch.Store("namespace/name", podToWatch{
+				recorder: recordingapi.ProfileRecorderLogs,
+				profiles: []profileToCollect{{
+					kind: recordingapi.ProfileRecordingKindSeccompProfile,
+					name: profileName,
+				}},
+			})
+
+			mock.GetPodReturns(nil, kerrors.NewNotFound(schema.GroupResource{}, ""))
+			mock.ClientGetCalls(func(
+				ctx context.Context, c client.Client, key types.NamespacedName, obj client.Object,
+			) error {
+				if r, ok := obj.(*recordingapi.ProfileRecording); ok {
+					recording.DeepCopyInto(r)
+				}
+				return nil
+			})
+			mock.ClientUpdateStatusCalls(func(
+				ctx context.Context, c client.Client, obj client.Object,
+			) error {
+				r, ok := obj.(*recordingapi.ProfileRecording)
+				assert.True(t, ok)
+				r.DeepCopyInto(recording)
+				return nil
+			})
+			mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+				Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+			}, nil)
+			mock.DialEnricherReturns(nil, func() {}, nil)
+			mock.SyscallsReturns(
+				&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH}, tc.syscallsErr,
+			)
+			mock.GoArchToSeccompArchReturns("SCMP_ARCH_X86_64", nil)
+
+			_, err := sut.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "name"},
+			})
+			if tc.syscallsErr != nil {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, tc.expectedPhase, recording.Status.Phase())
+			assert.Equal(t, tc.expectProfiles, recording.Status.Profiles)
+		})
+	}
+}
+
+func TestSnapshotProfiles(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name             string
+		recorder         recordingapi.ProfileRecorder
+		snapshotInterval *metav1.Duration
+		lastSnapshot     time.Duration
+		expectSnapshot   bool
+	}{
+		{
+			name:             "logs snapshot due",
+			recorder:         recordingapi.ProfileRecorderLogs,
+			snapshotInterval: &metav1.Duration{Duration: time.Minute},
+			lastSnapshot:     -2 * time.Minute,
+			expectSnapshot:   true,
+		},
+		{
+			name:             "bpf snapshot due",
+			recorder:         recordingapi.ProfileRecorderBpf,
+			snapshotInterval: &metav1.Duration{Duration: time.Minute},
+			lastSnapshot:     -2 * time.Minute,
+			expectSnapshot:   true,
+		},
+		{
+			name:             "snapshot not yet due",
+			recorder:         recordingapi.ProfileRecorderLogs,
+			snapshotInterval: &metav1.Duration{Duration: time.Hour},
+			lastSnapshot:     -time.Minute,
+		},
+		{
+			name:     "snapshots disabled",
+			recorder: recordingapi.ProfileRecorderLogs,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			sut := &RecorderReconciler{
+				impl:   mock,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+			}
+
+			profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+			key := types.NamespacedName{Namespace: "namespace", Name: "name"}
+			sut.podsToWatch.Store(key.String(), podToWatch{
+				recorder: tc.recorder,
+				profiles: []profileToCollect{{
+					kind: recordingapi.ProfileRecordingKindSeccompProfile,
+					name: profileName,
+				}},
+				snapshots: map[string]time.Time{
+					profileName: time.Now().Add(tc.lastSnapshot),
+				},
+			})
+
+			mock.GetPodReturns(&corev1.Pod{
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			}, nil)
+			mock.ClientGetCalls(func(
+				ctx context.Context, c client.Client, key types.NamespacedName, obj client.Object,
+			) error {
+				if recording, ok := obj.(*recordingapi.ProfileRecording); ok {
+					recording.Spec.SnapshotInterval = tc.snapshotInterval
+				}
+				return nil
+			})
+			mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+				Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+			}, nil)
+			mock.DialEnricherReturns(nil, func() {}, nil)
+			mock.DialBpfRecorderReturns(nil, func() {}, nil)
+			mock.SyscallsReturns(&enricherapi.SyscallsResponse{
+				Syscalls: []string{"read", "close"},
+				GoArch:   runtime.GOARCH,
+				Executables: []*enricherapi.ExecutableSyscalls{
+					{Executable: "/bin/sh", Syscalls: []string{"read", "close"}},
+				},
+				Statistics: []*enricherapi.SyscallStatistics{
+					{Name: "close", Count: 1}, {Name: "read", Count: 42},
+				},
+			}, nil)
+			mock.SyscallsForProfileReturns(&bpfrecorderapi.SyscallsResponse{
+				Syscalls: []string{"close", "read"},
+				GoArch:   runtime.GOARCH,
+				Executables: []*bpfrecorderapi.ExecutableSyscalls{
+					{Executable: "/bin/sh", Syscalls: []string{"close", "read"}},
+				},
+				Statistics: []*bpfrecorderapi.SyscallStatistics{
+					{Name: "close", Count: 1}, {Name: "read", Count: 42},
+				},
+			}, nil)
+			mock.CapabilitiesForProfileReturns(&bpfrecorderapi.CapabilitiesResponse{
+				Capabilities: []string{"NET_BIND_SERVICE"},
+			}, nil)
+			mock.CreateOrUpdateCalls(func(
+				ctx context.Context,
+				c client.Client,
+				obj client.Object,
+				f controllerutil.MutateFn,
+			) (controllerutil.OperationResult, error) {
+				assert.Nil(t, f())
+				profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+				assert.True(t, ok)
+				assert.Equal(t, []string{"close", "read"}, profile.Spec.Syscalls[0].Names)
+				assert.Equal(t,
+					`{"/bin/sh":["close","read"]}`,
+					profile.Annotations[recordingapi.ProfileExecutableSyscallsAnnotation],
+				)
+				assert.Equal(t,
+					`{"close":1,"read":42}`,
+					profile.Annotations[recordingapi.ProfileSyscallCountsAnnotation],
+				)
+				return controllerutil.OperationResultUpdated, nil
+			})
+
+			res, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+			assert.Nil(t, err)
+
+			if tc.expectSnapshot {
+				assert.Equal(t, 1, mock.CreateOrUpdateCallCount())
+				assert.Equal(t, tc.snapshotInterval.Duration, res.RequeueAfter)
+			} else {
+				assert.Zero(t, mock.CreateOrUpdateCallCount())
+			}
+
+			// snapshots never reset or stop the recording
+			assert.Zero(t, mock.ResetSyscallsCallCount())
+			assert.Zero(t, mock.StopBpfRecorderCallCount())
+			if mock.SyscallsForProfileCallCount() > 0 {
+				_, _, req := mock.SyscallsForProfileArgsForCall(0)
+				assert.True(t, req.Snapshot)
+				_, _, req = mock.CapabilitiesForProfileArgsForCall(0)
+				assert.True(t, req.Snapshot)
+				_, _, obj := mock.ClientUpdateStatusArgsForCall(0)
+				recording, ok := obj.(*recordingapi.ProfileRecording)
+				assert.True(t, ok)
+				assert.Equal(t, []string{"NET_BIND_SERVICE"}, recording.Status.Profiles[0].Capabilities)
+			}
+			_, ok := sut.podsToWatch.Load(key.String())
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestSplitStartup(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1000, 0)
+	at := func(d time.Duration) int64 { return start.Add(d).UnixNano() }
+
+	recorded := &recordedSeccompProfile{
+		syscalls: []string{"clone", "mount", "read", "setuid", "write"},
+		syscallArgs: []*bpfrecorderapi.SyscallArguments{
+			{Name: "clone", Index: 0, Values: []uint64{17}},
+			{Name: "mount", Index: 3, Values: []uint64{0}},
+		},
+		goArch: runtime.GOARCH,
+		executables: map[string][]string{
+			"/bin/init": {"mount", "setuid"},
+			"/bin/app":  {"read", "write"},
+		},
+		statistics: map[string]syscallStatistics{
+			"mount":  {count: 1, firstSeen: at(0), lastSeen: at(0)},
+			"setuid": {count: 2, firstSeen: at(time.Second), lastSeen: at(2 * time.Second)},
+			"read":   {count: 9, firstSeen: at(time.Second), lastSeen: at(time.Hour)},
+			"write":  {count: 5, firstSeen: at(time.Minute), lastSeen: at(time.Hour)},
+		},
+	}
+
+	startup, steady := recorded.splitStartup(10 * time.Second)
+
+	// The steady state filter is layered on top of the startup filter, which
+	// therefore has to allow the syscalls first seen afterwards, like write
+	assert.Equal(t, []string{"clone", "mount", "read", "setuid", "write"}, startup.syscalls)
+	assert.Len(t, startup.syscallArgs, 2)
+	assert.Equal(t, recorded.executables, startup.executables)
+	assert.Len(t, startup.statistics, 4)
+	assert.Subset(t, startup.syscalls, steady.syscalls)
+
+	// clone has no timestamps and is therefore part of both profiles
+
+	assert.Equal(t, []string{"clone", "read", "write"}, steady.syscalls)
+	assert.Len(t, steady.syscallArgs, 1)
+	assert.Equal(t, "clone", steady.syscallArgs[0].Name)
+	assert.Equal(t, map[string][]string{"/bin/app": {"read", "write"}}, steady.executables)
+	assert.Len(t, steady.statistics, 2)
+	assert.Equal(t, runtime.GOARCH, steady.goArch)
+}
+
+func TestWriteSeccompProfilesStartup(t *testing.T) {
+	t.Parallel()
+
+	mock := &profilerecorderfakes.FakeImpl{}
+	sut := &RecorderReconciler{
+		impl:   mock,
+		log:    logr.Discard(),
+		record: record.NewFakeRecorder(10),
+	}
+
+	mock.ClientGetCalls(func(
+		ctx context.Context, c client.Client, key types.NamespacedName, obj client.Object,
+	) error {
+		if recording, ok := obj.(*recordingapi.ProfileRecording); ok {
+			recording.Spec.StartupDuration = &metav1.Duration{Duration: time.Minute}
+		}
+		return nil
+	})
+	profiles := map[string]*seccompprofileapi.SeccompProfile{}
+	mock.CreateOrUpdateCalls(func(
+		ctx context.Context,
+		c client.Client,
+		obj client.Object,
+		f controllerutil.MutateFn,
+	) (controllerutil.OperationResult, error) {
+		assert.Nil(t, f())
+		profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+		assert.True(t, ok)
+		profiles[profile.Name] = profile
+		return controllerutil.OperationResultCreated, nil
+	})
+
+	start := time.Now()
+	err := sut.writeSeccompProfiles(
+		context.Background(), "recording",
+		types.NamespacedName{Name: "recording-ctr", Namespace: "ns"},
+		map[string]string{recordingapi.ProfileToContainerLabel: "ctr"},
+		&recordedSeccompProfile{
+			syscalls: []string{"mount", "read"},
+			goArch:   runtime.GOARCH,
+			statistics: map[string]syscallStatistics{
+				"mount": {count: 1, firstSeen: start.UnixNano(), lastSeen: start.UnixNano()},
+				"read": {
+					count:     2,
+					firstSeen: start.Add(time.Second).UnixNano(),
+					lastSeen:  start.Add(time.Hour).UnixNano(),
+				},
+			},
+		},
+		false,
+	)
+	assert.Nil(t, err)
+
+	assert.Len(t, profiles, 2)
+	if startup, ok := profiles["recording-ctr-startup"]; assert.True(t, ok) {
+		assert.Equal(t, "ctr-startup", startup.Labels[recordingapi.ProfileToContainerLabel])
+		assert.Equal(t, []string{"mount", "read"}, startup.Spec.Syscalls[0].Names)
+	}
+	if steady, ok := profiles["recording-ctr"]; assert.True(t, ok) {
+		assert.Equal(t, "ctr", steady.Labels[recordingapi.ProfileToContainerLabel])
+		assert.Equal(t, []string{"read"}, steady.Spec.Syscalls[0].Names)
+	}
+}
+
+func TestSeccompSyscallRules(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		syscalls    []string
+		syscallArgs []*bpfrecorderapi.SyscallArguments
+		expected    []*seccompprofileapi.Syscall
+	}{
+		{
+			name:     "without arguments",
+			syscalls: []string{"read", "write"},
+			expected: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: []string{"read", "write"}},
+			},
+		},
+		{
+			name:     "with arguments",
+			syscalls: []string{"clone", "personality", "read"},
+			syscallArgs: []*bpfrecorderapi.SyscallArguments{
+				{Name: "clone", Index: 0, Values: []uint64{17, 4001536}},
+				{Name: "personality", Index: 0},
+			},
+			expected: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: []string{"personality", "read"}},
+				{
+					Action: seccomp.ActAllow,
+					Names:  []string{"clone"},
+					Args:   []*seccompprofileapi.Arg{{Index: 0, Value: 17, Op: seccomp.OpEqualTo}},
+				},
+				{
+					Action: seccomp.ActAllow,
+					Names:  []string{"clone"},
+					Args:   []*seccompprofileapi.Arg{{Index: 0, Value: 4001536, Op: seccomp.OpEqualTo}},
+				},
+			},
+		},
+		{
+			name:     "only arguments",
+			syscalls: []string{"socket"},
+			syscallArgs: []*bpfrecorderapi.SyscallArguments{
+				{Name: "socket", Index: 0, Values: []uint64{2}},
+			},
+			expected: []*seccompprofileapi.Syscall{
+				{
+					Action: seccomp.ActAllow,
+					Names:  []string{"socket"},
+					Args:   []*seccompprofileapi.Arg{{Index: 0, Value: 2, Op: seccomp.OpEqualTo}},
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, seccompSyscallRules(tc.syscalls, tc.syscallArgs))
+		})
+	}
+}
+
 func TestIsPodOnLocalNode(t *testing.T) {
 	t.Parallel()
 
@@ -1250,6 +1924,18 @@ func TestIsPodWithTraceAnnotation(t *testing.T) {
 				assert.True(t, res)
 			},
 		},
+		{ // success apparmor logs
+			prepare: func(sut *RecorderReconciler) apiruntime.Object {
+				return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						config.AppArmorProfileRecordLogsAnnotationKey: "",
+					},
+				}}
+			},
+			assert: func(res bool) {
+				assert.True(t, res)
+			},
+		},
 		{ // success seccomp logs
 			prepare: func(sut *RecorderReconciler) apiruntime.Object {
 				return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
@@ -1300,3 +1986,51 @@ func TestIsPodWithTraceAnnotation(t *testing.T) {
 		tc.assert(res)
 	}
 }
+
+func TestAppArmorProfileBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder := newAppArmorProfileBuilder()
+	builder.AddAccessList([]*enricherapi.ApparmorResponse_ApparmorAccess{
+		{Operation: "exec", Name: "/bin/sh", RequestedMask: "x"},
+		{Operation: "file_mmap", Name: "/lib/libc.so.6", RequestedMask: "mr"},
+		{Operation: "open", Name: "/etc/passwd", RequestedMask: "r"},
+		{Operation: "open", Name: "/tmp/out", RequestedMask: "wc"},
+		{Operation: "open", Name: "/var/lib/data", RequestedMask: "r"},
+		{Operation: "open", Name: "/var/lib/data", RequestedMask: "w"},
+		{Operation: "capable", Capability: "net_bind_service"},
+		{Operation: "create", Family: "inet", SockType: "stream"},
+		{Operation: "create", Family: "inet6", SockType: "dgram"},
+		{Operation: "create", Family: "unix", SockType: "stream"},
+		{Operation: "create", Family: "packet", SockType: "raw"},
+		{Operation: "change_onexec"},
+	})
+
+	abstract := builder.Format()
+	assert.Equal(t, []string{"/bin/sh"}, abstract.Executable.AllowedExecutables)
+	assert.Equal(t, []string{"/lib/libc.so.6"}, abstract.Executable.AllowedLibraries)
+	assert.Equal(t, []string{"/etc/passwd"}, abstract.Filesystem.ReadOnlyPaths)
+	assert.Equal(t, []string{"/tmp/out"}, abstract.Filesystem.WriteOnlyPaths)
+	assert.Equal(t, []string{"/var/lib/data"}, abstract.Filesystem.ReadWritePaths)
+	assert.Equal(t, []string{"net_bind_service"}, abstract.Capability.AllowedCapabilities)
+	assert.True(t, *abstract.Network.Protocols.AllowTCP)
+	assert.True(t, *abstract.Network.Protocols.AllowUDP)
+	assert.True(t, *abstract.Network.AllowRaw)
+	assert.Equal(t, []string{"unix"}, abstract.Network.Families)
+
+	assert.Equal(t, &apparmorprofileapi.AppArmorAbstract{}, newAppArmorProfileBuilder().Format())
+
+	// non-absolute paths are skipped to keep the abstract valid
+	builder = newAppArmorProfileBuilder()
+	builder.AddAccessList([]*enricherapi.ApparmorResponse_ApparmorAccess{
+		{Operation: "exec", Name: "/bin/sleep", RequestedMask: "x"},
+		{Operation: "exec", Name: "sleep", RequestedMask: "x"},
+		{Operation: "file_inherit", Name: "apparmor/.null", RequestedMask: "rw"},
+		{Operation: "file_perm", Name: "pipe:[1234]", RequestedMask: "w"},
+	})
+
+	abstract = builder.Format()
+	assert.Equal(t, []string{"/bin/sleep"}, abstract.Executable.AllowedExecutables)
+	assert.Nil(t, abstract.Filesystem)
+	assert.NoError(t, translator.ValidateAppArmorAbstract(abstract))
+}
@@ -25,7 +25,6 @@ import (
 	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -37,6 +36,21 @@ import (
 )
 
 type FakeImpl struct {
+	ApparmorStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.ApparmorRequest) (*api_enricher.ApparmorResponse, error)
+	apparmorMutex       sync.RWMutex
+	apparmorArgsForCall []struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.ApparmorRequest
+	}
+	apparmorReturns struct {
+		result1 *api_enricher.ApparmorResponse
+		result2 error
+	}
+	apparmorReturnsOnCall map[int]struct {
+		result1 *api_enricher.ApparmorResponse
+		result2 error
+	}
 	AvcsStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.AvcRequest) (*api_enricher.AvcResponse, error)
 	avcsMutex       sync.RWMutex
 	avcsArgsForCall []struct {
@@ -52,12 +66,27 @@ type FakeImpl struct {
 		result1 *api_enricher.AvcResponse
 		result2 error
 	}
-	ClientGetStub        func(context.Context, client.Client, types.NamespacedName, client.Object) error
+	CapabilitiesForProfileStub        func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.ProfileRequest) (*api_bpfrecorder.CapabilitiesResponse, error)
+	capabilitiesForProfileMutex       sync.RWMutex
+	capabilitiesForProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 api_bpfrecorder.BpfRecorderClient
+		arg3 *api_bpfrecorder.ProfileRequest
+	}
+	capabilitiesForProfileReturns struct {
+		result1 *api_bpfrecorder.CapabilitiesResponse
+		result2 error
+	}
+	capabilitiesForProfileReturnsOnCall map[int]struct {
+		result1 *api_bpfrecorder.CapabilitiesResponse
+		result2 error
+	}
+	ClientGetStub        func(context.Context, client.Client, client.ObjectKey, client.Object) error
 	clientGetMutex       sync.RWMutex
 	clientGetArgsForCall []struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 		arg4 client.Object
 	}
 	clientGetReturns struct {
@@ -66,6 +95,19 @@ type FakeImpl struct {
 	clientGetReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ClientUpdateStatusStub        func(context.Context, client.Client, client.Object) error
+	clientUpdateStatusMutex       sync.RWMutex
+	clientUpdateStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 client.Object
+	}
+	clientUpdateStatusReturns struct {
+		result1 error
+	}
+	clientUpdateStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateOrUpdateStub        func(context.Context, client.Client, client.Object, controllerutil.MutateFn) (controllerutil.OperationResult, error)
 	createOrUpdateMutex       sync.RWMutex
 	createOrUpdateArgsForCall []struct {
@@ -110,12 +152,12 @@ type FakeImpl struct {
 		result2 context.CancelFunc
 		result3 error
 	}
-	GetPodStub        func(context.Context, client.Client, types.NamespacedName) (*v1.Pod, error)
+	GetPodStub        func(context.Context, client.Client, client.ObjectKey) (*v1.Pod, error)
 	getPodMutex       sync.RWMutex
 	getPodArgsForCall []struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 	}
 	getPodReturns struct {
 		result1 *v1.Pod
@@ -203,6 +245,19 @@ type FakeImpl struct {
 	newControllerManagedByReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResetApparmorStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.ApparmorRequest) error
+	resetApparmorMutex       sync.RWMutex
+	resetApparmorArgsForCall []struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.ApparmorRequest
+	}
+	resetApparmorReturns struct {
+		result1 error
+	}
+	resetApparmorReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResetAvcsStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.AvcRequest) error
 	resetAvcsMutex       sync.RWMutex
 	resetAvcsArgsForCall []struct {
@@ -229,11 +284,12 @@ type FakeImpl struct {
 	resetSyscallsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	StartBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient) error
+	StartBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.SessionRequest) error
 	startBpfRecorderMutex       sync.RWMutex
 	startBpfRecorderArgsForCall []struct {
 		arg1 context.Context
 		arg2 api_bpfrecorder.BpfRecorderClient
+		arg3 *api_bpfrecorder.SessionRequest
 	}
 	startBpfRecorderReturns struct {
 		result1 error
@@ -241,11 +297,12 @@ type FakeImpl struct {
 	startBpfRecorderReturnsOnCall map[int]struct {
 		result1 error
 	}
-	StopBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient) error
+	StopBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.SessionRequest) error
 	stopBpfRecorderMutex       sync.RWMutex
 	stopBpfRecorderArgsForCall []struct {
 		arg1 context.Context
 		arg2 api_bpfrecorder.BpfRecorderClient
+		arg3 *api_bpfrecorder.SessionRequest
 	}
 	stopBpfRecorderReturns struct {
 		result1 error
@@ -253,6 +310,19 @@ type FakeImpl struct {
 	stopBpfRecorderReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StopRecordingStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.StopRecordingRequest) error
+	stopRecordingMutex       sync.RWMutex
+	stopRecordingArgsForCall []struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.StopRecordingRequest
+	}
+	stopRecordingReturns struct {
+		result1 error
+	}
+	stopRecordingReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SyscallsStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.SyscallsRequest) (*api_enricher.SyscallsResponse, error)
 	syscallsMutex       sync.RWMutex
 	syscallsArgsForCall []struct {
@@ -287,6 +357,72 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeImpl) Apparmor(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.ApparmorRequest) (*api_enricher.ApparmorResponse, error) {
+	fake.apparmorMutex.Lock()
+	ret, specificReturn := fake.apparmorReturnsOnCall[len(fake.apparmorArgsForCall)]
+	fake.apparmorArgsForCall = append(fake.apparmorArgsForCall, struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.ApparmorRequest
+	}{arg1, arg2, arg3})
+	stub := fake.ApparmorStub
+	fakeReturns := fake.apparmorReturns
+	fake.recordInvocation("Apparmor", []interface{}{arg1, arg2, arg3})
+	fake.apparmorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ApparmorCallCount() int {
+	fake.apparmorMutex.RLock()
+	defer fake.apparmorMutex.RUnlock()
+	return len(fake.apparmorArgsForCall)
+}
+
+func (fake *FakeImpl) ApparmorCalls(stub func(context.Context, api_enricher.EnricherClient, *api_enricher.ApparmorRequest) (*api_enricher.ApparmorResponse, error)) {
+	fake.apparmorMutex.Lock()
+	defer fake.apparmorMutex.Unlock()
+	fake.ApparmorStub = stub
+}
+
+func (fake *FakeImpl) ApparmorArgsForCall(i int) (context.Context, api_enricher.EnricherClient, *api_enricher.ApparmorRequest) {
+	fake.apparmorMutex.RLock()
+	defer fake.apparmorMutex.RUnlock()
+	argsForCall := fake.apparmorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ApparmorReturns(result1 *api_enricher.ApparmorResponse, result2 error) {
+	fake.apparmorMutex.Lock()
+	defer fake.apparmorMutex.Unlock()
+	fake.ApparmorStub = nil
+	fake.apparmorReturns = struct {
+		result1 *api_enricher.ApparmorResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ApparmorReturnsOnCall(i int, result1 *api_enricher.ApparmorResponse, result2 error) {
+	fake.apparmorMutex.Lock()
+	defer fake.apparmorMutex.Unlock()
+	fake.ApparmorStub = nil
+	if fake.apparmorReturnsOnCall == nil {
+		fake.apparmorReturnsOnCall = make(map[int]struct {
+			result1 *api_enricher.ApparmorResponse
+			result2 error
+		})
+	}
+	fake.apparmorReturnsOnCall[i] = struct {
+		result1 *api_enricher.ApparmorResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) Avcs(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.AvcRequest) (*api_enricher.AvcResponse, error) {
 	fake.avcsMutex.Lock()
 	ret, specificReturn := fake.avcsReturnsOnCall[len(fake.avcsArgsForCall)]
@@ -353,13 +489,79 @@ func (fake *FakeImpl) AvcsReturnsOnCall(i int, result1 *api_enricher.AvcResponse
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) ClientGet(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName, arg4 client.Object) error {
+func (fake *FakeImpl) CapabilitiesForProfile(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient, arg3 *api_bpfrecorder.ProfileRequest) (*api_bpfrecorder.CapabilitiesResponse, error) {
+	fake.capabilitiesForProfileMutex.Lock()
+	ret, specificReturn := fake.capabilitiesForProfileReturnsOnCall[len(fake.capabilitiesForProfileArgsForCall)]
+	fake.capabilitiesForProfileArgsForCall = append(fake.capabilitiesForProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 api_bpfrecorder.BpfRecorderClient
+		arg3 *api_bpfrecorder.ProfileRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CapabilitiesForProfileStub
+	fakeReturns := fake.capabilitiesForProfileReturns
+	fake.recordInvocation("CapabilitiesForProfile", []interface{}{arg1, arg2, arg3})
+	fake.capabilitiesForProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) CapabilitiesForProfileCallCount() int {
+	fake.capabilitiesForProfileMutex.RLock()
+	defer fake.capabilitiesForProfileMutex.RUnlock()
+	return len(fake.capabilitiesForProfileArgsForCall)
+}
+
+func (fake *FakeImpl) CapabilitiesForProfileCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.ProfileRequest) (*api_bpfrecorder.CapabilitiesResponse, error)) {
+	fake.capabilitiesForProfileMutex.Lock()
+	defer fake.capabilitiesForProfileMutex.Unlock()
+	fake.CapabilitiesForProfileStub = stub
+}
+
+func (fake *FakeImpl) CapabilitiesForProfileArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.ProfileRequest) {
+	fake.capabilitiesForProfileMutex.RLock()
+	defer fake.capabilitiesForProfileMutex.RUnlock()
+	argsForCall := fake.capabilitiesForProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) CapabilitiesForProfileReturns(result1 *api_bpfrecorder.CapabilitiesResponse, result2 error) {
+	fake.capabilitiesForProfileMutex.Lock()
+	defer fake.capabilitiesForProfileMutex.Unlock()
+	fake.CapabilitiesForProfileStub = nil
+	fake.capabilitiesForProfileReturns = struct {
+		result1 *api_bpfrecorder.CapabilitiesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) CapabilitiesForProfileReturnsOnCall(i int, result1 *api_bpfrecorder.CapabilitiesResponse, result2 error) {
+	fake.capabilitiesForProfileMutex.Lock()
+	defer fake.capabilitiesForProfileMutex.Unlock()
+	fake.CapabilitiesForProfileStub = nil
+	if fake.capabilitiesForProfileReturnsOnCall == nil {
+		fake.capabilitiesForProfileReturnsOnCall = make(map[int]struct {
+			result1 *api_bpfrecorder.CapabilitiesResponse
+			result2 error
+		})
+	}
+	fake.capabilitiesForProfileReturnsOnCall[i] = struct {
+		result1 *api_bpfrecorder.CapabilitiesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ClientGet(arg1 context.Context, arg2 client.Client, arg3 client.ObjectKey, arg4 client.Object) error {
 	fake.clientGetMutex.Lock()
 	ret, specificReturn := fake.clientGetReturnsOnCall[len(fake.clientGetArgsForCall)]
 	fake.clientGetArgsForCall = append(fake.clientGetArgsForCall, struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 		arg4 client.Object
 	}{arg1, arg2, arg3, arg4})
 	stub := fake.ClientGetStub
@@ -381,13 +583,13 @@ func (fake *FakeImpl) ClientGetCallCount() int {
 	return len(fake.clientGetArgsForCall)
 }
 
-func (fake *FakeImpl) ClientGetCalls(stub func(context.Context, client.Client, types.NamespacedName, client.Object) error) {
+func (fake *FakeImpl) ClientGetCalls(stub func(context.Context, client.Client, client.ObjectKey, client.Object) error) {
 	fake.clientGetMutex.Lock()
 	defer fake.clientGetMutex.Unlock()
 	fake.ClientGetStub = stub
 }
 
-func (fake *FakeImpl) ClientGetArgsForCall(i int) (context.Context, client.Client, types.NamespacedName, client.Object) {
+func (fake *FakeImpl) ClientGetArgsForCall(i int) (context.Context, client.Client, client.ObjectKey, client.Object) {
 	fake.clientGetMutex.RLock()
 	defer fake.clientGetMutex.RUnlock()
 	argsForCall := fake.clientGetArgsForCall[i]
@@ -417,6 +619,69 @@ func (fake *FakeImpl) ClientGetReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) ClientUpdateStatus(arg1 context.Context, arg2 client.Client, arg3 client.Object) error {
+	fake.clientUpdateStatusMutex.Lock()
+	ret, specificReturn := fake.clientUpdateStatusReturnsOnCall[len(fake.clientUpdateStatusArgsForCall)]
+	fake.clientUpdateStatusArgsForCall = append(fake.clientUpdateStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 client.Object
+	}{arg1, arg2, arg3})
+	stub := fake.ClientUpdateStatusStub
+	fakeReturns := fake.clientUpdateStatusReturns
+	fake.recordInvocation("ClientUpdateStatus", []interface{}{arg1, arg2, arg3})
+	fake.clientUpdateStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) ClientUpdateStatusCallCount() int {
+	fake.clientUpdateStatusMutex.RLock()
+	defer fake.clientUpdateStatusMutex.RUnlock()
+	return len(fake.clientUpdateStatusArgsForCall)
+}
+
+func (fake *FakeImpl) ClientUpdateStatusCalls(stub func(context.Context, client.Client, client.Object) error) {
+	fake.clientUpdateStatusMutex.Lock()
+	defer fake.clientUpdateStatusMutex.Unlock()
+	fake.ClientUpdateStatusStub = stub
+}
+
+func (fake *FakeImpl) ClientUpdateStatusArgsForCall(i int) (context.Context, client.Client, client.Object) {
+	fake.clientUpdateStatusMutex.RLock()
+	defer fake.clientUpdateStatusMutex.RUnlock()
+	argsForCall := fake.clientUpdateStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ClientUpdateStatusReturns(result1 error) {
+	fake.clientUpdateStatusMutex.Lock()
+	defer fake.clientUpdateStatusMutex.Unlock()
+	fake.ClientUpdateStatusStub = nil
+	fake.clientUpdateStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ClientUpdateStatusReturnsOnCall(i int, result1 error) {
+	fake.clientUpdateStatusMutex.Lock()
+	defer fake.clientUpdateStatusMutex.Unlock()
+	fake.ClientUpdateStatusStub = nil
+	if fake.clientUpdateStatusReturnsOnCall == nil {
+		fake.clientUpdateStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clientUpdateStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) CreateOrUpdate(arg1 context.Context, arg2 client.Client, arg3 client.Object, arg4 controllerutil.MutateFn) (controllerutil.OperationResult, error) {
 	fake.createOrUpdateMutex.Lock()
 	ret, specificReturn := fake.createOrUpdateReturnsOnCall[len(fake.createOrUpdateArgsForCall)]
@@ -602,13 +867,13 @@ func (fake *FakeImpl) DialEnricherReturnsOnCall(i int, result1 *grpc.ClientConn,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeImpl) GetPod(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName) (*v1.Pod, error) {
+func (fake *FakeImpl) GetPod(arg1 context.Context, arg2 client.Client, arg3 client.ObjectKey) (*v1.Pod, error) {
 	fake.getPodMutex.Lock()
 	ret, specificReturn := fake.getPodReturnsOnCall[len(fake.getPodArgsForCall)]
 	fake.getPodArgsForCall = append(fake.getPodArgsForCall, struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 	}{arg1, arg2, arg3})
 	stub := fake.GetPodStub
 	fakeReturns := fake.getPodReturns
@@ -629,13 +894,13 @@ func (fake *FakeImpl) GetPodCallCount() int {
 	return len(fake.getPodArgsForCall)
 }
 
-func (fake *FakeImpl) GetPodCalls(stub func(context.Context, client.Client, types.NamespacedName) (*v1.Pod, error)) {
+func (fake *FakeImpl) GetPodCalls(stub func(context.Context, client.Client, client.ObjectKey) (*v1.Pod, error)) {
 	fake.getPodMutex.Lock()
 	defer fake.getPodMutex.Unlock()
 	fake.GetPodStub = stub
 }
 
-func (fake *FakeImpl) GetPodArgsForCall(i int) (context.Context, client.Client, types.NamespacedName) {
+func (fake *FakeImpl) GetPodArgsForCall(i int) (context.Context, client.Client, client.ObjectKey) {
 	fake.getPodMutex.RLock()
 	defer fake.getPodMutex.RUnlock()
 	argsForCall := fake.getPodArgsForCall[i]
@@ -1049,6 +1314,69 @@ func (fake *FakeImpl) NewControllerManagedByReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeImpl) ResetApparmor(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.ApparmorRequest) error {
+	fake.resetApparmorMutex.Lock()
+	ret, specificReturn := fake.resetApparmorReturnsOnCall[len(fake.resetApparmorArgsForCall)]
+	fake.resetApparmorArgsForCall = append(fake.resetApparmorArgsForCall, struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.ApparmorRequest
+	}{arg1, arg2, arg3})
+	stub := fake.ResetApparmorStub
+	fakeReturns := fake.resetApparmorReturns
+	fake.recordInvocation("ResetApparmor", []interface{}{arg1, arg2, arg3})
+	fake.resetApparmorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) ResetApparmorCallCount() int {
+	fake.resetApparmorMutex.RLock()
+	defer fake.resetApparmorMutex.RUnlock()
+	return len(fake.resetApparmorArgsForCall)
+}
+
+func (fake *FakeImpl) ResetApparmorCalls(stub func(context.Context, api_enricher.EnricherClient, *api_enricher.ApparmorRequest) error) {
+	fake.resetApparmorMutex.Lock()
+	defer fake.resetApparmorMutex.Unlock()
+	fake.ResetApparmorStub = stub
+}
+
+func (fake *FakeImpl) ResetApparmorArgsForCall(i int) (context.Context, api_enricher.EnricherClient, *api_enricher.ApparmorRequest) {
+	fake.resetApparmorMutex.RLock()
+	defer fake.resetApparmorMutex.RUnlock()
+	argsForCall := fake.resetApparmorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ResetApparmorReturns(result1 error) {
+	fake.resetApparmorMutex.Lock()
+	defer fake.resetApparmorMutex.Unlock()
+	fake.ResetApparmorStub = nil
+	fake.resetApparmorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ResetApparmorReturnsOnCall(i int, result1 error) {
+	fake.resetApparmorMutex.Lock()
+	defer fake.resetApparmorMutex.Unlock()
+	fake.ResetApparmorStub = nil
+	if fake.resetApparmorReturnsOnCall == nil {
+		fake.resetApparmorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetApparmorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) ResetAvcs(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.AvcRequest) error {
 	fake.resetAvcsMutex.Lock()
 	ret, specificReturn := fake.resetAvcsReturnsOnCall[len(fake.resetAvcsArgsForCall)]
@@ -1175,19 +1503,20 @@ func (fake *FakeImpl) ResetSyscallsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImpl) StartBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient) error {
+func (fake *FakeImpl) StartBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient, arg3 *api_bpfrecorder.SessionRequest) error {
 	fake.startBpfRecorderMutex.Lock()
 	ret, specificReturn := fake.startBpfRecorderReturnsOnCall[len(fake.startBpfRecorderArgsForCall)]
 	fake.startBpfRecorderArgsForCall = append(fake.startBpfRecorderArgsForCall, struct {
 		arg1 context.Context
 		arg2 api_bpfrecorder.BpfRecorderClient
-	}{arg1, arg2})
+		arg3 *api_bpfrecorder.SessionRequest
+	}{arg1, arg2, arg3})
 	stub := fake.StartBpfRecorderStub
 	fakeReturns := fake.startBpfRecorderReturns
-	fake.recordInvocation("StartBpfRecorder", []interface{}{arg1, arg2})
+	fake.recordInvocation("StartBpfRecorder", []interface{}{arg1, arg2, arg3})
 	fake.startBpfRecorderMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1201,17 +1530,17 @@ func (fake *FakeImpl) StartBpfRecorderCallCount() int {
 	return len(fake.startBpfRecorderArgsForCall)
 }
 
-func (fake *FakeImpl) StartBpfRecorderCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient) error) {
+func (fake *FakeImpl) StartBpfRecorderCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.SessionRequest) error) {
 	fake.startBpfRecorderMutex.Lock()
 	defer fake.startBpfRecorderMutex.Unlock()
 	fake.StartBpfRecorderStub = stub
 }
 
-func (fake *FakeImpl) StartBpfRecorderArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient) {
+func (fake *FakeImpl) StartBpfRecorderArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.SessionRequest) {
 	fake.startBpfRecorderMutex.RLock()
 	defer fake.startBpfRecorderMutex.RUnlock()
 	argsForCall := fake.startBpfRecorderArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeImpl) StartBpfRecorderReturns(result1 error) {
@@ -1237,19 +1566,20 @@ func (fake *FakeImpl) StartBpfRecorderReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImpl) StopBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient) error {
+func (fake *FakeImpl) StopBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient, arg3 *api_bpfrecorder.SessionRequest) error {
 	fake.stopBpfRecorderMutex.Lock()
 	ret, specificReturn := fake.stopBpfRecorderReturnsOnCall[len(fake.stopBpfRecorderArgsForCall)]
 	fake.stopBpfRecorderArgsForCall = append(fake.stopBpfRecorderArgsForCall, struct {
 		arg1 context.Context
 		arg2 api_bpfrecorder.BpfRecorderClient
-	}{arg1, arg2})
+		arg3 *api_bpfrecorder.SessionRequest
+	}{arg1, arg2, arg3})
 	stub := fake.StopBpfRecorderStub
 	fakeReturns := fake.stopBpfRecorderReturns
-	fake.recordInvocation("StopBpfRecorder", []interface{}{arg1, arg2})
+	fake.recordInvocation("StopBpfRecorder", []interface{}{arg1, arg2, arg3})
 	fake.stopBpfRecorderMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1263,17 +1593,17 @@ func (fake *FakeImpl) StopBpfRecorderCallCount() int {
 	return len(fake.stopBpfRecorderArgsForCall)
 }
 
-func (fake *FakeImpl) StopBpfRecorderCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient) error) {
+func (fake *FakeImpl) StopBpfRecorderCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.SessionRequest) error) {
 	fake.stopBpfRecorderMutex.Lock()
 	defer fake.stopBpfRecorderMutex.Unlock()
 	fake.StopBpfRecorderStub = stub
 }
 
-func (fake *FakeImpl) StopBpfRecorderArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient) {
+func (fake *FakeImpl) StopBpfRecorderArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.SessionRequest) {
 	fake.stopBpfRecorderMutex.RLock()
 	defer fake.stopBpfRecorderMutex.RUnlock()
 	argsForCall := fake.stopBpfRecorderArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeImpl) StopBpfRecorderReturns(result1 error) {
@@ -1299,6 +1629,69 @@ func (fake *FakeImpl) StopBpfRecorderReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) StopRecording(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.StopRecordingRequest) error {
+	fake.stopRecordingMutex.Lock()
+	ret, specificReturn := fake.stopRecordingReturnsOnCall[len(fake.stopRecordingArgsForCall)]
+	fake.stopRecordingArgsForCall = append(fake.stopRecordingArgsForCall, struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.StopRecordingRequest
+	}{arg1, arg2, arg3})
+	stub := fake.StopRecordingStub
+	fakeReturns := fake.stopRecordingReturns
+	fake.recordInvocation("StopRecording", []interface{}{arg1, arg2, arg3})
+	fake.stopRecordingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) StopRecordingCallCount() int {
+	fake.stopRecordingMutex.RLock()
+	defer fake.stopRecordingMutex.RUnlock()
+	return len(fake.stopRecordingArgsForCall)
+}
+
+func (fake *FakeImpl) StopRecordingCalls(stub func(context.Context, api_enricher.EnricherClient, *api_enricher.StopRecordingRequest) error) {
+	fake.stopRecordingMutex.Lock()
+	defer fake.stopRecordingMutex.Unlock()
+	fake.StopRecordingStub = stub
+}
+
+func (fake *FakeImpl) StopRecordingArgsForCall(i int) (context.Context, api_enricher.EnricherClient, *api_enricher.StopRecordingRequest) {
+	fake.stopRecordingMutex.RLock()
+	defer fake.stopRecordingMutex.RUnlock()
+	argsForCall := fake.stopRecordingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) StopRecordingReturns(result1 error) {
+	fake.stopRecordingMutex.Lock()
+	defer fake.stopRecordingMutex.Unlock()
+	fake.StopRecordingStub = nil
+	fake.stopRecordingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) StopRecordingReturnsOnCall(i int, result1 error) {
+	fake.stopRecordingMutex.Lock()
+	defer fake.stopRecordingMutex.Unlock()
+	fake.StopRecordingStub = nil
+	if fake.stopRecordingReturnsOnCall == nil {
+		fake.stopRecordingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.stopRecordingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Syscalls(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.SyscallsRequest) (*api_enricher.SyscallsResponse, error) {
 	fake.syscallsMutex.Lock()
 	ret, specificReturn := fake.syscallsReturnsOnCall[len(fake.syscallsArgsForCall)]
@@ -1434,10 +1827,16 @@ func (fake *FakeImpl) SyscallsForProfileReturnsOnCall(i int, result1 *api_bpfrec
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.apparmorMutex.RLock()
+	defer fake.apparmorMutex.RUnlock()
 	fake.avcsMutex.RLock()
 	defer fake.avcsMutex.RUnlock()
+	fake.capabilitiesForProfileMutex.RLock()
+	defer fake.capabilitiesForProfileMutex.RUnlock()
 	fake.clientGetMutex.RLock()
 	defer fake.clientGetMutex.RUnlock()
+	fake.clientUpdateStatusMutex.RLock()
+	defer fake.clientUpdateStatusMutex.RUnlock()
 	fake.createOrUpdateMutex.RLock()
 	defer fake.createOrUpdateMutex.RUnlock()
 	fake.dialBpfRecorderMutex.RLock()
@@ -1458,6 +1857,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.newClientMutex.RUnlock()
 	fake.newControllerManagedByMutex.RLock()
 	defer fake.newControllerManagedByMutex.RUnlock()
+	fake.resetApparmorMutex.RLock()
+	defer fake.resetApparmorMutex.RUnlock()
 	fake.resetAvcsMutex.RLock()
 	defer fake.resetAvcsMutex.RUnlock()
 	fake.resetSyscallsMutex.RLock()
@@ -1466,6 +1867,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.startBpfRecorderMutex.RUnlock()
 	fake.stopBpfRecorderMutex.RLock()
 	defer fake.stopBpfRecorderMutex.RUnlock()
+	fake.stopRecordingMutex.RLock()
+	defer fake.stopRecordingMutex.RUnlock()
 	fake.syscallsMutex.RLock()
 	defer fake.syscallsMutex.RUnlock()
 	fake.syscallsForProfileMutex.RLock()
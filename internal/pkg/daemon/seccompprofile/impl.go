@@ -40,6 +40,9 @@ type impl interface {
 	ClientGetProfile(
 		context.Context, client.Client, client.ObjectKey, ...client.GetOption,
 	) (*seccompprofileapi.SeccompProfile, error)
+	ClientGetClusterProfile(
+		context.Context, client.Client, client.ObjectKey, ...client.GetOption,
+	) (*seccompprofileapi.ClusterSeccompProfile, error)
 	IncSeccompProfileError(*metrics.Metrics, string)
 	RecordEvent(record.EventRecorder, runtime.Object, string, string, string)
 }
@@ -66,6 +69,14 @@ func (*defaultImpl) ClientGetProfile(
 	return profile, err
 }
 
+func (*defaultImpl) ClientGetClusterProfile(
+	ctx context.Context, c client.Client, key client.ObjectKey, opts ...client.GetOption,
+) (*seccompprofileapi.ClusterSeccompProfile, error) {
+	profile := &seccompprofileapi.ClusterSeccompProfile{}
+	err := c.Get(ctx, key, profile, opts...)
+	return profile, err
+}
+
 func (*defaultImpl) IncSeccompProfileError(m *metrics.Metrics, reason string) {
 	m.IncSeccompProfileError(reason)
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/containers/common/pkg/seccomp"
 	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -44,6 +45,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
@@ -92,12 +94,23 @@ const (
 
 // NewController returns a new empty controller instance.
 func NewController() controller.Controller {
+	return newReconciler(false)
+}
+
+// NewClusterController returns a new empty controller instance for cluster
+// scoped seccomp profiles.
+func NewClusterController() controller.Controller {
+	return newReconciler(true)
+}
+
+func newReconciler(clusterScoped bool) *Reconciler {
 	return &Reconciler{
 		impl: &defaultImpl{},
 		baseProfiles: ttlcache.New(
 			ttlcache.WithTTL[string, *seccompprofileapi.SeccompProfile](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, *seccompprofileapi.SeccompProfile](maxCacheItems),
 		),
+		clusterScoped: clusterScoped,
 	}
 }
 
@@ -112,13 +125,53 @@ type Reconciler struct {
 	save         saver
 	metrics      *metrics.Metrics
 	baseProfiles *ttlcache.Cache[string, *seccompprofileapi.SeccompProfile]
+
+	// clusterScoped indicates that the reconciler manages
+	// ClusterSeccompProfiles rather than SeccompProfiles.
+	clusterScoped bool
 }
 
 // Name returns the name of the controller.
 func (r *Reconciler) Name() string {
+	if r.clusterScoped {
+		return "cluster-seccomp-spod"
+	}
 	return "seccomp-spod"
 }
 
+// newProfile returns an empty profile of the kind managed by the reconciler.
+func (r *Reconciler) newProfile() seccompprofileapi.SeccompProfileObject {
+	if r.clusterScoped {
+		return &seccompprofileapi.ClusterSeccompProfile{}
+	}
+	return &seccompprofileapi.SeccompProfile{}
+}
+
+// listProfiles lists all profiles of the kind managed by the reconciler.
+func (r *Reconciler) listProfiles(ctx context.Context) ([]seccompprofileapi.SeccompProfileObject, error) {
+	var profiles []seccompprofileapi.SeccompProfileObject
+
+	if r.clusterScoped {
+		list := &seccompprofileapi.ClusterSeccompProfileList{}
+		if err := r.client.List(ctx, list, &client.ListOptions{}); err != nil {
+			return nil, fmt.Errorf("listing cluster seccomp profiles: %w", err)
+		}
+		for i := range list.Items {
+			profiles = append(profiles, &list.Items[i])
+		}
+		return profiles, nil
+	}
+
+	list := &seccompprofileapi.SeccompProfileList{}
+	if err := r.client.List(ctx, list, &client.ListOptions{}); err != nil {
+		return nil, fmt.Errorf("listing seccomp profiles: %w", err)
+	}
+	for i := range list.Items {
+		profiles = append(profiles, &list.Items[i])
+	}
+	return profiles, nil
+}
+
 // SchemeBuilder returns the API scheme of the controller.
 func (r *Reconciler) SchemeBuilder() *scheme.Builder {
 	return seccompprofileapi.SchemeBuilder
@@ -173,18 +226,48 @@ func (r *Reconciler) Setup(
 	r.save = saveProfileOnDisk
 	r.metrics = met
 
-	// Register the regular reconciler to manage SeccompProfiles
+	name := "profile"
+	if r.clusterScoped {
+		name = "clusterprofile"
+	}
+
+	// Register the regular reconciler to manage SeccompProfiles or
+	// ClusterSeccompProfiles
 	return ctrl.NewControllerManagedBy(mgr).
-		Named("profile").
-		For(&seccompprofileapi.SeccompProfile{}).
+		Named(name).
+		For(r.newProfile()).
 		Watches(
 			&source.Kind{Type: &spodapi.SecurityProfilesOperatorDaemon{}},
 			handler.EnqueueRequestsFromMapFunc(r.handleAllowedSyscallsChanged),
 			builder.WithPredicates(AllowedSyscallsChangedPredicate{}),
 		).
+		Watches(
+			&source.Kind{Type: &corev1.Node{}},
+			handler.EnqueueRequestsFromMapFunc(r.handleNodeChanged),
+			builder.WithPredicates(nodestatus.NodeLabelsChangedPredicate()),
+		).
 		Complete(r)
 }
 
+// handleNodeChanged enqueues all profiles using a node selector, because the
+// labels of the node may not match it any more or start to match it.
+func (r *Reconciler) handleNodeChanged(client.Object) []reconcile.Request {
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+
+	seccompProfiles, err := r.listProfiles(ctx)
+	if err != nil {
+		r.log.Error(err, "cannot list seccomp profiles in the cluster")
+		return []reconcile.Request{}
+	}
+
+	profiles := make([]profilebase.SecurityProfileBase, 0, len(seccompProfiles))
+	for _, sp := range seccompProfiles {
+		profiles = append(profiles, sp)
+	}
+	return nodestatus.NodeSelectorRequests(profiles)
+}
+
 func (r *Reconciler) handleAllowedSyscallsChanged(obj client.Object) []reconcile.Request {
 	spod, ok := obj.(*spodapi.SecurityProfilesOperatorDaemon)
 	if !ok {
@@ -198,16 +281,15 @@ func (r *Reconciler) handleAllowedSyscallsChanged(obj client.Object) []reconcile
 	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
 	defer cancel()
 
-	seccompProfileList := &seccompprofileapi.SeccompProfileList{}
-	if err := r.client.List(ctx, seccompProfileList, &client.ListOptions{}); err != nil {
+	seccompProfiles, err := r.listProfiles(ctx)
+	if err != nil {
 		r.log.Error(err, "cannot list seccomp profiles in the cluster")
 		return []reconcile.Request{}
 	}
 
 	reconcileRequests := []reconcile.Request{}
-	for i := range seccompProfileList.Items {
-		sp := &seccompProfileList.Items[i]
-		if err := allowProfile(sp, spod.Spec.AllowedSyscalls, spod.Spec.AllowedSeccompActions); err != nil {
+	for _, sp := range seccompProfiles {
+		if err := AllowProfile(sp, spod.Spec.AllowedSyscalls, spod.Spec.AllowedSeccompActions); err != nil {
 			r.log.Info(fmt.Sprintf("deleting not allowed seccomp profile %s/%s",
 				sp.GetNamespace(), sp.GetName()))
 			if err := r.client.Delete(ctx, sp, &client.DeleteOptions{}); err != nil {
@@ -254,6 +336,9 @@ func (r *Reconciler) checkSeccomp() error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/finalizers,verbs=delete;get;update;patch
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch;create;update;patch;delete
@@ -265,7 +350,7 @@ func (r *Reconciler) checkSeccomp() error {
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security.openshift.io,namespace="security-profiles-operator",resources=securitycontextconstraints,verbs=use
 
-// Reconcile reconciles a SeccompProfile.
+// Reconcile reconciles a SeccompProfile or ClusterSeccompProfile.
 func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger := r.log.WithValues("profile", req.Name, "namespace", req.Namespace)
 
@@ -280,7 +365,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
-	seccompProfile := &seccompprofileapi.SeccompProfile{}
+	seccompProfile := r.newProfile()
 	if err := r.client.Get(ctx, req.NamespacedName, seccompProfile); err != nil {
 		// Expected to find a SeccompProfile, return an error and requeue
 		if util.IgnoreNotFound(err) == nil {
@@ -293,10 +378,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 }
 
 func (r *Reconciler) mergeBaseProfile(
-	ctx context.Context, sp *seccompprofileapi.SeccompProfile, l logr.Logger,
-) (*seccompprofileapi.SeccompProfile, error) {
+	ctx context.Context, sp seccompprofileapi.SeccompProfileObject, l logr.Logger,
+) (seccompprofileapi.SeccompProfileObject, error) {
 	// Recursively resolve the syscalls
-	finalSyscalls, err := r.resolveSyscallsForProfile(ctx, sp, sp.Spec.Syscalls, l, 0)
+	finalSyscalls, err := r.resolveSyscallsForProfile(ctx, sp, sp.GetSpec().Syscalls, l, 0)
 	if err != nil {
 		return nil, fmt.Errorf("resolve syscalls: %w", err)
 	}
@@ -309,30 +394,33 @@ func (r *Reconciler) mergeBaseProfile(
 	jsonSyscalls := string(scBytes)
 
 	const key = "syscalls"
-	if sp.Annotations[key] != jsonSyscalls {
-		l.Info("Updating syscall annotations", "profile", sp.Name)
+	annotations := sp.GetAnnotations()
+	if annotations[key] != jsonSyscalls {
+		l.Info("Updating syscall annotations", "profile", sp.GetName())
 
-		if sp.Annotations == nil {
-			sp.Annotations = make(map[string]string)
+		if annotations == nil {
+			annotations = make(map[string]string)
 		}
 
-		sp.Annotations[key] = jsonSyscalls
+		annotations[key] = jsonSyscalls
+		sp.SetAnnotations(annotations)
 
 		if err := r.client.Update(ctx, sp); err != nil {
 			return nil, fmt.Errorf("update seccomp profile annotations: %w", err)
 		}
 	}
 
-	sp.Spec.Syscalls = finalSyscalls
+	sp.GetSpec().Syscalls = finalSyscalls
 	return sp, nil
 }
 
 // resolveSyscallsForProfile recursively resolves the syscalls for base
 // profiles up to a depth level of 15 is also caches the results when pulling
-// from OCI artifacts.
+// from OCI artifacts. Base profiles of cluster scoped profiles are always
+// cluster scoped as well.
 func (r *Reconciler) resolveSyscallsForProfile(
 	ctx context.Context,
-	sp *seccompprofileapi.SeccompProfile,
+	sp seccompprofileapi.SeccompProfileObject,
 	inputSyscalls []*seccompprofileapi.Syscall,
 	l logr.Logger,
 	level uint8,
@@ -345,13 +433,13 @@ func (r *Reconciler) resolveSyscallsForProfile(
 		)
 	}
 
-	baseProfileName := sp.Spec.BaseProfileName
+	baseProfileName := sp.GetSpec().BaseProfileName
 	if baseProfileName == "" {
 		// No base profile at all
 		return inputSyscalls, nil
 	}
 
-	baseProfile := &seccompprofileapi.SeccompProfile{}
+	var baseProfile seccompprofileapi.SeccompProfileObject
 
 	if strings.HasPrefix(baseProfileName, config.OCIProfilePrefix) {
 		// Pull remote base profile from an OCI artifact registry
@@ -375,9 +463,23 @@ func (r *Reconciler) resolveSyscallsForProfile(
 			if resType != artifact.PullResultTypeSeccompProfile {
 				return nil, fmt.Errorf("pull result type %s is not a seccomp profile", resType)
 			}
-			baseProfile = r.PullResultSeccompProfile(res)
-			r.baseProfiles.Set(from, baseProfile, ttlcache.DefaultTTL)
+			pulledProfile := r.PullResultSeccompProfile(res)
+			r.baseProfiles.Set(from, pulledProfile, ttlcache.DefaultTTL)
+			baseProfile = pulledProfile
 		}
+	} else if _, isCluster := sp.(*seccompprofileapi.ClusterSeccompProfile); isCluster ||
+		strings.HasPrefix(baseProfileName, config.ClusterProfilePrefix) {
+		// Cluster scoped base profile
+		name := strings.TrimPrefix(baseProfileName, config.ClusterProfilePrefix)
+		profile, err := r.ClientGetClusterProfile(ctx, r.client, util.NamespacedName(name, ""))
+		if err != nil {
+			l.Error(err, "cannot retrieve cluster base profile "+name)
+			r.IncSeccompProfileError(r.metrics, reasonInvalidSeccompProfile)
+			r.RecordEvent(r.record, sp, util.EventTypeWarning, reasonInvalidSeccompProfile, err.Error())
+			return nil, fmt.Errorf("merging cluster base profile: %w", err)
+		}
+
+		baseProfile = profile
 	} else {
 		// Local base profile
 		profile, err := r.ClientGetProfile(
@@ -393,7 +495,7 @@ func (r *Reconciler) resolveSyscallsForProfile(
 		baseProfile = profile
 	}
 
-	newSyscalls, err := util.UnionSyscalls(baseProfile.Spec.Syscalls, inputSyscalls)
+	newSyscalls, err := util.UnionSyscalls(baseProfile.GetSpec().Syscalls, inputSyscalls)
 	if err != nil {
 		return nil, fmt.Errorf("union syscalls: %w", err)
 	}
@@ -402,12 +504,12 @@ func (r *Reconciler) resolveSyscallsForProfile(
 }
 
 func (r *Reconciler) reconcileSeccompProfile(
-	ctx context.Context, sp *seccompprofileapi.SeccompProfile, l logr.Logger,
+	ctx context.Context, sp seccompprofileapi.SeccompProfileObject, l logr.Logger,
 ) (reconcile.Result, error) {
 	if sp == nil {
 		return reconcile.Result{}, errors.New(errSeccompProfileNil)
 	}
-	profileName := sp.Name
+	profileName := sp.GetName()
 
 	nodeStatus, err := nodestatus.NewForProfile(sp, r.client)
 	if err != nil {
@@ -431,7 +533,7 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return reconcile.Result{Requeue: false}, fmt.Errorf("validating profile: %w", err)
 	}
 
-	profileContent, err := json.Marshal(outputProfile.Spec)
+	profileContent, err := json.Marshal(outputProfile.GetSpec())
 	if err != nil {
 		l.Error(err, "cannot validate profile "+profileName)
 		r.metrics.IncSeccompProfileError(reasonInvalidSeccompProfile)
@@ -461,6 +563,22 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return reconcile.Result{}, nil
 	}
 
+	matchesNode, err := nodeStatus.MatchesNode(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("matching node selector: %w", err)
+	}
+	if !matchesNode {
+		l.Info("Node does not match the node selector of the profile, skipping")
+		if err := nodeStatus.SetSkipped(ctx, func() error {
+			return r.handleDeletion(sp)
+		}); err != nil {
+			r.metrics.IncSeccompProfileError(reasonCannotUpdateStatus)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+			return reconcile.Result{}, fmt.Errorf("setting node status to skipped: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	updated, err := r.save(profilePath, profileContent)
 	if err != nil {
 		l.Error(err, "cannot save profile into disk")
@@ -502,7 +620,7 @@ func (r *Reconciler) reconcileSeccompProfile(
 
 func (r *Reconciler) reconcileDeletion(
 	ctx context.Context,
-	sp *seccompprofileapi.SeccompProfile,
+	sp seccompprofileapi.SeccompProfileObject,
 	nsc *nodestatus.StatusClient,
 ) (reconcile.Result, error) {
 	hasStatus, err := nsc.Exists(ctx)
@@ -552,7 +670,7 @@ func (r *Reconciler) reconcileDeletion(
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) handleDeletion(sp *seccompprofileapi.SeccompProfile) error {
+func (r *Reconciler) handleDeletion(sp seccompprofileapi.SeccompProfileObject) error {
 	profilePath := sp.GetProfilePath()
 	err := os.Remove(profilePath)
 	if os.IsNotExist(err) {
@@ -566,13 +684,13 @@ func (r *Reconciler) handleDeletion(sp *seccompprofileapi.SeccompProfile) error
 	return nil
 }
 
-func (r *Reconciler) validateProfile(ctx context.Context, profile *seccompprofileapi.SeccompProfile) error {
+func (r *Reconciler) validateProfile(ctx context.Context, profile seccompprofileapi.SeccompProfileObject) error {
 	spod, err := common.GetSPOD(ctx, r.client)
 	if err != nil {
 		return fmt.Errorf("retrieving the SPOD configuration: %w", err)
 	}
 	if len(spod.Spec.AllowedSyscalls) > 0 {
-		return allowProfile(profile, spod.Spec.AllowedSyscalls, spod.Spec.AllowedSeccompActions)
+		return AllowProfile(profile, spod.Spec.AllowedSyscalls, spod.Spec.AllowedSeccompActions)
 	}
 	return nil
 }
@@ -594,11 +712,13 @@ func saveProfileOnDisk(fileName string, content []byte) (updated bool, err error
 	return true, nil
 }
 
-func allowProfile(
-	profile *seccompprofileapi.SeccompProfile, allowedSyscalls []string, allowedActions []seccomp.Action,
+// AllowProfile verifies that the profile only uses the allowed syscalls
+// for the allowed seccomp actions.
+func AllowProfile(
+	profile seccompprofileapi.SeccompProfileObject, allowedSyscalls []string, allowedActions []seccomp.Action,
 ) error {
 	syscalls := map[seccomp.Action]map[string]bool{}
-	for _, call := range profile.Spec.Syscalls {
+	for _, call := range profile.GetSpec().Syscalls {
 		if _, ok := syscalls[call.Action]; !ok {
 			syscalls[call.Action] = map[string]bool{}
 		}
@@ -623,7 +743,7 @@ func allowProfile(
 				}
 			}
 		}
-		if profile.Spec.DefaultAction == action && len(allowedSyscalls) > 0 {
+		if profile.GetSpec().DefaultAction == action && len(allowedSyscalls) > 0 {
 			return fmt.Errorf(errForbiddenProfile)
 		}
 	}
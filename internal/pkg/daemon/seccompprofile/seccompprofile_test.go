@@ -209,7 +209,7 @@ func TestGetProfilePath(t *testing.T) {
 	cases := []struct {
 		name string
 		want string
-		sp   *seccompprofileapi.SeccompProfile
+		sp   seccompprofileapi.SeccompProfileObject
 	}{
 		{
 			name: "AppendNamespaceAndProfile",
@@ -251,6 +251,15 @@ func TestGetProfilePath(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ClusterProfile",
+			want: path.Join(config.ProfilesRootPath(), seccompprofileapi.ClusterProfilesDir, "file.json"),
+			sp: &seccompprofileapi.ClusterSeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "file",
+				},
+			},
+		},
 		{
 			name: "AppendExtension",
 			want: path.Join(config.ProfilesRootPath(), "config-namespace", "file.json"),
@@ -464,7 +473,7 @@ func TestAllowProfile(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := allowProfile(tc.profile, tc.allowedSyscalls, tc.allowedSeccompActions)
+			got := AllowProfile(tc.profile, tc.allowedSyscalls, tc.allowedSeccompActions)
 
 			require.Equal(t, tc.want, got)
 		})
@@ -643,6 +652,35 @@ func TestResolveSyscallsForProfile(t *testing.T) {
 				require.Equal(t, "first", syscalls[2].Names[0])
 			},
 		},
+		{
+			name: "success cluster base profile",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
+				mock.ClientGetClusterProfileReturns(
+					&seccompprofileapi.ClusterSeccompProfile{
+						Spec: seccompprofileapi.SeccompProfileSpec{
+							Syscalls: []*seccompprofileapi.Syscall{
+								{Names: []string{"second"}},
+							},
+						},
+					}, nil,
+				)
+
+				return &seccompprofileapi.SeccompProfile{
+					Spec: seccompprofileapi.SeccompProfileSpec{
+						BaseProfileName: config.ClusterProfilePrefix + "test",
+						Syscalls: []*seccompprofileapi.Syscall{
+							{Names: []string{"first"}},
+						},
+					},
+				}
+			},
+			assert: func(syscalls []*seccompprofileapi.Syscall, err error) {
+				require.NoError(t, err)
+				require.Len(t, syscalls, 2)
+				require.Equal(t, "second", syscalls[0].Names[0])
+				require.Equal(t, "first", syscalls[1].Names[0])
+			},
+		},
 		{
 			name: "success two remote base profiles",
 			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
@@ -32,6 +32,22 @@ import (
 )
 
 type FakeImpl struct {
+	ClientGetClusterProfileStub        func(context.Context, client.Client, types.NamespacedName, ...client.GetOption) (*v1beta1.ClusterSeccompProfile, error)
+	clientGetClusterProfileMutex       sync.RWMutex
+	clientGetClusterProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+		arg4 []client.GetOption
+	}
+	clientGetClusterProfileReturns struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}
+	clientGetClusterProfileReturnsOnCall map[int]struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}
 	ClientGetProfileStub        func(context.Context, client.Client, types.NamespacedName, ...client.GetOption) (*v1beta1.SeccompProfile, error)
 	clientGetProfileMutex       sync.RWMutex
 	clientGetProfileArgsForCall []struct {
@@ -106,6 +122,73 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeImpl) ClientGetClusterProfile(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName, arg4 ...client.GetOption) (*v1beta1.ClusterSeccompProfile, error) {
+	fake.clientGetClusterProfileMutex.Lock()
+	ret, specificReturn := fake.clientGetClusterProfileReturnsOnCall[len(fake.clientGetClusterProfileArgsForCall)]
+	fake.clientGetClusterProfileArgsForCall = append(fake.clientGetClusterProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+		arg4 []client.GetOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClientGetClusterProfileStub
+	fakeReturns := fake.clientGetClusterProfileReturns
+	fake.recordInvocation("ClientGetClusterProfile", []interface{}{arg1, arg2, arg3, arg4})
+	fake.clientGetClusterProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileCallCount() int {
+	fake.clientGetClusterProfileMutex.RLock()
+	defer fake.clientGetClusterProfileMutex.RUnlock()
+	return len(fake.clientGetClusterProfileArgsForCall)
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileCalls(stub func(context.Context, client.Client, types.NamespacedName, ...client.GetOption) (*v1beta1.ClusterSeccompProfile, error)) {
+	fake.clientGetClusterProfileMutex.Lock()
+	defer fake.clientGetClusterProfileMutex.Unlock()
+	fake.ClientGetClusterProfileStub = stub
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileArgsForCall(i int) (context.Context, client.Client, types.NamespacedName, []client.GetOption) {
+	fake.clientGetClusterProfileMutex.RLock()
+	defer fake.clientGetClusterProfileMutex.RUnlock()
+	argsForCall := fake.clientGetClusterProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileReturns(result1 *v1beta1.ClusterSeccompProfile, result2 error) {
+	fake.clientGetClusterProfileMutex.Lock()
+	defer fake.clientGetClusterProfileMutex.Unlock()
+	fake.ClientGetClusterProfileStub = nil
+	fake.clientGetClusterProfileReturns = struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileReturnsOnCall(i int, result1 *v1beta1.ClusterSeccompProfile, result2 error) {
+	fake.clientGetClusterProfileMutex.Lock()
+	defer fake.clientGetClusterProfileMutex.Unlock()
+	fake.ClientGetClusterProfileStub = nil
+	if fake.clientGetClusterProfileReturnsOnCall == nil {
+		fake.clientGetClusterProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.ClusterSeccompProfile
+			result2 error
+		})
+	}
+	fake.clientGetClusterProfileReturnsOnCall[i] = struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) ClientGetProfile(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName, arg4 ...client.GetOption) (*v1beta1.SeccompProfile, error) {
 	fake.clientGetProfileMutex.Lock()
 	ret, specificReturn := fake.clientGetProfileReturnsOnCall[len(fake.clientGetProfileArgsForCall)]
@@ -435,6 +518,8 @@ func (fake *FakeImpl) RecordEventArgsForCall(i int) (record.EventRecorder, runti
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clientGetClusterProfileMutex.RLock()
+	defer fake.clientGetClusterProfileMutex.RUnlock()
 	fake.clientGetProfileMutex.RLock()
 	defer fake.clientGetProfileMutex.RUnlock()
 	fake.incSeccompProfileErrorMutex.RLock()
@@ -30,15 +30,21 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
@@ -53,6 +59,7 @@ const (
 	selinuxdPoliciesBaseURL = selinuxdSockAddr + "/policies/"
 	selinuxdReadyURL        = selinuxdSockAddr + "/ready"
 	selinuxdSocketTimeout   = 5 * time.Second
+	reconcileTimeout        = 1 * time.Minute
 
 	selinuxdReadyKey = "ready"
 )
@@ -97,6 +104,7 @@ type ReconcileSelinux struct {
 	controllerName    string
 	objectHandlerInit SelinuxObjectHandlerInit
 	ctrlBuilder       controllerBuilder
+	newProfileList    func() client.ObjectList
 }
 
 // Setup adds a controller that reconciles selinux profiles.
@@ -111,7 +119,38 @@ func (r *ReconcileSelinux) Setup(
 	r.record = mgr.GetEventRecorderFor(r.controllerName)
 	r.metrics = met
 
-	return r.ctrlBuilder(ctrl.NewControllerManagedBy(mgr), r)
+	return r.ctrlBuilder(ctrl.NewControllerManagedBy(mgr).Watches(
+		&source.Kind{Type: &corev1.Node{}},
+		handler.EnqueueRequestsFromMapFunc(r.handleNodeChanged),
+		builder.WithPredicates(nodestatus.NodeLabelsChangedPredicate()),
+	), r)
+}
+
+// handleNodeChanged enqueues all profiles using a node selector, because the
+// labels of the node may not match it any more or start to match it.
+func (r *ReconcileSelinux) handleNodeChanged(client.Object) []reconcile.Request {
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+
+	list := r.newProfileList()
+	if err := r.client.List(ctx, list); err != nil {
+		r.log.Error(err, "cannot list selinux profiles in the cluster")
+		return []reconcile.Request{}
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		r.log.Error(err, "cannot extract selinux profiles from list")
+		return []reconcile.Request{}
+	}
+
+	profiles := make([]profilebase.SecurityProfileBase, 0, len(items))
+	for _, item := range items {
+		if p, ok := item.(profilebase.SecurityProfileBase); ok {
+			profiles = append(profiles, p)
+		}
+	}
+	return nodestatus.NodeSelectorRequests(profiles)
 }
 
 // Name returns the name of the controller.
@@ -257,6 +296,24 @@ func (r *ReconcileSelinux) reconcilePolicy(
 		return reconcile.Result{}, nil
 	}
 
+	matchesNode, err := nodeStatus.MatchesNode(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("matching node selector: %w", err)
+	}
+	if !matchesNode {
+		l.Info("Node does not match the node selector of the profile, skipping")
+		if err := nodeStatus.SetSkipped(ctx, func() error {
+			// selinuxd unloads the policy once its file is gone
+			_, err := r.reconcileDeletePolicyFile(sp, l)
+			return err
+		}); err != nil {
+			r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdatePolicyStatus, err.Error())
+			return reconcile.Result{}, fmt.Errorf("setting node status to skipped: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	err = r.reconcilePolicyFile(sp, oh, l)
 	if err != nil {
 		r.metrics.IncSelinuxProfileError(reasonCannotWritePolicyFile)
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"html/template"
 	"strings"
+	"unicode"
 
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -44,6 +45,9 @@ func NewRawController() controller.Controller {
 		controllerName:    "rawselinuxprofile",
 		objectHandlerInit: newRawSelinuxProfileHandler,
 		ctrlBuilder:       rawSelinuxProfileControllerBuild,
+		newProfileList: func() client.ObjectList {
+			return &selxv1alpha2.RawSelinuxProfileList{}
+		},
 	}
 }
 
@@ -74,6 +78,73 @@ func (sph *rawSelinuxProfileHandler) GetProfileObject() selxv1alpha2.SelinuxProf
 }
 
 func (sph *rawSelinuxProfileHandler) Validate() error {
+	return ValidateRawProfile(sph.rsp)
+}
+
+// ValidateRawProfile verifies that the policy of the provided
+// RawSelinuxProfile is a well-formed list of CIL statements, which is
+// required to wrap it into a block.
+func ValidateRawProfile(rsp *selxv1alpha2.RawSelinuxProfile) error {
+	return validateCIL(rsp.Spec.Policy)
+}
+
+func validateCIL(policy string) error {
+	depth, line, statements := 0, 1, 0
+	expectKeyword := false
+
+	for i := 0; i < len(policy); i++ {
+		c := policy[i]
+		switch {
+		case c == '\n':
+			line++
+			continue
+		case unicode.IsSpace(rune(c)):
+			continue
+		case c == ';':
+			// Comments last until the end of the line
+			for i+1 < len(policy) && policy[i+1] != '\n' {
+				i++
+			}
+			continue
+		}
+
+		if expectKeyword && (c == '(' || c == ')') {
+			return fmt.Errorf("line %d: statement without keyword: %w", line, ErrInvalidCILPolicy)
+		}
+		expectKeyword = false
+
+		switch c {
+		case '(':
+			if depth == 0 {
+				statements++
+			}
+			depth++
+			expectKeyword = true
+		case ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("line %d: unexpected closing parenthesis: %w", line, ErrInvalidCILPolicy)
+			}
+		case '"':
+			end := strings.IndexByte(policy[i+1:], '"')
+			if end < 0 {
+				return fmt.Errorf("line %d: unterminated string: %w", line, ErrInvalidCILPolicy)
+			}
+			line += strings.Count(policy[i+1:i+1+end], "\n")
+			i += end + 1
+		default:
+			if depth == 0 {
+				return fmt.Errorf("line %d: content outside of a statement: %w", line, ErrInvalidCILPolicy)
+			}
+		}
+	}
+
+	if depth > 0 {
+		return fmt.Errorf("missing %d closing parentheses: %w", depth, ErrInvalidCILPolicy)
+	}
+	if statements == 0 {
+		return fmt.Errorf("no statements found: %w", ErrInvalidCILPolicy)
+	}
 	return nil
 }
 
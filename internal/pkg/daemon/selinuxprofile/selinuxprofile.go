@@ -35,6 +35,7 @@ import (
 )
 
 var (
+	ErrInvalidCILPolicy        = errors.New("invalid CIL policy")
 	ErrInvalidLabelKey         = errors.New("invalid label key")
 	ErrInvalidObjClass         = errors.New("invalid object class")
 	ErrInvalidPermission       = errors.New("invalid permission")
@@ -48,6 +49,9 @@ func NewController() controller.Controller {
 		controllerName:    "selinuxprofile",
 		objectHandlerInit: newSelinuxProfileHandler,
 		ctrlBuilder:       selinuxProfileControllerBuild,
+		newProfileList: func() client.ObjectList {
+			return &selxv1alpha2.SelinuxProfileList{}
+		},
 	}
 }
 
@@ -82,6 +86,11 @@ func (sph *selinuxProfileHandler) Init(
 		return err
 	}
 
+	sph.compileRegexes()
+	return nil
+}
+
+func (sph *selinuxProfileHandler) compileRegexes() {
 	// Matches alpha numerical names in upper and lower-case, as well as
 	// dashes and underscores. @self is also allowed explicitly.
 	// Must be at least one character.
@@ -93,7 +102,14 @@ func (sph *selinuxProfileHandler) Init(
 	// Must be at least one character.
 	// The characters must match from beginning to end of the string
 	sph.objClassPermRegex = regexp.MustCompile(`^[a-zA-Z0-9.\-_]+$`)
-	return nil
+}
+
+// ValidateProfile validates the provided SelinuxProfile without requiring it
+// to exist in the cluster, for example during admission.
+func ValidateProfile(cli client.Client, sp *selxv1alpha2.SelinuxProfile) error {
+	sph := &selinuxProfileHandler{sp: sp, cli: cli}
+	sph.compileRegexes()
+	return sph.Validate()
 }
 
 func (sph *selinuxProfileHandler) GetProfileObject() selxv1alpha2.SelinuxProfileObject {
@@ -134,7 +150,7 @@ func (sph *selinuxProfileHandler) validateAndTrackInherit(
 	// We default to System if Kind is left empty
 	case selxv1alpha2.SystemPolicyKind, "":
 		return sph.handleInheritSystemPolicy(ancestorRef)
-	case "SelinuxPolicy":
+	case "SelinuxProfile", "SelinuxPolicy":
 		return sph.handleInheritSPOPolicy(ancestorRef, namespace)
 	}
 	return fmt.Errorf("%s/%s: %w", ancestorRef.Kind, ancestorRef.Name, ErrUnknownKindForEntry)
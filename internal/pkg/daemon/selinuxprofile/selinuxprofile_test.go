@@ -20,6 +20,7 @@ import (
 	"context"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -406,3 +407,70 @@ func Test_selinuxProfileHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRawProfile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{
+			name: "valid policy",
+			policy: `(blockinherit container)
+; allow logging
+(allow process var_log_t ( dir ( open read getattr )))
+(typeattributeset cil_gen_require "quoted ( string")`,
+		},
+		{
+			name:    "empty policy",
+			policy:  "  ; only a comment\n",
+			wantErr: "no statements found",
+		},
+		{
+			name:    "missing closing parenthesis",
+			policy:  "(blockinherit container)\n(allow process var_log_t ( dir ( open ))",
+			wantErr: "missing 1 closing parentheses",
+		},
+		{
+			name:    "unexpected closing parenthesis",
+			policy:  "(blockinherit container))",
+			wantErr: "line 1: unexpected closing parenthesis",
+		},
+		{
+			name:    "content outside of a statement",
+			policy:  "(blockinherit container)\nallow process var_log_t",
+			wantErr: "line 2: content outside of a statement",
+		},
+		{
+			name:    "statement without keyword",
+			policy:  "(blockinherit container)\n(\n(allow process var_log_t))",
+			wantErr: "line 3: statement without keyword",
+		},
+		{
+			name:    "unterminated string",
+			policy:  `(typeattributeset cil_gen_require "open)`,
+			wantErr: "line 1: unterminated string",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateRawProfile(&selxv1alpha2.RawSelinuxProfile{
+				Spec: selxv1alpha2.RawSelinuxProfileSpec{Policy: tt.policy},
+			})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateRawProfile() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateRawProfile() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -96,6 +96,9 @@ func (r *StatusReconciler) Healthz(*http.Request) error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/finalizers,verbs=delete;get;update;patch
 
 // Security Profiles Operator RBAC permissions to manage Node Statuses
 //nolint:lll // required for kubebuilder
@@ -191,13 +194,29 @@ func (r *StatusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 		return reconcile.Result{Requeue: true}, nil
 	}
 
+	lowestCommonState := aggregateState(nodeStatusList)
+	logger.V(config.VerboseLevel).Info("Setting the status to", "Status", lowestCommonState)
+
+	return r.reconcileStatus(ctx, prof, lowestCommonState, lprof)
+}
+
+// aggregateState returns the lowest common state of all nodes matching the
+// node selector of the profile. The state is skipped if no node matches at all.
+func aggregateState(nodeStatusList *statusv1alpha1.SecurityProfileNodeStatusList) statusv1alpha1.ProfileState {
 	lowestCommonState := statusv1alpha1.LowestState
+	matchingNodes := 0
 	for i := range nodeStatusList.Items {
+		if nodeStatusList.Items[i].Status == statusv1alpha1.ProfileStateSkipped {
+			continue
+		}
+		matchingNodes++
 		lowestCommonState = statusv1alpha1.LowerOfTwoStates(lowestCommonState, nodeStatusList.Items[i].Status)
 	}
-	logger.V(config.VerboseLevel).Info("Setting the status to", "Status", lowestCommonState)
 
-	return r.reconcileStatus(ctx, prof, lowestCommonState, lprof)
+	if matchingNodes == 0 && len(nodeStatusList.Items) > 0 {
+		return statusv1alpha1.ProfileStateSkipped
+	}
+	return lowestCommonState
 }
 
 // removeStatusForDeletedNode removes the status for a node that has been deleted.
@@ -264,6 +283,10 @@ func (r *StatusReconciler) getProfileFromStatus(
 	switch ctrl.Kind {
 	case "SeccompProfile":
 		prof = &seccompprofileapi.SeccompProfile{}
+	case "ClusterSeccompProfile":
+		// cluster scoped profiles store their statuses in the operator namespace
+		key.Namespace = ""
+		prof = &seccompprofileapi.ClusterSeccompProfile{}
 	case "SelinuxProfile":
 		prof = &selxv1alpha2.SelinuxProfile{}
 	default:
@@ -306,6 +329,9 @@ func (r *StatusReconciler) reconcileStatus(
 	case statusv1alpha1.ProfileStatePartial:
 		outStatus.Status = statusv1alpha1.ProfileStatePartial
 		outStatus.SetConditions(spodv1alpha1.Unavailable())
+	case statusv1alpha1.ProfileStateSkipped:
+		outStatus.Status = statusv1alpha1.ProfileStateSkipped
+		outStatus.SetConditions(spodv1alpha1.Unavailable())
 	}
 
 	l.V(config.VerboseLevel).Info("Updating status")
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodestatus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+)
+
+func TestAggregateState(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		states []statusv1alpha1.ProfileState
+		want   statusv1alpha1.ProfileState
+	}{
+		{
+			name: "AllInstalled",
+			states: []statusv1alpha1.ProfileState{
+				statusv1alpha1.ProfileStateInstalled,
+				statusv1alpha1.ProfileStateInstalled,
+			},
+			want: statusv1alpha1.ProfileStateInstalled,
+		},
+		{
+			name: "OnePending",
+			states: []statusv1alpha1.ProfileState{
+				statusv1alpha1.ProfileStateInstalled,
+				statusv1alpha1.ProfileStatePending,
+			},
+			want: statusv1alpha1.ProfileStatePending,
+		},
+		{
+			name: "SkippedNodesAreIgnored",
+			states: []statusv1alpha1.ProfileState{
+				statusv1alpha1.ProfileStateInstalled,
+				statusv1alpha1.ProfileStateSkipped,
+			},
+			want: statusv1alpha1.ProfileStateInstalled,
+		},
+		{
+			name: "SkippedNodesDoNotHideErrors",
+			states: []statusv1alpha1.ProfileState{
+				statusv1alpha1.ProfileStateError,
+				statusv1alpha1.ProfileStateSkipped,
+			},
+			want: statusv1alpha1.ProfileStateError,
+		},
+		{
+			name: "AllSkipped",
+			states: []statusv1alpha1.ProfileState{
+				statusv1alpha1.ProfileStateSkipped,
+				statusv1alpha1.ProfileStateSkipped,
+			},
+			want: statusv1alpha1.ProfileStateSkipped,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			list := &statusv1alpha1.SecurityProfileNodeStatusList{}
+			for _, state := range tc.states {
+				list.Items = append(list.Items, statusv1alpha1.SecurityProfileNodeStatus{Status: state})
+			}
+			require.Equal(t, tc.want, aggregateState(list))
+		})
+	}
+}
@@ -23,8 +23,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
@@ -146,6 +148,8 @@ func newMergeableProfile(obj client.Object) (mergeableProfile, error) {
 		return &mergeableSeccompProfile{SeccompProfile: *obj}, nil
 	case *selinuxprofileapi.SelinuxProfile:
 		return &MergeableSelinuxProfile{SelinuxProfile: *obj}, nil
+	case *apparmorprofileapi.AppArmorProfile:
+		return &mergeableAppArmorProfile{AppArmorProfile: *obj}, nil
 	default:
 		return nil, fmt.Errorf("cannot convert %T to mergeableProfile", obj)
 	}
@@ -173,6 +177,41 @@ func (sp *mergeableSeccompProfile) merge(other mergeableProfile) error {
 	}
 	sp.Spec.Syscalls = syscalls
 
+	if err := unionAnnotation(
+		sp, otherSP, profilerecording1alpha1.ProfileExecutableSyscallsAnnotation,
+		util.UnionExecutableSyscallsAnnotations,
+	); err != nil {
+		return fmt.Errorf("union executable syscalls: %w", err)
+	}
+	if err := unionAnnotation(
+		sp, otherSP, profilerecording1alpha1.ProfileSyscallCountsAnnotation,
+		util.UnionSyscallCountsAnnotations,
+	); err != nil {
+		return fmt.Errorf("union syscall counts: %w", err)
+	}
+
+	return nil
+}
+
+// unionAnnotation merges the values of an annotation containing recorded data
+// of the other profile into the profile.
+func unionAnnotation(
+	profile, other metav1.Object, key string, union func(a, b string) (string, error),
+) error {
+	value, err := union(profile.GetAnnotations()[key], other.GetAnnotations()[key])
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		return nil
+	}
+
+	annotations := profile.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	profile.SetAnnotations(annotations)
 	return nil
 }
 
@@ -222,3 +261,109 @@ func addAllow(union, additional selinuxprofileapi.Allow) selinuxprofileapi.Allow
 
 	return union
 }
+
+type mergeableAppArmorProfile struct {
+	apparmorprofileapi.AppArmorProfile
+}
+
+func (sp *mergeableAppArmorProfile) getProfile() client.Object {
+	return &sp.AppArmorProfile
+}
+
+func (sp *mergeableAppArmorProfile) merge(other mergeableProfile) error {
+	otherSP, ok := other.(*mergeableAppArmorProfile)
+	if !ok {
+		return fmt.Errorf("cannot merge AppArmorProfile with %T", other)
+	}
+	if otherSP.Spec.Abstract == nil {
+		return nil
+	}
+	if sp.Spec.Abstract == nil {
+		sp.Spec.Abstract = &apparmorprofileapi.AppArmorAbstract{}
+	}
+
+	base, additional := sp.Spec.Abstract, otherSP.Spec.Abstract
+	if additional.Executable != nil {
+		if base.Executable == nil {
+			base.Executable = &apparmorprofileapi.AppArmorExecutablesRules{}
+		}
+		base.Executable.AllowedExecutables = unionStrings(
+			base.Executable.AllowedExecutables, additional.Executable.AllowedExecutables)
+		base.Executable.AllowedLibraries = unionStrings(
+			base.Executable.AllowedLibraries, additional.Executable.AllowedLibraries)
+	}
+	if additional.Filesystem != nil {
+		if base.Filesystem == nil {
+			base.Filesystem = &apparmorprofileapi.AppArmorFsRules{}
+		}
+		base.Filesystem = addFsRules(base.Filesystem, additional.Filesystem)
+	}
+	if additional.Network != nil {
+		if base.Network == nil {
+			base.Network = &apparmorprofileapi.AppArmorNetworkRules{}
+		}
+		addNetworkRules(base.Network, additional.Network)
+	}
+	if additional.Capability != nil {
+		if base.Capability == nil {
+			base.Capability = &apparmorprofileapi.AppArmorCapabilityRules{}
+		}
+		base.Capability.AllowedCapabilities = unionStrings(
+			base.Capability.AllowedCapabilities, additional.Capability.AllowedCapabilities)
+	}
+
+	return nil
+}
+
+// addFsRules merges two sets of filesystem rules. A path which is readable in
+// one profile and writable in the other becomes read-write.
+func addFsRules(union, additional *apparmorprofileapi.AppArmorFsRules) *apparmorprofileapi.AppArmorFsRules {
+	readable := sets.New(union.ReadOnlyPaths...).
+		Insert(union.ReadWritePaths...).
+		Insert(additional.ReadOnlyPaths...).
+		Insert(additional.ReadWritePaths...)
+	writable := sets.New(union.WriteOnlyPaths...).
+		Insert(union.ReadWritePaths...).
+		Insert(additional.WriteOnlyPaths...).
+		Insert(additional.ReadWritePaths...)
+
+	return &apparmorprofileapi.AppArmorFsRules{
+		ReadOnlyPaths:  nilIfEmpty(sets.List(readable.Difference(writable))),
+		WriteOnlyPaths: nilIfEmpty(sets.List(writable.Difference(readable))),
+		ReadWritePaths: nilIfEmpty(sets.List(readable.Intersection(writable))),
+	}
+}
+
+func addNetworkRules(union, additional *apparmorprofileapi.AppArmorNetworkRules) {
+	union.AllowRaw = orBool(union.AllowRaw, additional.AllowRaw)
+	if additional.Protocols != nil {
+		if union.Protocols == nil {
+			union.Protocols = &apparmorprofileapi.AppArmorAllowedProtocols{}
+		}
+		union.Protocols.AllowTCP = orBool(union.Protocols.AllowTCP, additional.Protocols.AllowTCP)
+		union.Protocols.AllowUDP = orBool(union.Protocols.AllowUDP, additional.Protocols.AllowUDP)
+	}
+	union.Families = unionStrings(union.Families, additional.Families)
+}
+
+func orBool(a, b *bool) *bool {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	res := *a || *b
+	return &res
+}
+
+func unionStrings(a, b []string) []string {
+	return nilIfEmpty(sets.List(sets.New(a...).Insert(b...)))
+}
+
+func nilIfEmpty(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 )
@@ -181,6 +182,77 @@ func TestMergeProfiles(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "Two apparmor profiles",
+			prepare: func(t *testing.T) []mergeableProfile {
+				t.Helper()
+
+				allow := true
+				parts := []apparmorprofileapi.AppArmorProfile{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-foobarbaz1",
+						},
+						Spec: apparmorprofileapi.AppArmorProfileSpec{
+							Abstract: &apparmorprofileapi.AppArmorAbstract{
+								Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+									AllowedExecutables: []string{"/bin/sh"},
+								},
+								Filesystem: &apparmorprofileapi.AppArmorFsRules{
+									ReadOnlyPaths:  []string{"/etc/passwd", "/var/lib/data"},
+									WriteOnlyPaths: []string{"/tmp/out"},
+								},
+							},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-foobarbaz2",
+						},
+						Spec: apparmorprofileapi.AppArmorProfileSpec{
+							Abstract: &apparmorprofileapi.AppArmorAbstract{
+								Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+									AllowedExecutables: []string{"/bin/sh", "/usr/bin/sleep"},
+								},
+								Filesystem: &apparmorprofileapi.AppArmorFsRules{
+									WriteOnlyPaths: []string{"/var/lib/data"},
+								},
+								Network: &apparmorprofileapi.AppArmorNetworkRules{
+									Protocols: &apparmorprofileapi.AppArmorAllowedProtocols{
+										AllowTCP: &allow,
+									},
+								},
+								Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+									AllowedCapabilities: []string{"chown"},
+								},
+							},
+						},
+					},
+				}
+
+				partialSpecs := make([]mergeableProfile, len(parts))
+				for i := range parts {
+					var err error
+					partialSpecs[i], err = newMergeableProfile(&parts[i])
+					require.NoError(t, err)
+				}
+				return partialSpecs
+			},
+			assert: func(profile mergeableProfile) error {
+				t.Helper()
+
+				mergedProf, ok := profile.getProfile().(*apparmorprofileapi.AppArmorProfile)
+				require.True(t, ok)
+				abstract := mergedProf.Spec.Abstract
+				require.Equal(t, []string{"/bin/sh", "/usr/bin/sleep"}, abstract.Executable.AllowedExecutables)
+				require.Equal(t, []string{"/etc/passwd"}, abstract.Filesystem.ReadOnlyPaths)
+				require.Equal(t, []string{"/tmp/out"}, abstract.Filesystem.WriteOnlyPaths)
+				require.Equal(t, []string{"/var/lib/data"}, abstract.Filesystem.ReadWritePaths)
+				require.True(t, *abstract.Network.Protocols.AllowTCP)
+				require.Equal(t, []string{"chown"}, abstract.Capability.AllowedCapabilities)
+				return nil
+			},
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
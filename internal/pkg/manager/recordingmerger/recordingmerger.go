@@ -23,12 +23,14 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
@@ -82,8 +84,10 @@ func (r *PolicyMergeReconciler) Healthz(*http.Request) error {
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/finalizers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
 
 // Reconcile reconciles a NodeStatus.
 func (r *PolicyMergeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
@@ -105,6 +109,15 @@ func (r *PolicyMergeReconciler) Reconcile(ctx context.Context, req reconcile.Req
 		logger.Info("Is being deleted, will check if there are policies to be merged")
 
 		if err := r.mergeProfiles(ctx, profileRecording); err != nil {
+			if statusErr := r.updateRecordingStatus(ctx, profileRecording,
+				func(status *profilerecording1alpha1.ProfileRecordingStatus) {
+					status.SetPhase(
+						profilerecording1alpha1.RecordingConditionFailed,
+						profilerecording1alpha1.ReasonMergeFailed, err.Error(),
+					)
+				}); statusErr != nil {
+				logger.Error(statusErr, "Cannot update profile recording status")
+			}
 			return reconcile.Result{}, fmt.Errorf("%s: %w", errMergingRec, err)
 		}
 		return reconcile.Result{}, nil
@@ -125,6 +138,8 @@ func (r *PolicyMergeReconciler) mergeProfiles(
 		err = r.mergeSeccompProfiles(ctx, profileRecording)
 	case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
 		err = r.mergeSelinuxProfiles(ctx, profileRecording)
+	case profilerecording1alpha1.ProfileRecordingKindAppArmorProfile:
+		err = r.mergeAppArmorProfiles(ctx, profileRecording)
 	default:
 		err = fmt.Errorf("%s: %s", errCannotMergeKind, profileRecording.Spec.Kind)
 		r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotMergeKind, err.Error())
@@ -155,6 +170,17 @@ func (r *PolicyMergeReconciler) mergeTypedProfiles(
 		return nil
 	}
 
+	if err := r.updateRecordingStatus(ctx, profileRecording,
+		func(status *profilerecording1alpha1.ProfileRecordingStatus) {
+			status.SetPhase(
+				profilerecording1alpha1.RecordingConditionMerging,
+				profilerecording1alpha1.ReasonMergingProfiles, "",
+			)
+		}); err != nil {
+		return fmt.Errorf("cannot update profile recording status: %w", err)
+	}
+
+	mergedProfiles := []profilerecording1alpha1.RecordedProfile{}
 	for cntName, cntPartialProfiles := range partialProfiles {
 		r.log.Info("Merging profiles for container", "container", cntName)
 
@@ -176,11 +202,62 @@ func (r *PolicyMergeReconciler) mergeTypedProfiles(
 			return fmt.Errorf("cannot create or update merged profile: action:  %w", err)
 		}
 		r.log.Info("Created/updated profile", "action", res, "name", mergedRecordingName)
+		mergedProfiles = append(mergedProfiles, profilerecording1alpha1.RecordedProfile{
+			Name:      mergedRecordingName,
+			Container: cntName,
+		})
+	}
+
+	// Update the status before deleting the partial profiles, because the
+	// recording may vanish once its finalizer is released.
+	if err := r.updateRecordingStatus(ctx, profileRecording,
+		func(status *profilerecording1alpha1.ProfileRecordingStatus) {
+			for i := range mergedProfiles {
+				capabilities := status.PartialProfileCapabilities(mergedProfiles[i].Container)
+				if len(capabilities) > 0 {
+					mergedProfiles[i].Capabilities = capabilities
+				}
+			}
+			status.RemovePartialProfiles()
+			for _, profile := range mergedProfiles {
+				status.AddProfile(profile)
+			}
+			status.SetPhase(
+				profilerecording1alpha1.RecordingConditionCompleted,
+				profilerecording1alpha1.ReasonProfilesMerged, "",
+			)
+		}); err != nil {
+		return fmt.Errorf("cannot update profile recording status: %w", err)
 	}
 
 	return deletePartialProfiles(ctx, r.client, profileItem, profileRecording)
 }
 
+// updateRecordingStatus applies the provided mutation to the latest status of
+// the recording and retries on conflicts.
+func (r *PolicyMergeReconciler) updateRecordingStatus(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	mutate func(*profilerecording1alpha1.ProfileRecordingStatus),
+) error {
+	return util.Retry(func() error {
+		current := &profilerecording1alpha1.ProfileRecording{}
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(profileRecording), current); err != nil {
+			if util.IgnoreNotFound(err) == nil {
+				return nil
+			}
+			return fmt.Errorf("%s: %w", errGetRecording, err)
+		}
+
+		mutate(&current.Status)
+
+		if err := r.client.Status().Update(ctx, current); err != nil {
+			return fmt.Errorf("cannot update profile recording status: %w", err)
+		}
+		return nil
+	}, kerrors.IsConflict)
+}
+
 type createUpdateFn func(
 	ctx context.Context,
 	client client.Client,
@@ -221,6 +298,17 @@ func createUpdateSeccompProfile(
 	return controllerutil.CreateOrUpdate(ctx, cl, mergedSp,
 		func() error {
 			mergedSp.Spec = *mergedSpec
+			for _, key := range []string{
+				profilerecording1alpha1.ProfileExecutableSyscallsAnnotation,
+				profilerecording1alpha1.ProfileSyscallCountsAnnotation,
+			} {
+				if value, ok := mergedProf.GetAnnotations()[key]; ok {
+					if mergedSp.Annotations == nil {
+						mergedSp.Annotations = map[string]string{}
+					}
+					mergedSp.Annotations[key] = value
+				}
+			}
 			return nil
 		},
 	)
@@ -263,3 +351,41 @@ func createUpdateSelinuxProfile(
 		},
 	)
 }
+
+func (r *PolicyMergeReconciler) mergeAppArmorProfiles(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) error {
+	return r.mergeTypedProfiles(
+		ctx,
+		profileRecording,
+		createUpdateAppArmorProfile,
+		&apparmorprofileapi.AppArmorProfile{},
+		&apparmorprofileapi.AppArmorProfileList{})
+}
+
+func createUpdateAppArmorProfile(
+	ctx context.Context,
+	cl client.Client,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	mergedRecordingName string,
+	mergedProfiles mergeableProfile,
+) (controllerutil.OperationResult, error) {
+	mergedAp := &apparmorprofileapi.AppArmorProfile{
+		ObjectMeta: *mergedObjectMeta(mergedRecordingName, profileRecording.Name, profileRecording.Namespace),
+	}
+
+	mergedProf, ok := mergedProfiles.getProfile().(*apparmorprofileapi.AppArmorProfile)
+	if !ok {
+		return controllerutil.OperationResultNone, fmt.Errorf("cannot convert merged profile to AppArmorProfile")
+	}
+
+	mergedSpec := mergedProf.Spec.DeepCopy()
+	mergedAp.Spec = *mergedSpec
+	return controllerutil.CreateOrUpdate(ctx, cl, mergedAp,
+		func() error {
+			mergedAp.Spec = *mergedSpec
+			return nil
+		},
+	)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func partialSeccompProfile(name, syscall string) *seccompprofile.SeccompProfile {
+	return &seccompprofile.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Labels: map[string]string{
+				profilerecording1alpha1.ProfileToRecordingLabel: "rec",
+				profilerecording1alpha1.ProfileToContainerLabel: "ctr",
+				profilebase.ProfilePartialLabel:                 "true",
+			},
+		},
+		Spec: seccompprofile.SeccompProfileSpec{
+			DefaultAction: seccomp.ActErrno,
+			Syscalls: []*seccompprofile.Syscall{{
+				Action: seccomp.ActAllow,
+				Names:  []string{syscall},
+			}},
+		},
+	}
+}
+
+func TestReconcileUpdatesRecordingStatus(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.Nil(t, profilerecording1alpha1.AddToScheme(scheme))
+	require.Nil(t, seccompprofile.AddToScheme(scheme))
+
+	now := metav1.Now()
+	recording := &profilerecording1alpha1.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "rec",
+			Namespace:         "ns",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{profilerecording1alpha1.RecordingHasUnmergedProfiles},
+		},
+		Spec: profilerecording1alpha1.ProfileRecordingSpec{
+			Kind:          profilerecording1alpha1.ProfileRecordingKindSeccompProfile,
+			MergeStrategy: profilerecording1alpha1.ProfileMergeContainers,
+		},
+		Status: profilerecording1alpha1.ProfileRecordingStatus{
+			Profiles: []profilerecording1alpha1.RecordedProfile{
+				{Name: "rec-ctr-1", Container: "ctr", Partial: true, Capabilities: []string{"NET_RAW"}},
+				{Name: "rec-ctr-2", Container: "ctr", Partial: true, Capabilities: []string{"CHOWN", "NET_RAW"}},
+			},
+		},
+	}
+
+	partial1 := partialSeccompProfile("rec-ctr-1", "read")
+	partial1.Annotations = map[string]string{
+		profilerecording1alpha1.ProfileExecutableSyscallsAnnotation: `{"/bin/sh":["read"]}`,
+		profilerecording1alpha1.ProfileSyscallCountsAnnotation:      `{"read":3}`,
+	}
+	partial2 := partialSeccompProfile("rec-ctr-2", "write")
+	partial2.Annotations = map[string]string{
+		profilerecording1alpha1.ProfileExecutableSyscallsAnnotation: `{"/bin/sh":["write"],"/usr/bin/gdb":["ptrace"]}`,
+		profilerecording1alpha1.ProfileSyscallCountsAnnotation:      `{"ptrace":1,"read":2,"write":5}`,
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		recording, partial1, partial2,
+	).Build()
+
+	sut := &PolicyMergeReconciler{
+		client: cli,
+		log:    logr.Discard(),
+		record: record.NewFakeRecorder(10),
+	}
+
+	key := types.NamespacedName{Name: "rec", Namespace: "ns"}
+	_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+	require.Nil(t, err)
+
+	updated := &profilerecording1alpha1.ProfileRecording{}
+	require.Nil(t, cli.Get(context.Background(), key, updated))
+	require.Equal(t, profilerecording1alpha1.RecordingConditionCompleted, updated.Status.Phase())
+	require.Equal(t, []profilerecording1alpha1.RecordedProfile{
+		{Name: "rec-ctr", Container: "ctr", Capabilities: []string{"CHOWN", "NET_RAW"}},
+	}, updated.Status.Profiles)
+	require.Equal(t, []profilerecording1alpha1.RecordedContainer{
+		{Name: "ctr", Profiles: 1, Capabilities: []string{"CHOWN", "NET_RAW"}},
+	}, updated.Status.Containers)
+
+	merged := &seccompprofile.SeccompProfile{}
+	require.Nil(t, cli.Get(context.Background(), types.NamespacedName{Name: "rec-ctr", Namespace: "ns"}, merged))
+	require.Equal(t,
+		`{"/bin/sh":["read","write"],"/usr/bin/gdb":["ptrace"]}`,
+		merged.Annotations[profilerecording1alpha1.ProfileExecutableSyscallsAnnotation],
+	)
+	require.Equal(t,
+		`{"ptrace":1,"read":5,"write":5}`,
+		merged.Annotations[profilerecording1alpha1.ProfileSyscallCountsAnnotation],
+	)
+}
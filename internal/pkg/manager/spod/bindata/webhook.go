@@ -43,6 +43,7 @@ var (
 	caBundle                      = []byte("Cg==")
 	bindingPath                   = "/mutate-v1-pod-binding"
 	recordingPath                 = "/mutate-v1-pod-recording"
+	validationPath                = "/validate-v1-security-profile"
 	sideEffects                   = admissionregv1.SideEffectClassNone
 	admissionReviewVersions       = []string{"v1beta1"}
 	rules                         = []admissionregv1.RuleWithOperations{
@@ -57,6 +58,24 @@ var (
 			},
 		},
 	}
+	validationRules = []admissionregv1.RuleWithOperations{
+		{
+			Operations: []admissionregv1.OperationType{
+				"CREATE", "UPDATE",
+			},
+			Rule: admissionregv1.Rule{
+				APIGroups:   []string{"security-profiles-operator.x-k8s.io"},
+				APIVersions: []string{"*"},
+				Resources: []string{
+					"seccompprofiles",
+					"clusterseccompprofiles",
+					"selinuxprofiles",
+					"rawselinuxprofiles",
+					"apparmorprofiles",
+				},
+			},
+		},
+	}
 	objectSelector = metav1.LabelSelector{
 		MatchExpressions: []metav1.LabelSelectorRequirement{
 			{
@@ -78,20 +97,22 @@ const (
 )
 
 const (
-	webhookName        = config.OperatorName + "-webhook"
-	webhookConfigName  = "spo-mutating-webhook-configuration"
-	serviceAccountName = "spo-webhook"
-	certsMountPath     = "/tmp/k8s-webhook-server/serving-certs"
-	containerPort      = 9443
-	serviceName        = "webhook-service"
-	webhookServerCert  = "webhook-server-cert"
+	webhookName          = config.OperatorName + "-webhook"
+	webhookConfigName    = "spo-mutating-webhook-configuration"
+	validatingConfigName = "spo-validating-webhook-configuration"
+	serviceAccountName   = "spo-webhook"
+	certsMountPath       = "/tmp/k8s-webhook-server/serving-certs"
+	containerPort        = 9443
+	serviceName          = "webhook-service"
+	webhookServerCert    = "webhook-server-cert"
 )
 
 type Webhook struct {
-	log        logr.Logger
-	deployment *appsv1.Deployment
-	config     *admissionregv1.MutatingWebhookConfiguration
-	service    *corev1.Service
+	log              logr.Logger
+	deployment       *appsv1.Deployment
+	config           *admissionregv1.MutatingWebhookConfiguration
+	validatingConfig *admissionregv1.ValidatingWebhookConfiguration
+	service          *corev1.Service
 }
 
 func GetWebhook(
@@ -123,6 +144,10 @@ func GetWebhook(
 	cfg.Webhooks[0].ClientConfig.Service.Namespace = namespace
 	cfg.Webhooks[1].ClientConfig.Service.Namespace = namespace
 
+	validatingCfg := validatingWebhookConfig.DeepCopy()
+	validatingCfg.Namespace = namespace
+	validatingCfg.Webhooks[0].ClientConfig.Service.Namespace = namespace
+
 	service := webhookService.DeepCopy()
 	service.Namespace = namespace
 
@@ -131,10 +156,12 @@ func GetWebhook(
 		cfg.Annotations = map[string]string{
 			"cert-manager.io/inject-ca-from": config.OperatorName + "/webhook-cert",
 		}
+		validatingCfg.Annotations = cfg.Annotations
 	case CAInjectTypeOpenShift:
 		cfg.Annotations = map[string]string{
 			"service.beta.openshift.io/inject-cabundle": "true",
 		}
+		validatingCfg.Annotations = cfg.Annotations
 		service.Annotations = map[string]string{
 			openshiftCertAnnotation: webhookServerCert,
 		}
@@ -144,12 +171,14 @@ func GetWebhook(
 
 	// then apply the user-specified opts
 	applyWebhookOptions(cfg, webhookOpts)
+	applyValidatingWebhookOptions(validatingCfg, webhookOpts)
 
 	return &Webhook{
-		log:        log,
-		deployment: deployment,
-		config:     cfg,
-		service:    service,
+		log:              log,
+		deployment:       deployment,
+		config:           cfg,
+		validatingConfig: validatingCfg,
+		service:          service,
 	}
 }
 
@@ -157,7 +186,7 @@ func (w *Webhook) Create(ctx context.Context, c client.Client) error {
 	for k, o := range w.objectMap() {
 		if err := c.Create(ctx, o); err != nil {
 			if errors.IsAlreadyExists(err) {
-				if k == "config" {
+				if k == "config" || k == "validatingConfig" {
 					// The config already exists because it's a global resource we have to remove later on
 					if err := c.Patch(ctx, o, client.Merge); err != nil {
 						return fmt.Errorf("updating %s: %w", k, err)
@@ -200,7 +229,41 @@ func applyWebhookOptions(cfg *admissionregv1.MutatingWebhookConfiguration, opts
 	}
 }
 
+func applyValidatingWebhookOptions(
+	cfg *admissionregv1.ValidatingWebhookConfiguration, opts []spodv1alpha1.WebhookOptions,
+) {
+	for i := range cfg.Webhooks {
+		hook := &cfg.Webhooks[i]
+		for j := range opts {
+			userOpt := &opts[j]
+			if userOpt.Name != hook.Name {
+				continue
+			}
+
+			if userOpt.FailurePolicy != nil {
+				hook.FailurePolicy = userOpt.FailurePolicy
+			}
+
+			if userOpt.NamespaceSelector != nil {
+				hook.NamespaceSelector = userOpt.NamespaceSelector
+			}
+
+			if userOpt.ObjectSelector != nil {
+				hook.ObjectSelector = userOpt.ObjectSelector
+			}
+		}
+	}
+}
+
 func (w *Webhook) NeedsUpdate(ctx context.Context, c client.Client) (bool, error) {
+	needsUpdate, err := w.mutatingConfigNeedsUpdate(ctx, c)
+	if err != nil || needsUpdate {
+		return needsUpdate, err
+	}
+	return w.validatingConfigNeedsUpdate(ctx, c)
+}
+
+func (w *Webhook) mutatingConfigNeedsUpdate(ctx context.Context, c client.Client) (bool, error) {
 	existingWebHook := admissionregv1.MutatingWebhookConfiguration{}
 
 	if err := c.Get(ctx,
@@ -231,6 +294,52 @@ func (w *Webhook) NeedsUpdate(ctx context.Context, c client.Client) (bool, error
 	return false, nil
 }
 
+func (w *Webhook) validatingConfigNeedsUpdate(ctx context.Context, c client.Client) (bool, error) {
+	existingWebHook := admissionregv1.ValidatingWebhookConfiguration{}
+
+	if err := c.Get(ctx,
+		types.NamespacedName{Namespace: w.validatingConfig.Namespace, Name: w.validatingConfig.Name},
+		&existingWebHook); err != nil {
+		if errors.IsNotFound(err) {
+			// Deployments prior to the validating webhook
+			return true, nil
+		}
+		return false, err
+	}
+
+	if len(existingWebHook.Webhooks) != len(w.validatingConfig.Webhooks) {
+		return true, nil
+	}
+
+	for i := range existingWebHook.Webhooks {
+		ew := mutatingWebhookTunables(&existingWebHook.Webhooks[i])
+		for j := range w.validatingConfig.Webhooks {
+			cw := mutatingWebhookTunables(&w.validatingConfig.Webhooks[j])
+
+			if ew.Name != cw.Name {
+				continue
+			}
+
+			if webhookNeedsUpdate(ew, cw) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// mutatingWebhookTunables converts the settings of a validating webhook
+// which are tunable in spod, to be able to compare them.
+func mutatingWebhookTunables(hook *admissionregv1.ValidatingWebhook) *admissionregv1.MutatingWebhook {
+	return &admissionregv1.MutatingWebhook{
+		Name:              hook.Name,
+		FailurePolicy:     hook.FailurePolicy,
+		NamespaceSelector: hook.NamespaceSelector,
+		ObjectSelector:    hook.ObjectSelector,
+	}
+}
+
 // only compare the settings that are tunable in spod now.
 func webhookNeedsUpdate(existing, configured *admissionregv1.MutatingWebhook) bool {
 	if existing.FailurePolicy == nil && configured.FailurePolicy != nil ||
@@ -276,6 +385,12 @@ func webhookNeedsUpdate(existing, configured *admissionregv1.MutatingWebhook) bo
 func (w *Webhook) Update(ctx context.Context, c client.Client) error {
 	for k, o := range w.objectMap() {
 		if err := c.Patch(ctx, o, client.Merge); err != nil {
+			if errors.IsNotFound(err) {
+				if err := c.Create(ctx, o); err != nil {
+					return fmt.Errorf("creating %s: %w", k, err)
+				}
+				continue
+			}
 			return fmt.Errorf("updating %s: %w", k, err)
 		}
 	}
@@ -284,9 +399,10 @@ func (w *Webhook) Update(ctx context.Context, c client.Client) error {
 
 func (w *Webhook) objectMap() map[string]client.Object {
 	return map[string]client.Object{
-		"deployment": w.deployment,
-		"config":     w.config,
-		"service":    w.service,
+		"deployment":       w.deployment,
+		"config":           w.config,
+		"validatingConfig": w.validatingConfig,
+		"service":          w.service,
 	}
 }
 
@@ -444,6 +560,28 @@ var webhookConfig = &admissionregv1.MutatingWebhookConfiguration{
 	},
 }
 
+var validatingWebhookConfig = &admissionregv1.ValidatingWebhookConfiguration{
+	ObjectMeta: metav1.ObjectMeta{
+		Name: validatingConfigName,
+	},
+	Webhooks: []admissionregv1.ValidatingWebhook{
+		{
+			Name:          "validation.spo.io",
+			FailurePolicy: &failurePolicy,
+			SideEffects:   &sideEffects,
+			Rules:         validationRules,
+			ClientConfig: admissionregv1.WebhookClientConfig{
+				CABundle: caBundle,
+				Service: &admissionregv1.ServiceReference{
+					Name: serviceName,
+					Path: &validationPath,
+				},
+			},
+			AdmissionReviewVersions: admissionReviewVersions,
+		},
+	},
+}
+
 var webhookService = &corev1.Service{
 	ObjectMeta: metav1.ObjectMeta{
 		Name:   serviceName,
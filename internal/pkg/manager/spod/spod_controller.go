@@ -18,10 +18,13 @@ package spod
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -55,6 +58,10 @@ const (
 	reasonCannotUpdateSPOD string = "CannotUpdateSPOD"
 
 	appArmorAnnotation = "container.seccomp.security.alpha.kubernetes.io/security-profiles-operator"
+
+	// logEnricherSinksPath is the path within the log enricher container
+	// below which the directories of the File sinks are mounted.
+	logEnricherSinksPath = "/var/run/log-enricher-sinks"
 )
 
 // NewController returns a new empty controller instance.
@@ -107,6 +114,7 @@ func (r *ReconcileSPOd) Healthz(*http.Request) error {
 // +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets/finalizers,verbs=delete;get;update;patch
 // +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=issuers;certificates,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons/status,verbs=get;update;patch
@@ -550,6 +558,29 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 			ctr.VolumeMounts = append(ctr.VolumeMounts, mount)
 		}
 
+		if cfg.Spec.LogEnricherSource != "" {
+			ctr.Args = append(ctr.Args, "--log-source="+string(cfg.Spec.LogEnricherSource))
+		}
+
+		if len(cfg.Spec.LogEnricherSinks) > 0 {
+			containerSinks, volumes, mounts := logEnricherSinkVolumes(cfg.Spec.LogEnricherSinks)
+			sinks, err := json.Marshal(containerSinks)
+			if err != nil {
+				r.log.Error(err, "Unable to configure log enricher sinks")
+			} else {
+				ctr.Args = append(ctr.Args, "--sinks="+string(sinks))
+				templateSpec.Volumes = append(templateSpec.Volumes, volumes...)
+				ctr.VolumeMounts = append(ctr.VolumeMounts, mounts...)
+			}
+		}
+
+		if cfg.Spec.EnableBpfViolationObserver {
+			ctr.Args = append(ctr.Args, "--bpf-violations")
+			ctr.VolumeMounts = append(ctr.VolumeMounts, bpfVolumeMounts(
+				r.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDBpfRecorder],
+			)...)
+		}
+
 		templateSpec.Containers = append(templateSpec.Containers, ctr)
 		// pass the log enricher env var to the daemon as the profile recorder is otherwise disabled
 		addEnvVar(templateSpec, config.EnableLogEnricherEnvKey)
@@ -564,6 +595,14 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 			ctr.VolumeMounts = append(ctr.VolumeMounts, mount)
 		}
 
+		if len(cfg.Spec.BpfRecorderSyscallArgs) > 0 {
+			ctr.Args = append(ctr.Args, bpfRecorderSyscallArgsFlag(cfg.Spec.BpfRecorderSyscallArgs))
+		}
+
+		if cfg.Spec.BpfRecorderKey != "" {
+			ctr.Args = append(ctr.Args, "--recording-key="+string(cfg.Spec.BpfRecorderKey))
+		}
+
 		templateSpec.Containers = append(templateSpec.Containers, ctr)
 		// pass the bpf recorder env var to the daemon as the profile recorder is otherwise disabled
 		addEnvVar(templateSpec, config.EnableBpfRecorderEnvKey)
@@ -678,6 +717,73 @@ func isBpfRecorderEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon) bool
 	return cfg.Spec.EnableBpfRecorder || enableBpfRecorderEnv
 }
 
+// bpfVolumeMounts returns the volume mounts of the bpf recorder which are
+// required to load the bpf module.
+func bpfVolumeMounts(bpfRecorder corev1.Container) []corev1.VolumeMount {
+	mounts := []corev1.VolumeMount{}
+	for _, mount := range bpfRecorder.VolumeMounts {
+		if mount.Name != "grpc-server-volume" {
+			mounts = append(mounts, mount)
+		}
+	}
+	return mounts
+}
+
+// logEnricherSinkVolumes returns the host path volumes and mounts for the
+// directories of the File sinks of the log enricher, together with the sinks
+// using the paths within the container. The directories are mounted below a
+// dedicated path to not collide with the existing mounts of the container.
+func logEnricherSinkVolumes(
+	sinks []spodv1alpha1.EnricherSink,
+) (containerSinks []spodv1alpha1.EnricherSink, volumes []corev1.Volume, mounts []corev1.VolumeMount) {
+	hostPathType := corev1.HostPathDirectoryOrCreate
+	mountPaths := map[string]string{}
+	containerSinks = make([]spodv1alpha1.EnricherSink, 0, len(sinks))
+	for i := range sinks {
+		sink := sinks[i]
+		if sink.Type != spodv1alpha1.EnricherSinkTypeFile || !filepath.IsAbs(sink.Path) {
+			containerSinks = append(containerSinks, sink)
+			continue
+		}
+
+		dir := filepath.Dir(sink.Path)
+		mountPath, ok := mountPaths[dir]
+		if !ok {
+			name := fmt.Sprintf("log-enricher-sink-%d", len(mountPaths))
+			mountPath = filepath.Join(logEnricherSinksPath, name)
+			mountPaths[dir] = mountPath
+
+			volumes = append(volumes, corev1.Volume{
+				Name: name,
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{
+						Path: dir,
+						Type: &hostPathType,
+					},
+				},
+			})
+			mounts = append(mounts, corev1.VolumeMount{
+				Name:      name,
+				MountPath: mountPath,
+			})
+		}
+
+		sink.Path = filepath.Join(mountPath, filepath.Base(sink.Path))
+		containerSinks = append(containerSinks, sink)
+	}
+	return containerSinks, volumes, mounts
+}
+
+// bpfRecorderSyscallArgsFlag converts the syscall arguments to be recorded
+// into the command line flag of the bpf recorder.
+func bpfRecorderSyscallArgsFlag(args []spodv1alpha1.RecordedSyscallArgument) string {
+	values := make([]string, 0, len(args))
+	for _, arg := range args {
+		values = append(values, fmt.Sprintf("%s:%d", arg.Name, arg.Index))
+	}
+	return "--syscall-args=" + strings.Join(values, ",")
+}
+
 func addEnvVar(templateSpec *corev1.PodSpec, envVarKey string) {
 	envValue, err := strconv.ParseBool(os.Getenv(envVarKey))
 	if err != nil {
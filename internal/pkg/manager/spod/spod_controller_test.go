@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+func TestLogEnricherSinkVolumes(t *testing.T) {
+	t.Parallel()
+
+	sinks, volumes, mounts := logEnricherSinkVolumes([]spodv1alpha1.EnricherSink{
+		{Type: spodv1alpha1.EnricherSinkTypeFile, Path: "/var/log/spo.jsonl"},
+		{Type: spodv1alpha1.EnricherSinkTypeFile, Path: "/var/log/spo-audit.jsonl"},
+		{Type: spodv1alpha1.EnricherSinkTypeFile, Path: "/var/log/spo/events.jsonl"},
+		{Type: spodv1alpha1.EnricherSinkTypeWebhook, Endpoint: "http://localhost"},
+	})
+
+	require.Equal(t, []spodv1alpha1.EnricherSink{
+		{Type: spodv1alpha1.EnricherSinkTypeFile, Path: "/var/run/log-enricher-sinks/log-enricher-sink-0/spo.jsonl"},
+		{Type: spodv1alpha1.EnricherSinkTypeFile, Path: "/var/run/log-enricher-sinks/log-enricher-sink-0/spo-audit.jsonl"},
+		{Type: spodv1alpha1.EnricherSinkTypeFile, Path: "/var/run/log-enricher-sinks/log-enricher-sink-1/events.jsonl"},
+		{Type: spodv1alpha1.EnricherSinkTypeWebhook, Endpoint: "http://localhost"},
+	}, sinks)
+
+	require.Len(t, volumes, 2)
+	require.Equal(t, "/var/log", volumes[0].HostPath.Path)
+	require.Equal(t, "/var/log/spo", volumes[1].HostPath.Path)
+
+	// The directories must not collide with the existing mounts, like the
+	// one of the syslog directory.
+	require.Len(t, mounts, 2)
+	for i := range mounts {
+		require.Equal(t, volumes[i].Name, mounts[i].Name)
+		require.NotEqual(t, "/var/log", mounts[i].MountPath)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
@@ -65,6 +66,17 @@ func (r *PodReconciler) Setup(
 		return fmt.Errorf("creating pod index: %w", err)
 	}
 
+	// Index Pods using apparmor profiles
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &corev1.Pod{}, apOwnerKey, func(rawObj client.Object) []string {
+		pod, ok := rawObj.(*corev1.Pod)
+		if !ok {
+			return []string{}
+		}
+		return getAppArmorProfilesFromPod(pod)
+	}); err != nil {
+		return fmt.Errorf("creating pod index: %w", err)
+	}
+
 	// Index SeccompProfiles with active pods
 	if err := mgr.GetFieldIndexer().IndexField(
 		ctx, &seccompprofileapi.SeccompProfile{}, linkedPodsKey, func(rawObj client.Object) []string {
@@ -78,6 +90,19 @@ func (r *PodReconciler) Setup(
 		return fmt.Errorf("creating seccomp profile index: %w", err)
 	}
 
+	// Index ClusterSeccompProfiles with active pods
+	if err := mgr.GetFieldIndexer().IndexField(
+		ctx, &seccompprofileapi.ClusterSeccompProfile{}, linkedPodsKey, func(rawObj client.Object) []string {
+			sp, ok := rawObj.(*seccompprofileapi.ClusterSeccompProfile)
+			if !ok {
+				return []string{}
+			}
+
+			return sp.Status.ActiveWorkloads
+		}); err != nil {
+		return fmt.Errorf("creating cluster seccomp profile index: %w", err)
+	}
+
 	// Index SelinuxProfile with active pods
 	if err := mgr.GetFieldIndexer().IndexField(
 		ctx, &selinuxprofileapi.SelinuxProfile{}, linkedPodsKey, func(rawObj client.Object) []string {
@@ -90,6 +115,18 @@ func (r *PodReconciler) Setup(
 		return fmt.Errorf("creating selinux profile index: %w", err)
 	}
 
+	// Index AppArmorProfile with active pods
+	if err := mgr.GetFieldIndexer().IndexField(
+		ctx, &apparmorprofileapi.AppArmorProfile{}, linkedPodsKey, func(rawObj client.Object) []string {
+			ap, ok := rawObj.(*apparmorprofileapi.AppArmorProfile)
+			if !ok {
+				return []string{}
+			}
+			return ap.Status.ActiveWorkloads
+		}); err != nil {
+		return fmt.Errorf("creating apparmor profile index: %w", err)
+	}
+
 	// Register a special reconciler for pod events
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -121,6 +158,15 @@ func hasSelinuxProfile(r *PodReconciler, obj runtime.Object) bool {
 	return len(getSelinuxProfilesFromPod(context.TODO(), r, pod)) > 0
 }
 
+func hasAppArmorProfile(obj runtime.Object) bool {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return false
+	}
+
+	return len(getAppArmorProfilesFromPod(pod)) > 0
+}
+
 func (r *PodReconciler) hasValidProfile(obj runtime.Object) bool {
-	return hasSeccompProfile(obj) || hasSelinuxProfile(r, obj)
+	return hasSeccompProfile(obj) || hasSelinuxProfile(r, obj) || hasAppArmorProfile(obj)
 }
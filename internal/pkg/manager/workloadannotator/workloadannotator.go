@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
@@ -40,6 +42,7 @@ import (
 const (
 	spOwnerKey        = ".metadata.seccompProfileOwner"
 	seOwnerKey        = ".metadata.selinuxProfileOwner"
+	apOwnerKey        = ".metadata.apparmorProfileOwner"
 	linkedPodsKey     = ".metadata.activeWorkloads"
 	StatusToProfLabel = "spo.x-k8s.io/profile-id"
 	reconcileTimeout  = 1 * time.Minute
@@ -76,7 +79,20 @@ func (r *PodReconciler) Healthz(*http.Request) error {
 // Namespace scoped
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
 
-// Reconcile reacts to pod events and updates SeccompProfiles or SelinuxProfiles if in use or no longer in use by a pod.
+// Security Profiles Operator RBAC permissions to track ClusterSeccompProfile workloads
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/finalizers,verbs=delete;get;update;patch
+
+// Security Profiles Operator RBAC permissions to track AppArmorProfile workloads
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/finalizers,verbs=delete;get;update;patch
+
+// Reconcile reacts to pod events and updates SeccompProfiles, SelinuxProfiles or AppArmorProfiles if in use or no
+// longer in use by a pod.
 func (r *PodReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger := r.log.WithValues("pod", req.Name, "namespace", req.Namespace)
 
@@ -93,27 +109,49 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req reconcile.Request) (r
 	}
 	if errors.IsNotFound(err) { // this is a pod deletion, so update all seccomp/selinux profiles that were using it
 		seccompProfiles := &seccompprofileapi.SeccompProfileList{}
+		clusterSeccompProfiles := &seccompprofileapi.ClusterSeccompProfileList{}
 		selinuxProfiles := &selinuxprofileapi.SelinuxProfileList{}
+		appArmorProfiles := &apparmorprofileapi.AppArmorProfileList{}
 
 		if err = r.client.List(ctx, seccompProfiles, client.MatchingFields{linkedPodsKey: podID}); err != nil {
 			return reconcile.Result{}, fmt.Errorf("listing SeccompProfiles for deleted pod: %w", err)
 		}
 
+		if err = r.client.List(ctx, clusterSeccompProfiles, client.MatchingFields{linkedPodsKey: podID}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("listing ClusterSeccompProfiles for deleted pod: %w", err)
+		}
+
 		if err = r.client.List(ctx, selinuxProfiles, client.MatchingFields{linkedPodsKey: podID}); err != nil {
 			return reconcile.Result{}, fmt.Errorf("listing SelinuxProfiles for deleted pod: %w", err)
 		}
 
+		if err = r.client.List(ctx, appArmorProfiles, client.MatchingFields{linkedPodsKey: podID}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("listing AppArmorProfiles for deleted pod: %w", err)
+		}
+
 		for i := range seccompProfiles.Items {
 			if err = r.updatePodReferencesForSeccomp(ctx, &seccompProfiles.Items[i]); err != nil {
 				return reconcile.Result{}, fmt.Errorf("updating SeccompProfile for deleted pod: %w", err)
 			}
 		}
 
+		for i := range clusterSeccompProfiles.Items {
+			if err = r.updatePodReferencesForSeccomp(ctx, &clusterSeccompProfiles.Items[i]); err != nil {
+				return reconcile.Result{}, fmt.Errorf("updating ClusterSeccompProfile for deleted pod: %w", err)
+			}
+		}
+
 		for j := range selinuxProfiles.Items {
 			if err = r.updatePodReferencesForSelinux(ctx, &selinuxProfiles.Items[j]); err != nil {
 				return reconcile.Result{}, fmt.Errorf("updating SelinuxProfile for deleted pod: %w", err)
 			}
 		}
+
+		for k := range appArmorProfiles.Items {
+			if err = r.updatePodReferencesForAppArmor(ctx, &appArmorProfiles.Items[k]); err != nil {
+				return reconcile.Result{}, fmt.Errorf("updating AppArmorProfile for deleted pod: %w", err)
+			}
+		}
 		return reconcile.Result{}, nil
 	}
 
@@ -125,7 +163,11 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req reconcile.Request) (r
 		}
 		profileNamespace := profileElements[1]
 		profileName := strings.TrimSuffix(profileElements[2], ".json")
-		seccompProfile := &seccompprofileapi.SeccompProfile{}
+		var seccompProfile seccompprofileapi.SeccompProfileObject = &seccompprofileapi.SeccompProfile{}
+		if profileNamespace == seccompprofileapi.ClusterProfilesDir {
+			seccompProfile = &seccompprofileapi.ClusterSeccompProfile{}
+			profileNamespace = ""
+		}
 		if err := r.client.Get(ctx, util.NamespacedName(profileName, profileNamespace), seccompProfile); err != nil {
 			logger.Error(err, "could not get seccomp profile for pod")
 			return reconcile.Result{}, fmt.Errorf("looking up SeccompProfile for new or updated pod: %w", err)
@@ -151,14 +193,37 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req reconcile.Request) (r
 			return reconcile.Result{}, fmt.Errorf("updating SelinuxProfile pod references for new or updated pod: %w", err)
 		}
 	}
+
+	// pod is being created or updated so ensure it is linked to an apparmor profile
+	for _, profileName := range getAppArmorProfilesFromPod(pod) {
+		appArmorProfile := &apparmorprofileapi.AppArmorProfile{}
+		if err := r.client.Get(ctx, util.NamespacedName(profileName, pod.GetNamespace()), appArmorProfile); err != nil {
+			if errors.IsNotFound(err) {
+				// the profile is not managed by the operator
+				continue
+			}
+			logger.Error(err, "could not get apparmor profile for pod")
+			return reconcile.Result{}, fmt.Errorf("looking up AppArmorProfile for new or updated pod: %w", err)
+		}
+		if err := r.updatePodReferencesForAppArmor(ctx, appArmorProfile); err != nil {
+			logger.Error(err, "could not update apparmor profile for pod")
+			return reconcile.Result{}, fmt.Errorf("updating AppArmorProfile pod references for new or updated pod: %w", err)
+		}
+	}
 	return reconcile.Result{}, nil
 }
 
-// updatePodReferencesForSeccomp updates a SeccompProfile with the identifiers of pods using it and ensures
-// it has a finalizer indicating it is in use to prevent it from being deleted.
-func (r *PodReconciler) updatePodReferencesForSeccomp(ctx context.Context, sp *seccompprofileapi.SeccompProfile) error {
+// updatePodReferencesForSeccomp updates a SeccompProfile or ClusterSeccompProfile with the identifiers of pods
+// using it and ensures it has a finalizer indicating it is in use to prevent it from being deleted.
+func (r *PodReconciler) updatePodReferencesForSeccomp(
+	ctx context.Context, sp seccompprofileapi.SeccompProfileObject,
+) error {
 	linkedPods := &corev1.PodList{}
-	profileReference := fmt.Sprintf("operator/%s/%s.json", sp.GetNamespace(), sp.GetName())
+	profileDir := sp.GetNamespace()
+	if profileDir == "" {
+		profileDir = seccompprofileapi.ClusterProfilesDir
+	}
+	profileReference := fmt.Sprintf("operator/%s/%s.json", profileDir, sp.GetName())
 	err := r.client.List(ctx, linkedPods, client.MatchingFields{spOwnerKey: profileReference})
 	if util.IgnoreNotFound(err) != nil {
 		return fmt.Errorf("listing pods to update seccompProfile: %w", err)
@@ -169,7 +234,7 @@ func (r *PodReconciler) updatePodReferencesForSeccomp(ctx context.Context, sp *s
 		podList[i] = pod.ObjectMeta.Namespace + "/" + pod.ObjectMeta.Name
 	}
 	if err := util.Retry(func() error {
-		sp.Status.ActiveWorkloads = podList
+		sp.GetStatus().ActiveWorkloads = podList
 
 		updateErr := r.client.Status().Update(ctx, sp)
 		if updateErr != nil {
@@ -242,6 +307,53 @@ func (r *PodReconciler) updatePodReferencesForSelinux(ctx context.Context, se *s
 	return nil
 }
 
+// updatePodReferencesForAppArmor updates an AppArmorProfile with the identifiers of pods using it and ensures
+// it has a finalizer indicating it is in use to prevent it from being deleted.
+func (r *PodReconciler) updatePodReferencesForAppArmor(
+	ctx context.Context, ap *apparmorprofileapi.AppArmorProfile,
+) error {
+	linkedPods := &corev1.PodList{}
+	err := r.client.List(ctx, linkedPods,
+		client.InNamespace(ap.GetNamespace()),
+		client.MatchingFields{apOwnerKey: ap.GetProfileName()},
+	)
+	if util.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("listing pods to update appArmorProfile: %w", err)
+	}
+	podList := make([]string, len(linkedPods.Items))
+	for i := range linkedPods.Items {
+		pod := linkedPods.Items[i]
+		podList[i] = pod.ObjectMeta.Namespace + "/" + pod.ObjectMeta.Name
+	}
+	if err := util.Retry(func() error {
+		ap.Status.ActiveWorkloads = podList
+		updateErr := r.client.Status().Update(ctx, ap)
+		if updateErr != nil {
+			if err := r.client.Get(ctx, util.NamespacedName(ap.GetName(), ap.GetNamespace()), ap); err != nil {
+				return fmt.Errorf("retrieving profile: %w", err)
+			}
+			return fmt.Errorf("updating profile: %w", updateErr)
+		}
+		return nil
+	}, util.IsNotFoundOrConflict); err != nil {
+		return fmt.Errorf("updating AppArmorProfile status: %w", err)
+	}
+	if len(linkedPods.Items) > 0 {
+		if err := util.Retry(func() error {
+			return util.AddFinalizer(ctx, r.client, ap, util.HasActivePodsFinalizerString)
+		}, util.IsNotFoundOrConflict); err != nil {
+			return fmt.Errorf("adding finalizer: %w", err)
+		}
+	} else {
+		if err := util.Retry(func() error {
+			return util.RemoveFinalizer(ctx, r.client, ap, util.HasActivePodsFinalizerString)
+		}, util.IsNotFoundOrConflict); err != nil {
+			return fmt.Errorf("removing finalizer: %w", err)
+		}
+	}
+	return nil
+}
+
 // getSeccompProfilesFromPod returns a slice of strings representing seccomp profiles required by the pod.
 // It looks first at the pod spec level, then in each container and init container, then in the annotations.
 func getSeccompProfilesFromPod(pod *corev1.Pod) []string {
@@ -306,6 +418,25 @@ func getSelinuxProfilesFromPod(ctx context.Context, r *PodReconciler, pod *corev
 	return profiles
 }
 
+// getAppArmorProfilesFromPod returns a slice of strings representing the
+// localhost apparmor profiles required by the containers of the pod. It looks
+// at the beta container annotations.
+func getAppArmorProfilesFromPod(pod *corev1.Pod) []string {
+	profiles := []string{}
+	for key, value := range pod.GetAnnotations() {
+		if !strings.HasPrefix(key, corev1.AppArmorBetaContainerAnnotationKeyPrefix) ||
+			!strings.HasPrefix(value, corev1.AppArmorBetaProfileNamePrefix) {
+			continue
+		}
+		profileName := strings.TrimPrefix(value, corev1.AppArmorBetaProfileNamePrefix)
+		if profileName != "" && !util.Contains(profiles, profileName) {
+			profiles = append(profiles, profileName)
+		}
+	}
+	sort.Strings(profiles)
+	return profiles
+}
+
 // isOperatorSeccompProfile checks whether a corev1.SeccompProfile object belongs to the operator.
 // SeccompProfiles controlled by the operator are of type "Localhost" and have a path of the form
 // "operator/namespace/profile-name.json".
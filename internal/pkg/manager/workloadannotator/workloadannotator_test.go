@@ -29,6 +29,7 @@ func TestGetSeccompProfilesFromPod(t *testing.T) {
 
 	profilePath := "operator/default/test.json"
 	profilePath2 := "operator/default/test2.json"
+	clusterProfilePath := "operator/_cluster/test.json"
 	cases := []struct {
 		name string
 		pod  corev1.Pod
@@ -49,6 +50,21 @@ func TestGetSeccompProfilesFromPod(t *testing.T) {
 			},
 			want: []string{profilePath},
 		},
+		{
+			name: "ClusterSeccompProfileForPod",
+			pod: corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "container1", Image: "testimage"}},
+					SecurityContext: &corev1.PodSecurityContext{
+						SeccompProfile: &corev1.SeccompProfile{
+							Type:             "Localhost",
+							LocalhostProfile: &clusterProfilePath,
+						},
+					},
+				},
+			},
+			want: []string{clusterProfilePath},
+		},
 		{
 			name: "SeccompProfileForOneContainer",
 			pod: corev1.Pod{
@@ -238,3 +254,60 @@ func TestGetSeccompProfilesFromPod(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAppArmorProfilesFromPod(t *testing.T) {
+	t.Parallel()
+
+	const prefix = corev1.AppArmorBetaContainerAnnotationKeyPrefix
+	cases := []struct {
+		name        string
+		annotations map[string]string
+		want        []string
+	}{
+		{
+			name: "NoAnnotations",
+			want: []string{},
+		},
+		{
+			name: "LocalhostProfileForOneContainer",
+			annotations: map[string]string{
+				prefix + "container1": "localhost/test",
+			},
+			want: []string{"test"},
+		},
+		{
+			name: "SameProfileForMultipleContainers",
+			annotations: map[string]string{
+				prefix + "container1": "localhost/test2",
+				prefix + "container2": "localhost/test",
+				prefix + "container3": "localhost/test",
+			},
+			want: []string{"test", "test2"},
+		},
+		{
+			name: "RuntimeDefaultProfile",
+			annotations: map[string]string{
+				prefix + "container1": "runtime/default",
+			},
+			want: []string{},
+		},
+		{
+			name: "UnrelatedAnnotation",
+			annotations: map[string]string{
+				"foo": "localhost/test",
+			},
+			want: []string{},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+			}
+			require.Equal(t, tc.want, getAppArmorProfilesFromPod(pod))
+		})
+	}
+}
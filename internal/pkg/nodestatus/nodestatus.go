@@ -22,11 +22,16 @@ import (
 	"fmt"
 	"os"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
@@ -37,8 +42,15 @@ import (
 
 const (
 	partialProfileFinalizer = "spo.x-k8s.io/partial-profile-finalizer"
+
+	// clusterStatusPrefix is prepended to the node status names of cluster
+	// scoped profiles, so that they do not collide with the statuses of
+	// namespaced profiles of the same name in the operator namespace.
+	clusterStatusPrefix = "cluster-"
 )
 
+var errForeignNodeStatus = errors.New("node status belongs to another profile")
+
 type StatusClient struct {
 	pol             profilebase.SecurityProfileBase
 	nodeName        string
@@ -60,11 +72,46 @@ func NewForProfile(pol profilebase.SecurityProfileBase, c client.Client) (*Statu
 }
 
 func (nsf *StatusClient) perNodeStatusName() string {
-	return nsf.pol.GetName() + "-" + nsf.nodeName
+	name := nsf.pol.GetName() + "-" + nsf.nodeName
+	if nsf.pol.GetNamespace() == "" {
+		return clusterStatusPrefix + name
+	}
+	return name
+}
+
+// perNodeStatusNamespace returns the namespace of the node status. Cluster
+// scoped profiles have no namespace, which is why their statuses are stored
+// in the operator namespace.
+func (nsf *StatusClient) perNodeStatusNamespace() string {
+	if ns := nsf.pol.GetNamespace(); ns != "" {
+		return ns
+	}
+	return config.GetOperatorNamespace()
 }
 
 func (nsf *StatusClient) perNodeStatusNamespacedName() types.NamespacedName {
-	return util.NamespacedName(nsf.perNodeStatusName(), nsf.pol.GetNamespace())
+	return util.NamespacedName(nsf.perNodeStatusName(), nsf.perNodeStatusNamespace())
+}
+
+// getNodeStatus retrieves the node status of the profile. A status which
+// carries the name of the profile but is labeled for another one is reported
+// as not found, so that it never gets modified or removed on behalf of the
+// wrong profile.
+func (nsf *StatusClient) getNodeStatus(
+	ctx context.Context,
+) (*secprofnodestatusv1alpha1.SecurityProfileNodeStatus, error) {
+	status := &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	key := nsf.perNodeStatusNamespacedName()
+	if err := nsf.client.Get(ctx, key, status); err != nil {
+		return nil, err
+	}
+
+	if status.Labels[secprofnodestatusv1alpha1.StatusToProfLabel] != util.KindBasedDNSLengthName(nsf.pol) {
+		return nil, kerrors.NewNotFound(secprofnodestatusv1alpha1.GroupVersion.WithResource(
+			"securityprofilenodestatuses").GroupResource(), key.Name)
+	}
+
+	return status, nil
 }
 
 func (nsf *StatusClient) Create(ctx context.Context) error {
@@ -114,7 +161,7 @@ func (nsf *StatusClient) statusObj(
 	return &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      nsf.perNodeStatusName(),
-			Namespace: nsf.pol.GetNamespace(),
+			Namespace: nsf.perNodeStatusNamespace(),
 			Labels: map[string]string{
 				secprofnodestatusv1alpha1.StatusToProfLabel: util.KindBasedDNSLengthName(nsf.pol),
 				secprofnodestatusv1alpha1.StatusToNodeLabel: nsf.nodeName,
@@ -138,7 +185,12 @@ func (nsf *StatusClient) createNodeStatus(ctx context.Context) error {
 		return fmt.Errorf("cannot set node status owner reference: %s: %w", nsf.pol.GetName(), setCtrlErr)
 	}
 	err := nsf.client.Create(ctx, s)
-	if err != nil && !kerrors.IsAlreadyExists(err) {
+	if kerrors.IsAlreadyExists(err) {
+		if _, getErr := nsf.getNodeStatus(ctx); kerrors.IsNotFound(getErr) {
+			return fmt.Errorf("creating node status %s: %w", s.GetName(), errForeignNodeStatus)
+		}
+		return nil
+	} else if err != nil {
 		return fmt.Errorf("creating node status: %w", err)
 	}
 
@@ -174,8 +226,14 @@ func (nsf *StatusClient) removeFinalizer(ctx context.Context) error {
 }
 
 func (nsf *StatusClient) removeNodeStatus(ctx context.Context, c client.Client) error {
-	// the state here is more or less unused, we just care about the name since we're deleting...
-	err := c.Delete(ctx, nsf.statusObj(secprofnodestatusv1alpha1.ProfileStateTerminating))
+	status, err := nsf.getNodeStatus(ctx)
+	if kerrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("fetching node status: %w", err)
+	}
+
+	err = c.Delete(ctx, status, client.Preconditions{UID: &status.UID})
 	if err != nil && !kerrors.IsNotFound(err) {
 		return fmt.Errorf("deleting node status: %w", err)
 	}
@@ -195,8 +253,7 @@ func (nsf *StatusClient) finalizerExists() bool {
 }
 
 func (nsf *StatusClient) nodeStatusExists(ctx context.Context) (bool, error) {
-	status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
-	err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), &status)
+	_, err := nsf.getNodeStatus(ctx)
 	if kerrors.IsNotFound(err) {
 		return false, nil
 	} else if err != nil {
@@ -210,8 +267,7 @@ func (nsf *StatusClient) SetNodeStatus(
 	ctx context.Context,
 	polState secprofnodestatusv1alpha1.ProfileState,
 ) error {
-	status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
-	err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), &status)
+	status, err := nsf.getNodeStatus(ctx)
 	if kerrors.IsNotFound(err) && polState == secprofnodestatusv1alpha1.ProfileStateTerminating {
 		// it's OK if we're about to terminate a profile but it was already gone
 		return nil
@@ -221,7 +277,7 @@ func (nsf *StatusClient) SetNodeStatus(
 
 	status.Status = polState
 	status.Labels[secprofnodestatusv1alpha1.StatusStateLabel] = string(polState)
-	if err := nsf.client.Update(ctx, &status); err != nil {
+	if err := nsf.client.Update(ctx, status); err != nil {
 		return fmt.Errorf("updating node status: %w", err)
 	}
 
@@ -231,8 +287,8 @@ func (nsf *StatusClient) SetNodeStatus(
 func (nsf *StatusClient) Matches(
 	ctx context.Context, polState secprofnodestatusv1alpha1.ProfileState,
 ) (bool, error) {
-	status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
-	if err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), &status); err != nil {
+	status, err := nsf.getNodeStatus(ctx)
+	if err != nil {
 		if kerrors.IsNotFound(err) && polState == secprofnodestatusv1alpha1.ProfileStateTerminating {
 			// it's OK if we're about to terminate a profile but it was already gone
 			return true, nil
@@ -242,6 +298,79 @@ func (nsf *StatusClient) Matches(
 	return status.Status == polState, nil
 }
 
+// MatchesNode returns true if the node of the status client matches the node
+// selector of the profile.
+func (nsf *StatusClient) MatchesNode(ctx context.Context) (bool, error) {
+	nodeSelector := nsf.pol.GetNodeSelector()
+	if len(nodeSelector) == 0 {
+		return true, nil
+	}
+
+	node := &corev1.Node{}
+	if err := nsf.client.Get(ctx, types.NamespacedName{Name: nsf.nodeName}, node); err != nil {
+		return false, fmt.Errorf("getting node %s: %w", nsf.nodeName, err)
+	}
+
+	return labels.SelectorFromSet(nodeSelector).Matches(labels.Set(node.GetLabels())), nil
+}
+
+// SetSkipped sets the node status to skipped, if it is not already. The
+// profile may have been installed before the node stopped matching the node
+// selector, which is why uninstall is called to remove it from the node
+// before the status changes.
+func (nsf *StatusClient) SetSkipped(ctx context.Context, uninstall func() error) error {
+	isSkipped, err := nsf.Matches(ctx, secprofnodestatusv1alpha1.ProfileStateSkipped)
+	if err != nil {
+		return fmt.Errorf("getting node status: %w", err)
+	}
+	if isSkipped {
+		return nil
+	}
+	if err := uninstall(); err != nil {
+		return fmt.Errorf("removing profile from node: %w", err)
+	}
+	return nsf.SetNodeStatus(ctx, secprofnodestatusv1alpha1.ProfileStateSkipped)
+}
+
+// NodeLabelsChangedPredicate filters node events down to label changes of the
+// node the daemon is running on, which may change the result of MatchesNode.
+func NodeLabelsChangedPredicate() predicate.Predicate {
+	isLocalNode := func(obj client.Object) bool {
+		return obj.GetName() == os.Getenv(config.NodeNameEnvKey)
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isLocalNode(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isLocalNode(e.ObjectNew) &&
+				!labels.Equals(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// NodeSelectorRequests returns the reconcile requests for all profiles that
+// use a node selector.
+func NodeSelectorRequests(profiles []profilebase.SecurityProfileBase) []reconcile.Request {
+	requests := []reconcile.Request{}
+	for _, p := range profiles {
+		if len(p.GetNodeSelector()) == 0 {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: util.NamespacedName(p.GetName(), p.GetNamespace()),
+		})
+	}
+	return requests
+}
+
 func getFinalizerString(pol profilebase.SecurityProfileBase, nodeName string) string {
 	if pol.IsPartial() {
 		return partialProfileFinalizer
@@ -17,14 +17,24 @@ limitations under the License.
 package nodestatus
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
 // Expected shorten the node name if length exceed the limit.
@@ -69,6 +79,252 @@ func TestShortenNodeName(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestPerNodeStatusNamespacedName(t *testing.T) {
+	const operatorNamespace = "security-profiles-operator"
+
+	cases := []struct {
+		name          string
+		profileBase   profilebase.SecurityProfileBase
+		wantName      string
+		wantNamespace string
+	}{
+		{
+			name:          "NamespacedProfile",
+			profileBase:   regularSeccompProfile(),
+			wantName:      "test-profile-node",
+			wantNamespace: "test-namespace",
+		},
+		{
+			name: "ClusterProfile",
+			profileBase: &seccompprofile.ClusterSeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+			},
+			wantName:      "cluster-test-profile-node",
+			wantNamespace: operatorNamespace,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.NodeNameEnvKey, "node")
+			t.Setenv(config.OperatorNamespaceEnvKey, operatorNamespace)
+			sc, err := NewForProfile(tc.profileBase, nil)
+			require.NoError(t, err)
+
+			key := sc.perNodeStatusNamespacedName()
+			require.Equal(t, tc.wantName, key.Name)
+			require.Equal(t, tc.wantNamespace, key.Namespace)
+			require.Equal(t, tc.wantNamespace, sc.statusObj("").Namespace)
+		})
+	}
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestForeignNodeStatus(t *testing.T) {
+	const operatorNamespace = "security-profiles-operator"
+	t.Setenv(config.NodeNameEnvKey, "node")
+	t.Setenv(config.OperatorNamespaceEnvKey, operatorNamespace)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, seccompprofile.AddToScheme(scheme))
+	require.NoError(t, secprofnodestatusv1alpha1.AddToScheme(scheme))
+
+	// A namespaced profile whose node status has the same name as the one
+	// of the cluster scoped profile below
+	namespacedProfile := &seccompprofile.SeccompProfile{
+		TypeMeta: metav1.TypeMeta{Kind: "SeccompProfile"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-test-profile",
+			Namespace: operatorNamespace,
+		},
+	}
+	clusterProfile := &seccompprofile.ClusterSeccompProfile{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterSeccompProfile"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespacedProfile, clusterProfile).Build()
+	ctx := context.Background()
+
+	namespacedStatus, err := NewForProfile(namespacedProfile, cli)
+	require.NoError(t, err)
+	require.NoError(t, namespacedStatus.createNodeStatus(ctx))
+
+	clusterStatus, err := NewForProfile(clusterProfile, cli)
+	require.NoError(t, err)
+	require.Equal(t, namespacedStatus.perNodeStatusNamespacedName(), clusterStatus.perNodeStatusNamespacedName())
+
+	exists, err := clusterStatus.nodeStatusExists(ctx)
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.ErrorIs(t, clusterStatus.createNodeStatus(ctx), errForeignNodeStatus)
+	require.NoError(t, clusterStatus.removeNodeStatus(ctx, cli))
+
+	exists, err = namespacedStatus.nodeStatusExists(ctx)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestMatchesNode(t *testing.T) {
+	nodeLabels := func(obj client.Object) error {
+		node, ok := obj.(*corev1.Node)
+		require.True(t, ok)
+		node.Labels = map[string]string{"pool": "gpu", "zone": "a"}
+		return nil
+	}
+
+	cases := []struct {
+		name         string
+		nodeSelector map[string]string
+		want         bool
+	}{
+		{
+			name: "NoNodeSelector",
+			want: true,
+		},
+		{
+			name:         "MatchingNodeSelector",
+			nodeSelector: map[string]string{"pool": "gpu"},
+			want:         true,
+		},
+		{
+			name:         "NotMatchingNodeSelector",
+			nodeSelector: map[string]string{"pool": "gpu", "zone": "b"},
+			want:         false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.NodeNameEnvKey, "node")
+			sp := regularSeccompProfile()
+			sp.Spec.NodeSelector = tc.nodeSelector
+			sc, err := NewForProfile(sp, &util.MockClient{
+				MockGet: util.NewMockGetFn(nil, nodeLabels),
+			})
+			require.NoError(t, err)
+
+			got, err := sc.MatchesNode(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestSetSkipped(t *testing.T) {
+	errTest := errors.New("test")
+
+	cases := []struct {
+		name          string
+		state         secprofnodestatusv1alpha1.ProfileState
+		uninstallErr  error
+		wantUninstall bool
+		wantState     secprofnodestatusv1alpha1.ProfileState
+		wantErr       error
+	}{
+		{
+			name:          "Installed",
+			state:         secprofnodestatusv1alpha1.ProfileStateInstalled,
+			wantUninstall: true,
+			wantState:     secprofnodestatusv1alpha1.ProfileStateSkipped,
+		},
+		{
+			name:          "AlreadySkipped",
+			state:         secprofnodestatusv1alpha1.ProfileStateSkipped,
+			wantUninstall: false,
+			wantState:     secprofnodestatusv1alpha1.ProfileStateSkipped,
+		},
+		{
+			name:          "UninstallFailed",
+			state:         secprofnodestatusv1alpha1.ProfileStateInstalled,
+			uninstallErr:  errTest,
+			wantUninstall: true,
+			wantState:     secprofnodestatusv1alpha1.ProfileStateInstalled,
+			wantErr:       errTest,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.NodeNameEnvKey, "node")
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, seccompprofile.AddToScheme(scheme))
+			require.NoError(t, secprofnodestatusv1alpha1.AddToScheme(scheme))
+
+			sp := regularSeccompProfile()
+			sp.Kind = "SeccompProfile"
+			cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sp).Build()
+			sc, err := NewForProfile(sp, cli)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			require.NoError(t, cli.Create(ctx, sc.statusObj(tc.state)))
+
+			uninstalled := false
+			err = sc.SetSkipped(ctx, func() error {
+				uninstalled = true
+				return tc.uninstallErr
+			})
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantUninstall, uninstalled)
+
+			matches, err := sc.Matches(ctx, tc.wantState)
+			require.NoError(t, err)
+			require.True(t, matches)
+		})
+	}
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestNodeLabelsChangedPredicate(t *testing.T) {
+	t.Setenv(config.NodeNameEnvKey, "node")
+	node := func(name string, nodeLabels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels}}
+	}
+	p := NodeLabelsChangedPredicate()
+
+	require.True(t, p.Create(event.CreateEvent{Object: node("node", nil)}))
+	require.False(t, p.Create(event.CreateEvent{Object: node("other", nil)}))
+	require.True(t, p.Update(event.UpdateEvent{
+		ObjectOld: node("node", map[string]string{"pool": "gpu"}),
+		ObjectNew: node("node", map[string]string{"pool": "cpu"}),
+	}))
+	require.False(t, p.Update(event.UpdateEvent{
+		ObjectOld: node("node", map[string]string{"pool": "gpu"}),
+		ObjectNew: node("node", map[string]string{"pool": "gpu"}),
+	}))
+	require.False(t, p.Update(event.UpdateEvent{
+		ObjectOld: node("other", map[string]string{"pool": "gpu"}),
+		ObjectNew: node("other", map[string]string{"pool": "cpu"}),
+	}))
+	require.False(t, p.Delete(event.DeleteEvent{Object: node("node", nil)}))
+}
+
+func TestNodeSelectorRequests(t *testing.T) {
+	t.Parallel()
+
+	withSelector := regularSeccompProfile()
+	withSelector.Spec.NodeSelector = map[string]string{"pool": "gpu"}
+	cluster := &seccompprofile.ClusterSeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-profile"},
+	}
+	cluster.Spec.NodeSelector = map[string]string{"pool": "gpu"}
+
+	requests := NodeSelectorRequests([]profilebase.SecurityProfileBase{
+		regularSeccompProfile(), withSelector, cluster,
+	})
+	require.Equal(t, []reconcile.Request{
+		{NamespacedName: util.NamespacedName("test-profile", "test-namespace")},
+		{NamespacedName: util.NamespacedName("cluster-profile", "")},
+	}, requests)
+}
+
 func regularSeccompProfile() *seccompprofile.SeccompProfile {
 	return &seccompprofile.SeccompProfile{
 		ObjectMeta: metav1.ObjectMeta{
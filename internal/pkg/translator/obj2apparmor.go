@@ -0,0 +1,207 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+)
+
+const (
+	appArmorFlags         = "attach_disconnected,mediate_deleted"
+	appArmorComplainFlag  = "complain"
+	appArmorExecPerms     = "mrix"
+	appArmorLibraryPerms  = "mr"
+	appArmorReadPerms     = "r"
+	appArmorWritePerms    = "w"
+	appArmorReadWritePerm = "rw"
+)
+
+// ErrInvalidAppArmorRule is returned if an abstract rule cannot be translated.
+var ErrInvalidAppArmorRule = errors.New("invalid AppArmor rule")
+
+// appArmorCapabilities are the Linux capabilities in the AppArmor notation.
+var appArmorCapabilities = sets.New(
+	"chown", "dac_override", "dac_read_search", "fowner", "fsetid", "kill",
+	"setgid", "setuid", "setpcap", "linux_immutable", "net_bind_service",
+	"net_broadcast", "net_admin", "net_raw", "ipc_lock", "ipc_owner",
+	"sys_module", "sys_rawio", "sys_chroot", "sys_ptrace", "sys_pacct",
+	"sys_admin", "sys_boot", "sys_nice", "sys_resource", "sys_time",
+	"sys_tty_config", "mknod", "lease", "audit_write", "audit_control",
+	"setfcap", "mac_override", "mac_admin", "syslog", "wake_alarm",
+	"block_suspend", "audit_read", "perfmon", "bpf", "checkpoint_restore",
+)
+
+// appArmorNetworkFamilies are the network address families known to AppArmor.
+var appArmorNetworkFamilies = sets.New(
+	"unix", "inet", "inet6", "ax25", "ipx", "appletalk", "netrom", "bridge",
+	"atmpvc", "x25", "rose", "netbeui", "security", "key", "netlink",
+	"packet", "ash", "econet", "atmsvc", "rds", "sna", "irda", "pppox",
+	"wanpipe", "llc", "ib", "mpls", "can", "tipc", "bluetooth", "iucv",
+	"rxrpc", "isdn", "phonet", "ieee802154", "caif", "alg", "nfc", "vsock",
+	"kcm", "qipcrtr", "smc", "xdp", "mctp",
+)
+
+// appArmorPathQuoter escapes the characters which have a special meaning
+// within a quoted AppArmor path.
+var appArmorPathQuoter = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// Object2AppArmor translates the abstract rules of an AppArmorProfile into
+// the AppArmor policy syntax. The resulting profile is named after the
+// AppArmorProfile object.
+func Object2AppArmor(ap *apparmorprofileapi.AppArmorProfile) (string, error) {
+	if abstract := ap.Spec.Abstract; abstract != nil {
+		if err := ValidateAppArmorAbstract(abstract); err != nil {
+			return "", err
+		}
+	}
+
+	aabuilder := strings.Builder{}
+	aabuilder.WriteString(getAppArmorStart(ap))
+
+	if abstract := ap.Spec.Abstract; abstract != nil {
+		if exec := abstract.Executable; exec != nil {
+			aabuilder.WriteString(getAppArmorPathLines(exec.AllowedExecutables, appArmorExecPerms))
+			aabuilder.WriteString(getAppArmorPathLines(exec.AllowedLibraries, appArmorLibraryPerms))
+		}
+		if fs := abstract.Filesystem; fs != nil {
+			aabuilder.WriteString(getAppArmorPathLines(fs.ReadOnlyPaths, appArmorReadPerms))
+			aabuilder.WriteString(getAppArmorPathLines(fs.WriteOnlyPaths, appArmorWritePerms))
+			aabuilder.WriteString(getAppArmorPathLines(fs.ReadWritePaths, appArmorReadWritePerm))
+		}
+		if abstract.Network != nil {
+			aabuilder.WriteString(getAppArmorNetworkLines(abstract.Network))
+		}
+		if abstract.Capability != nil {
+			for _, capability := range sortedUnique(abstract.Capability.AllowedCapabilities) {
+				aabuilder.WriteString(fmt.Sprintf("  capability %s,\n", capability))
+			}
+		}
+	}
+
+	aabuilder.WriteString(getAppArmorEnd())
+	return aabuilder.String(), nil
+}
+
+// ValidateAppArmorAbstract verifies that all paths of the abstract rules are
+// absolute and free of control characters, and that only known network
+// families and capabilities are used.
+func ValidateAppArmorAbstract(abstract *apparmorprofileapi.AppArmorAbstract) error {
+	paths := []string{}
+	if exec := abstract.Executable; exec != nil {
+		paths = append(paths, exec.AllowedExecutables...)
+		paths = append(paths, exec.AllowedLibraries...)
+	}
+	if fs := abstract.Filesystem; fs != nil {
+		paths = append(paths, fs.ReadOnlyPaths...)
+		paths = append(paths, fs.WriteOnlyPaths...)
+		paths = append(paths, fs.ReadWritePaths...)
+	}
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "/") && !strings.HasPrefix(path, "@{") {
+			return fmt.Errorf("path %q is not absolute: %w", path, ErrInvalidAppArmorRule)
+		}
+		if strings.IndexFunc(path, unicode.IsControl) != -1 {
+			return fmt.Errorf("path %q contains control characters: %w", path, ErrInvalidAppArmorRule)
+		}
+	}
+
+	if abstract.Network != nil {
+		for _, family := range abstract.Network.Families {
+			if !appArmorNetworkFamilies.Has(family) {
+				return fmt.Errorf("unknown network family %q: %w", family, ErrInvalidAppArmorRule)
+			}
+		}
+	}
+
+	if abstract.Capability != nil {
+		for _, capability := range abstract.Capability.AllowedCapabilities {
+			if !appArmorCapabilities.Has(capability) {
+				return fmt.Errorf("unknown capability %q: %w", capability, ErrInvalidAppArmorRule)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getAppArmorStart(ap *apparmorprofileapi.AppArmorProfile) string {
+	flags := appArmorFlags
+	if ap.Spec.ComplainMode {
+		flags += "," + appArmorComplainFlag
+	}
+	return fmt.Sprintf(
+		"#include <tunables/global>\n\nprofile %s flags=(%s) {\n  #include <abstractions/base>\n\n",
+		ap.GetProfileName(), flags,
+	)
+}
+
+func getAppArmorPathLines(paths []string, perms string) string {
+	lines := strings.Builder{}
+	for _, path := range sortedUnique(paths) {
+		lines.WriteString(fmt.Sprintf("  %s %s,\n", quoteAppArmorPath(path), perms))
+	}
+	return lines.String()
+}
+
+// quoteAppArmorPath quotes the path if it contains whitespace or characters
+// which would otherwise end the rule or start a comment.
+func quoteAppArmorPath(path string) string {
+	needsQuotes := strings.IndexFunc(path, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(`"\,#`, r)
+	}) != -1
+	if !needsQuotes {
+		return path
+	}
+	return `"` + appArmorPathQuoter.Replace(path) + `"`
+}
+
+func getAppArmorNetworkLines(network *apparmorprofileapi.AppArmorNetworkRules) string {
+	lines := strings.Builder{}
+	if protocols := network.Protocols; protocols != nil {
+		if protocols.AllowTCP != nil && *protocols.AllowTCP {
+			lines.WriteString("  network inet tcp,\n  network inet6 tcp,\n")
+		}
+		if protocols.AllowUDP != nil && *protocols.AllowUDP {
+			lines.WriteString("  network inet udp,\n  network inet6 udp,\n")
+		}
+	}
+	if network.AllowRaw != nil && *network.AllowRaw {
+		lines.WriteString("  network raw,\n")
+	}
+	for _, family := range sortedUnique(network.Families) {
+		lines.WriteString(fmt.Sprintf("  network %s,\n", family))
+	}
+	return lines.String()
+}
+
+func getAppArmorEnd() string {
+	return "}\n"
+}
+
+func sortedUnique(items []string) []string {
+	unique := sets.New(items...).UnsortedList()
+	sort.Strings(unique)
+	return unique
+}
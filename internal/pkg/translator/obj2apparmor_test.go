@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+)
+
+func TestObject2AppArmor(t *testing.T) {
+	t.Parallel()
+
+	allow := true
+	deny := false
+	tests := []struct {
+		name    string
+		profile *apparmorprofileapi.AppArmorProfile
+		want    string
+		wantErr string
+	}{
+		{
+			name: "Test translation without rules",
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "empty"},
+			},
+			want: `#include <tunables/global>
+
+profile empty flags=(attach_disconnected,mediate_deleted) {
+  #include <abstractions/base>
+
+}
+`,
+		},
+		{
+			name: "Test translation of all rules in complain mode",
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					ComplainMode: true,
+					Abstract: &apparmorprofileapi.AppArmorAbstract{
+						Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+							AllowedExecutables: []string{"/usr/sbin/nginx", "/bin/sh"},
+							AllowedLibraries:   []string{"/lib/x86_64-linux-gnu/libc.so.6"},
+						},
+						Filesystem: &apparmorprofileapi.AppArmorFsRules{
+							ReadOnlyPaths:  []string{"/etc/nginx/**", "/etc/nginx/**"},
+							WriteOnlyPaths: []string{"/var/log/nginx/*.log"},
+							ReadWritePaths: []string{"/run/nginx.pid"},
+						},
+						Network: &apparmorprofileapi.AppArmorNetworkRules{
+							AllowRaw: &deny,
+							Protocols: &apparmorprofileapi.AppArmorAllowedProtocols{
+								AllowTCP: &allow,
+							},
+							Families: []string{"unix"},
+						},
+						Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+							AllowedCapabilities: []string{"setuid", "net_bind_service", "setgid"},
+						},
+					},
+				},
+			},
+			want: `#include <tunables/global>
+
+profile nginx flags=(attach_disconnected,mediate_deleted,complain) {
+  #include <abstractions/base>
+
+  /bin/sh mrix,
+  /usr/sbin/nginx mrix,
+  /lib/x86_64-linux-gnu/libc.so.6 mr,
+  /etc/nginx/** r,
+  /var/log/nginx/*.log w,
+  /run/nginx.pid rw,
+  network inet tcp,
+  network inet6 tcp,
+  network unix,
+  capability net_bind_service,
+  capability setgid,
+  capability setuid,
+}
+`,
+		},
+		{
+			// Executing a binary requires mapping and reading it as well.
+			name: "Test translation of executables",
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "exec"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Abstract: &apparmorprofileapi.AppArmorAbstract{
+						Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+							AllowedExecutables: []string{"/usr/bin/sleep"},
+						},
+					},
+				},
+			},
+			want: `#include <tunables/global>
+
+profile exec flags=(attach_disconnected,mediate_deleted) {
+  #include <abstractions/base>
+
+  /usr/bin/sleep mrix,
+}
+`,
+		},
+		{
+			name: "Test translation of network rules",
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "net"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Abstract: &apparmorprofileapi.AppArmorAbstract{
+						Network: &apparmorprofileapi.AppArmorNetworkRules{
+							AllowRaw: &allow,
+							Protocols: &apparmorprofileapi.AppArmorAllowedProtocols{
+								AllowTCP: &deny,
+								AllowUDP: &allow,
+							},
+						},
+					},
+				},
+			},
+			want: `#include <tunables/global>
+
+profile net flags=(attach_disconnected,mediate_deleted) {
+  #include <abstractions/base>
+
+  network inet udp,
+  network inet6 udp,
+  network raw,
+}
+`,
+		},
+		{
+			name: "Test translation of paths which need quoting",
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "quoted"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Abstract: &apparmorprofileapi.AppArmorAbstract{
+						Filesystem: &apparmorprofileapi.AppArmorFsRules{
+							ReadOnlyPaths: []string{
+								"/data/my files/**",
+								`/data/a"b`,
+								"/data/x, /** rw",
+								"/data/#c",
+							},
+						},
+					},
+				},
+			},
+			want: `#include <tunables/global>
+
+profile quoted flags=(attach_disconnected,mediate_deleted) {
+  #include <abstractions/base>
+
+  "/data/#c" r,
+  "/data/a\"b" r,
+  "/data/my files/**" r,
+  "/data/x, /** rw" r,
+}
+`,
+		},
+		{
+			name: "Test translation fails for control characters in paths",
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Abstract: &apparmorprofileapi.AppArmorAbstract{
+						Filesystem: &apparmorprofileapi.AppArmorFsRules{
+							ReadWritePaths: []string{"/tmp/x rw,\n  /** rw"},
+						},
+					},
+				},
+			},
+			wantErr: `path "/tmp/x rw,\n  /** rw" contains control characters: invalid AppArmor rule`,
+		},
+		{
+			name: "Test translation fails for unknown network families",
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Abstract: &apparmorprofileapi.AppArmorAbstract{
+						Network: &apparmorprofileapi.AppArmorNetworkRules{
+							Families: []string{"inet,\n  /** rw"},
+						},
+					},
+				},
+			},
+			wantErr: `unknown network family "inet,\n  /** rw": invalid AppArmor rule`,
+		},
+		{
+			name: "Test translation fails for unknown capabilities",
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Abstract: &apparmorprofileapi.AppArmorAbstract{
+						Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+							AllowedCapabilities: []string{"CAP_SYS_ADMIN"},
+						},
+					},
+				},
+			},
+			wantErr: `unknown capability "CAP_SYS_ADMIN": invalid AppArmor rule`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Object2AppArmor(tt.profile)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -69,7 +69,7 @@ func ContainerIDForPID(cache *ttlcache.Cache[string, string], pid int) (string,
 	for scanner.Scan() {
 		text := scanner.Text()
 
-		if containerID := ContainerIDRegex.FindString(text); containerID != "" {
+		if containerID, err := ContainerIDForCgroupPath(text); err == nil {
 			// Update the cache
 			cache.Set(strconv.Itoa(pid), containerID, ttlcache.DefaultTTL)
 			return containerID, nil
@@ -78,3 +78,12 @@ func ContainerIDForPID(cache *ttlcache.Cache[string, string], pid int) (string,
 
 	return "", ErrContainerIDNotFound
 }
+
+// ContainerIDForCgroupPath tries to find the 64 digit container ID in the
+// provided cgroup path or name.
+func ContainerIDForCgroupPath(path string) (string, error) {
+	if containerID := ContainerIDRegex.FindString(path); containerID != "" {
+		return containerID, nil
+	}
+	return "", ErrContainerIDNotFound
+}
@@ -61,3 +61,16 @@ func TestExtractContainerID(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerIDForCgroupPath(t *testing.T) {
+	t.Parallel()
+
+	id, err := ContainerIDForCgroupPath(
+		"cri-containerd-b469ca5b54e01e7724b7a990f01d54f571dd7669b87851a87bd8b849c438c580.scope",
+	)
+	require.NoError(t, err)
+	require.Equal(t, "b469ca5b54e01e7724b7a990f01d54f571dd7669b87851a87bd8b849c438c580", id)
+
+	_, err = ContainerIDForCgroupPath("crio.service")
+	require.ErrorIs(t, err, ErrContainerIDNotFound)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ExecutableSyscallsAnnotation serializes the per executable syscalls into
+// the value of the executable syscalls profile annotation. An empty string
+// is returned if no executables are provided.
+func ExecutableSyscallsAnnotation(executables map[string][]string) (string, error) {
+	if len(executables) == 0 {
+		return "", nil
+	}
+
+	normalized := make(map[string][]string, len(executables))
+	for executable, syscalls := range executables {
+		normalized[executable] = sets.List(sets.New(syscalls...))
+	}
+
+	// Maps are serialized with sorted keys, which keeps the value stable.
+	value, err := json.Marshal(normalized)
+	if err != nil {
+		return "", fmt.Errorf("marshal executable syscalls: %w", err)
+	}
+
+	return string(value), nil
+}
+
+// UnionExecutableSyscallsAnnotations merges two values of the executable
+// syscalls profile annotation.
+func UnionExecutableSyscallsAnnotations(a, b string) (string, error) {
+	union := map[string][]string{}
+	for _, value := range []string{a, b} {
+		if value == "" {
+			continue
+		}
+		executables := map[string][]string{}
+		if err := json.Unmarshal([]byte(value), &executables); err != nil {
+			return "", fmt.Errorf("unmarshal executable syscalls: %w", err)
+		}
+		for executable, syscalls := range executables {
+			union[executable] = append(union[executable], syscalls...)
+		}
+	}
+
+	return ExecutableSyscallsAnnotation(union)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnionExecutableSyscallsAnnotations(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		a, b      string
+		want      string
+		shouldErr bool
+	}{
+		{
+			name: "BothEmpty",
+		},
+		{
+			name: "OneEmpty",
+			a:    `{"/bin/sh":["write","read"]}`,
+			want: `{"/bin/sh":["read","write"]}`,
+		},
+		{
+			name: "Overlapping",
+			a:    `{"/bin/sh":["read"],"/usr/bin/gdb":["ptrace"]}`,
+			b:    `{"/bin/sh":["read","write"]}`,
+			want: `{"/bin/sh":["read","write"],"/usr/bin/gdb":["ptrace"]}`,
+		},
+		{
+			name:      "Invalid",
+			a:         `{"/bin/sh":["read"]}`,
+			b:         "invalid",
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := UnionExecutableSyscallsAnnotations(tc.a, tc.b)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SyscallCountsAnnotation serializes the syscall counts into the value of the
+// syscall counts profile annotation. An empty string is returned if no counts
+// are provided.
+func SyscallCountsAnnotation(counts map[string]uint64) (string, error) {
+	if len(counts) == 0 {
+		return "", nil
+	}
+
+	// Maps are serialized with sorted keys, which keeps the value stable.
+	value, err := json.Marshal(counts)
+	if err != nil {
+		return "", fmt.Errorf("marshal syscall counts: %w", err)
+	}
+
+	return string(value), nil
+}
+
+// UnionSyscallCountsAnnotations merges two values of the syscall counts
+// profile annotation by adding up the counts per syscall.
+func UnionSyscallCountsAnnotations(a, b string) (string, error) {
+	union := map[string]uint64{}
+	for _, value := range []string{a, b} {
+		if value == "" {
+			continue
+		}
+		counts := map[string]uint64{}
+		if err := json.Unmarshal([]byte(value), &counts); err != nil {
+			return "", fmt.Errorf("unmarshal syscall counts: %w", err)
+		}
+		for syscall, count := range counts {
+			union[syscall] += count
+		}
+	}
+
+	return SyscallCountsAnnotation(union)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnionSyscallCountsAnnotations(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		a, b      string
+		want      string
+		shouldErr bool
+	}{
+		{
+			name: "BothEmpty",
+		},
+		{
+			name: "OneEmpty",
+			b:    `{"write":2,"read":1}`,
+			want: `{"read":1,"write":2}`,
+		},
+		{
+			name: "Overlapping",
+			a:    `{"read":1,"ptrace":1}`,
+			b:    `{"read":5,"write":2}`,
+			want: `{"ptrace":1,"read":6,"write":2}`,
+		},
+		{
+			name:      "Invalid",
+			a:         "invalid",
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := UnionSyscallCountsAnnotations(tc.a, tc.b)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
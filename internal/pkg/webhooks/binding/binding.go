@@ -22,18 +22,22 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"sync"
+	"sort"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
@@ -64,36 +68,15 @@ func RegisterWebhook(server *webhook.Server, c client.Client) {
 
 type containerList []*corev1.Container
 
-func initContainerMap(m *sync.Map, spec *corev1.PodSpec) {
-	if spec.Containers != nil {
-		for i := range spec.Containers {
-			image := spec.Containers[i].Image
-			value, _ := m.LoadOrStore(image, containerList{})
-			cList, ok := value.(containerList)
-			if ok {
-				m.Store(image, append(cList, &spec.Containers[i]))
-			}
-		}
-	}
-	if spec.InitContainers != nil {
-		for i := range spec.InitContainers {
-			image := spec.InitContainers[i].Image
-			value, _ := m.LoadOrStore(image, containerList{})
-			cList, ok := value.(containerList)
-			if ok {
-				m.Store(image, append(cList, &spec.InitContainers[i]))
-			}
-		}
-	}
-}
-
 // Security Profiles Operator Webhook RBAC permissions
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/finalizers,verbs=delete;get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create
@@ -116,23 +99,27 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 	podID := req.Namespace + "/" + req.Name
 	pod := &corev1.Pod{}
 
-	var containers sync.Map
 	if req.Operation != "DELETE" {
 		pod, err = p.impl.DecodePod(req)
 		if err != nil {
 			p.log.Error(err, "failed to decode pod")
 			return admission.Errored(http.StatusBadRequest, err)
 		}
-		initContainerMap(&containers, &pod.Spec)
 	}
 
+	sortBindingsByPrecedence(profilebindings)
+	boundContainers := map[profilebindingv1alpha1.ProfileBindingKind]sets.Set[string]{}
+
 	for i := range profilebindings {
 		profileKind := profilebindings[i].Spec.ProfileRef.Kind
-		if profileKind != profilebindingv1alpha1.ProfileBindingKindSeccompProfile {
-			if profileKind != profilebindingv1alpha1.ProfileBindingKindSelinuxProfile {
-				p.log.Info(fmt.Sprintf("profile kind %s not yet supported", profileKind))
-				continue
-			}
+		switch profileKind {
+		case profilebindingv1alpha1.ProfileBindingKindSeccompProfile,
+			profilebindingv1alpha1.ProfileBindingKindClusterSeccompProfile,
+			profilebindingv1alpha1.ProfileBindingKindSelinuxProfile,
+			profilebindingv1alpha1.ProfileBindingKindAppArmorProfile:
+		default:
+			p.log.Info(fmt.Sprintf("profile kind %s not yet supported", profileKind))
+			continue
 		}
 
 		profileName := profilebindings[i].Spec.ProfileRef.Name
@@ -142,36 +129,58 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 			}
 			continue
 		}
-		value, ok := containers.Load(profilebindings[i].Spec.Image)
-		if !ok {
+
+		// Namespaced and cluster scoped seccomp profiles compete for the
+		// same containers.
+		boundKind := profileKind
+		if boundKind == profilebindingv1alpha1.ProfileBindingKindClusterSeccompProfile {
+			boundKind = profilebindingv1alpha1.ProfileBindingKindSeccompProfile
+		}
+		if _, ok := boundContainers[boundKind]; !ok {
+			boundContainers[boundKind] = sets.New[string]()
+		}
+		matched, err := p.matchingContainers(pod, &profilebindings[i], boundContainers[boundKind])
+		if err != nil {
+			p.log.Error(err, fmt.Sprintf("failed to match containers for binding %s", profilebindings[i].Name))
 			continue
 		}
-		containers, ok := value.(containerList)
-		if !ok {
+		if len(matched) == 0 {
 			continue
 		}
 
 		namespacedName := types.NamespacedName{Namespace: req.Namespace, Name: profileName}
 		var bindProfile interface{}
-		var err error
 
 		if profileKind == profilebindingv1alpha1.ProfileBindingKindSeccompProfile {
 			bindProfile, err = p.getSeccompProfile(ctx, namespacedName)
 		}
 
+		if profileKind == profilebindingv1alpha1.ProfileBindingKindClusterSeccompProfile {
+			bindProfile, err = p.getClusterSeccompProfile(ctx, types.NamespacedName{Name: profileName})
+		}
+
 		if profileKind == profilebindingv1alpha1.ProfileBindingKindSelinuxProfile {
 			bindProfile, err = p.getSelinuxProfile(ctx, namespacedName)
 		}
 
+		if profileKind == profilebindingv1alpha1.ProfileBindingKindAppArmorProfile {
+			bindProfile, err = p.getAppArmorProfile(ctx, namespacedName)
+		}
+
 		if err != nil {
 			p.log.Error(err, fmt.Sprintf("failed to get %v %#v", profileKind, namespacedName))
 			return admission.Errored(http.StatusInternalServerError, err)
 		}
 
-		for j := range containers {
-			podChanged = p.addSecurityContext(containers[j], bindProfile)
+		bindingChanged := false
+		for j := range matched {
+			boundContainers[boundKind].Insert(matched[j].Name)
+			if p.addSecurityContext(pod, matched[j], bindProfile) {
+				bindingChanged = true
+			}
 		}
-		if podChanged {
+		if bindingChanged {
+			podChanged = true
 			if err := p.addPodToBinding(ctx, podID, &profilebindings[i]); err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
 			}
@@ -189,6 +198,61 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
 }
 
+// sortBindingsByPrecedence orders the bindings so that the most specific
+// ones come first. Bindings with the same specificity are ordered by name.
+func sortBindingsByPrecedence(bindings []profilebindingv1alpha1.ProfileBinding) {
+	sort.SliceStable(bindings, func(i, j int) bool {
+		si, sj := bindings[i].Specificity(), bindings[j].Specificity()
+		if si != sj {
+			return si > sj
+		}
+		return bindings[i].Name < bindings[j].Name
+	})
+}
+
+// matchingContainers returns the containers of the pod selected by the
+// binding, excluding the ones which are already bound to a profile of the
+// same kind by a binding with higher precedence.
+func (p *podBinder) matchingContainers(
+	pod *corev1.Pod,
+	pb *profilebindingv1alpha1.ProfileBinding,
+	bound sets.Set[string],
+) (containerList, error) {
+	if pb.Spec.PodSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(pb.Spec.PodSelector)
+		if err != nil {
+			return nil, fmt.Errorf("parse pod selector: %w", err)
+		}
+		if !selector.Matches(labels.Set(pod.GetLabels())) {
+			return nil, nil
+		}
+	}
+
+	candidates := containerList{}
+	for i := range pod.Spec.Containers {
+		candidates = append(candidates, &pod.Spec.Containers[i])
+	}
+	for i := range pod.Spec.InitContainers {
+		candidates = append(candidates, &pod.Spec.InitContainers[i])
+	}
+
+	matchImage := pb.Spec.Image != "" || pb.Specificity() == 0
+	matches := containerList{}
+	for _, c := range candidates {
+		if bound.Has(c.Name) {
+			continue
+		}
+		if matchImage && !imageMatches(pb.Spec.Image, c.Image) {
+			continue
+		}
+		if len(pb.Spec.ContainerNames) > 0 && !util.Contains(pb.Spec.ContainerNames, c.Name) {
+			continue
+		}
+		matches = append(matches, c)
+	}
+	return matches, nil
+}
+
 func (p *podBinder) getSeccompProfile(
 	ctx context.Context,
 	key types.NamespacedName,
@@ -210,6 +274,27 @@ func (p *podBinder) getSeccompProfile(
 	return seccompProfile, err
 }
 
+func (p *podBinder) getClusterSeccompProfile(
+	ctx context.Context,
+	key types.NamespacedName,
+) (seccompProfile *seccompprofileapi.ClusterSeccompProfile, err error) {
+	err = util.Retry(
+		func() (retryErr error) {
+			seccompProfile, retryErr = p.GetClusterSeccompProfile(ctx, key)
+			if retryErr != nil {
+				return fmt.Errorf("getting profile: %w", retryErr)
+			}
+			if seccompProfile.Status.Status == "" {
+				return fmt.Errorf("getting profile: %w", ErrProfWithoutStatus)
+			}
+			return nil
+		}, func(inErr error) bool {
+			return errors.Is(inErr, ErrProfWithoutStatus) || kerrors.IsNotFound(inErr)
+		})
+	//nolint:wrapcheck // already wrapped
+	return seccompProfile, err
+}
+
 func (p *podBinder) getSelinuxProfile(
 	ctx context.Context,
 	key types.NamespacedName,
@@ -231,16 +316,41 @@ func (p *podBinder) getSelinuxProfile(
 	return selinuxProfile, err
 }
 
+func (p *podBinder) getAppArmorProfile(
+	ctx context.Context,
+	key types.NamespacedName,
+) (appArmorProfile *apparmorprofileapi.AppArmorProfile, err error) {
+	err = util.Retry(
+		func() (retryErr error) {
+			appArmorProfile, retryErr = p.GetAppArmorProfile(ctx, key)
+			if retryErr != nil {
+				return fmt.Errorf("getting profile: %w", retryErr)
+			}
+			if appArmorProfile.Status.Status == "" {
+				return fmt.Errorf("getting profile: %w", ErrProfWithoutStatus)
+			}
+			return nil
+		}, func(inErr error) bool {
+			return errors.Is(inErr, ErrProfWithoutStatus) || kerrors.IsNotFound(inErr)
+		})
+	//nolint:wrapcheck // error is already wrapped
+	return appArmorProfile, err
+}
+
 func (p *podBinder) addSecurityContext(
-	c *corev1.Container, bindProfile interface{},
+	pod *corev1.Pod, c *corev1.Container, bindProfile interface{},
 ) bool {
 	var podChanged bool
 
 	switch v := bindProfile.(type) {
 	case *seccompprofileapi.SeccompProfile:
-		podChanged = p.addSeccompContext(c, v)
+		podChanged = p.addSeccompContext(c, v.Status.LocalhostProfile)
+	case *seccompprofileapi.ClusterSeccompProfile:
+		podChanged = p.addSeccompContext(c, v.Status.LocalhostProfile)
 	case *selinuxprofileapi.SelinuxProfile:
 		podChanged = p.addSelinuxContext(c, v)
+	case *apparmorprofileapi.AppArmorProfile:
+		podChanged = p.addAppArmorContext(pod, c, v)
 	default:
 		p.log.Info("Unexpected Profile Type")
 		return false
@@ -249,10 +359,9 @@ func (p *podBinder) addSecurityContext(
 }
 
 func (p *podBinder) addSeccompContext(
-	c *corev1.Container, seccompProfile *seccompprofileapi.SeccompProfile,
+	c *corev1.Container, profileRef string,
 ) bool {
 	podChanged := false
-	profileRef := seccompProfile.Status.LocalhostProfile
 	sp := corev1.SeccompProfile{
 		Type:             corev1.SeccompProfileTypeLocalhost,
 		LocalhostProfile: &profileRef,
@@ -290,6 +399,24 @@ func (p *podBinder) addSelinuxContext(
 	return podChanged
 }
 
+// addAppArmorContext binds the AppArmor profile to the container by using
+// the beta container annotation, since the pod security context field is not
+// available in the Kubernetes API version we build against.
+func (p *podBinder) addAppArmorContext(
+	pod *corev1.Pod, c *corev1.Container, appArmorProfile *apparmorprofileapi.AppArmorProfile,
+) bool {
+	key := corev1.AppArmorBetaContainerAnnotationKeyPrefix + c.Name
+	if _, ok := pod.GetAnnotations()[key]; ok {
+		p.log.Info("cannot override existing apparmor profile for pod or container")
+		return false
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[key] = corev1.AppArmorBetaProfileNamePrefix + appArmorProfile.GetProfileName()
+	return true
+}
+
 func (p *podBinder) addPodToBinding(
 	ctx context.Context,
 	podID string,
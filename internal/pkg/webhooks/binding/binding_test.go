@@ -21,7 +21,6 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
-	"sync"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -30,8 +29,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
@@ -130,6 +131,51 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 1)
 			},
 		},
+		{ // cluster seccomp profile success pod changed
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindClusterSeccompProfile,
+									Name: "cluster-profile",
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetClusterSeccompProfileReturns(&seccompprofileapi.ClusterSeccompProfile{
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+						LocalhostProfile: "operator/_cluster/cluster-profile.json",
+					},
+				}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Namespace: "test-ns",
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+				require.Equal(t,
+					"operator/_cluster/cluster-profile.json",
+					resp.Patches[0].Value.(map[string]interface{})["seccompProfile"].(map[string]interface{})["localhostProfile"],
+				)
+			},
+		},
 		{ // selinux success pod changed
 			prepare: func(mock *bindingfakes.FakeImpl) {
 				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
@@ -168,6 +214,80 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 1)
 			},
 		},
+		{ // apparmor success pod changed
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindAppArmorProfile,
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetAppArmorProfileReturns(&apparmorprofileapi.AppArmorProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+					Status: apparmorprofileapi.AppArmorProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+				require.Equal(t, "/metadata/annotations", resp.Patches[0].Path)
+				require.Equal(t, map[string]interface{}{
+					corev1.AppArmorBetaContainerAnnotationKeyPrefix + "container": "localhost/profile",
+				}, resp.Patches[0].Value)
+			},
+		},
+		{ // apparmor success existing annotation not overridden
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindAppArmorProfile,
+								},
+							},
+						},
+					},
+				}, nil)
+				pod := testPod.DeepCopy()
+				pod.Annotations = map[string]string{
+					corev1.AppArmorBetaContainerAnnotationKeyPrefix + "container": "runtime/default",
+				}
+				mock.DecodePodReturns(pod, nil)
+				mock.GetAppArmorProfileReturns(&apparmorprofileapi.AppArmorProfile{
+					Status: apparmorprofileapi.AppArmorProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, metav1.StatusReason("pod unchanged"), resp.Result.Reason)
+			},
+		},
 		{ // success unsupported kind
 			prepare: func(mock *bindingfakes.FakeImpl) {
 				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
@@ -413,128 +533,99 @@ func TestHandle(t *testing.T) {
 	}
 }
 
-func TestNewContainerMap(t *testing.T) {
+func TestMatchingContainers(t *testing.T) {
 	t.Parallel()
 
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"role": "frontend"},
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{
+				Name:  "init",
+				Image: "nginx",
+			}},
+			Containers: []corev1.Container{
+				{
+					Name:  "web",
+					Image: "nginx",
+				},
+				{
+					Name:  "sidecar",
+					Image: "envoy",
+				},
+			},
+		},
+	}
+
 	cases := []struct {
 		name    string
-		podSpec *corev1.PodSpec
-		want    map[string]containerList
+		spec    v1alpha1.ProfileBindingSpec
+		bound   []string
+		want    []string
+		wantErr bool
 	}{
 		{
-			name:    "NoContainers",
-			podSpec: &corev1.PodSpec{},
-			want:    map[string]containerList{},
+			name: "ImageOnly",
+			spec: v1alpha1.ProfileBindingSpec{Image: "nginx"},
+			want: []string{"web", "init"},
 		},
 		{
-			name: "OnlyContainers",
-			podSpec: &corev1.PodSpec{
-				Containers: []corev1.Container{
-					{
-						Name:  "web",
-						Image: "nginx",
-					},
-					{
-						Name:  "sidecar",
-						Image: "sidecar-image",
-					},
-				},
-			},
-			want: map[string]containerList{
-				"nginx": {
-					{
-						Name:  "web",
-						Image: "nginx",
-					},
-				},
-				"sidecar-image": {
-					{
-						Name:  "sidecar",
-						Image: "sidecar-image",
-					},
-				},
-			},
+			name: "ImagePattern",
+			spec: v1alpha1.ProfileBindingSpec{Image: "docker.io/library/*"},
+			want: []string{"web", "init", "sidecar"},
 		},
 		{
-			name: "OnlyInitContainers",
-			podSpec: &corev1.PodSpec{
-				InitContainers: []corev1.Container{
-					{
-						Name:  "step1",
-						Image: "busybox",
-					},
-					{
-						Name:  "step2",
-						Image: "bash",
-					},
+			name: "ImageNotFound",
+			spec: v1alpha1.ProfileBindingSpec{Image: "redis"},
+		},
+		{
+			name: "PodSelectorMatches",
+			spec: v1alpha1.ProfileBindingSpec{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"role": "frontend"},
 				},
 			},
-			want: map[string]containerList{
-				"busybox": {
-					{
-						Name:  "step1",
-						Image: "busybox",
-					},
-				},
-				"bash": {
-					{
-						Name:  "step2",
-						Image: "bash",
-					},
+			want: []string{"init", "web", "sidecar"},
+		},
+		{
+			name: "PodSelectorDoesNotMatch",
+			spec: v1alpha1.ProfileBindingSpec{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"role": "backend"},
 				},
 			},
 		},
 		{
-			name: "ContainersAndInitContainers",
-			podSpec: &corev1.PodSpec{
-				InitContainers: []corev1.Container{{
-					Name:  "init",
-					Image: "bash",
-				}},
-				Containers: []corev1.Container{{
-					Name:  "app",
-					Image: "nginx",
-				}},
-			},
-			want: map[string]containerList{
-				"bash": {
-					{
-						Name:  "init",
-						Image: "bash",
-					},
-				},
-				"nginx": {
-					{
-						Name:  "app",
-						Image: "nginx",
-					},
+			name: "InvalidPodSelector",
+			spec: v1alpha1.ProfileBindingSpec{
+				PodSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "role",
+						Operator: "Invalid",
+					}},
 				},
 			},
+			wantErr: true,
 		},
 		{
-			name: "DuplicateImages",
-			podSpec: &corev1.PodSpec{
-				InitContainers: []corev1.Container{{
-					Name:  "init",
-					Image: "bash",
-				}},
-				Containers: []corev1.Container{{
-					Name:  "app",
-					Image: "bash",
-				}},
-			},
-			want: map[string]containerList{
-				"bash": {
-					{
-						Name:  "app",
-						Image: "bash",
-					},
-					{
-						Name:  "init",
-						Image: "bash",
-					},
-				},
+			name: "ContainerNames",
+			spec: v1alpha1.ProfileBindingSpec{ContainerNames: []string{"sidecar"}},
+			want: []string{"sidecar"},
+		},
+		{
+			name: "ImageAndContainerNames",
+			spec: v1alpha1.ProfileBindingSpec{
+				Image:          "nginx",
+				ContainerNames: []string{"web"},
 			},
+			want: []string{"web"},
+		},
+		{
+			name:  "AlreadyBound",
+			spec:  v1alpha1.ProfileBindingSpec{Image: "nginx"},
+			bound: []string{"web"},
+			want:  []string{"init"},
 		},
 	}
 
@@ -543,18 +634,286 @@ func TestNewContainerMap(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			var result sync.Map
-			initContainerMap(&result, tc.podSpec)
-			result.Range(func(k, v interface{}) bool {
-				ks, ok := k.(string)
-				require.True(t, ok)
+			binder := podBinder{log: logr.Discard()}
+			res, err := binder.matchingContainers(
+				pod.DeepCopy(), &v1alpha1.ProfileBinding{Spec: tc.spec}, sets.New(tc.bound...),
+			)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, c := range res {
+				names = append(names, c.Name)
+			}
+			require.ElementsMatch(t, tc.want, names)
+		})
+	}
+}
+
+func TestSortBindingsByPrecedence(t *testing.T) {
+	t.Parallel()
+
+	bindings := []v1alpha1.ProfileBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "image-b"},
+			Spec:       v1alpha1.ProfileBindingSpec{Image: "nginx"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "image-and-names"},
+			Spec: v1alpha1.ProfileBindingSpec{
+				Image:          "nginx",
+				ContainerNames: []string{"web"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "image-a"},
+			Spec:       v1alpha1.ProfileBindingSpec{Image: "nginx"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "all"},
+			Spec: v1alpha1.ProfileBindingSpec{
+				Image:          "nginx",
+				PodSelector:    &metav1.LabelSelector{},
+				ContainerNames: []string{"web"},
+			},
+		},
+	}
+
+	sortBindingsByPrecedence(bindings)
+
+	names := []string{}
+	for i := range bindings {
+		names = append(names, bindings[i].Name)
+	}
+	require.Equal(t, []string{"all", "image-and-names", "image-a", "image-b"}, names)
+}
+
+func TestImageMatches(t *testing.T) {
+	t.Parallel()
+
+	const digest = "sha256:4b3f1f8e2a1d1c8c5b5f9b5e0e8c4b0e0d6b1d7a9a6c1e4e2f3a4b5c6d7e8f90"
+
+	cases := []struct {
+		name           string
+		bindingImage   string
+		containerImage string
+		want           bool
+	}{
+		{
+			name:           "Exact",
+			bindingImage:   "nginx:1.19.1",
+			containerImage: "nginx:1.19.1",
+			want:           true,
+		},
+		{
+			name:           "NormalizedOfficialImage",
+			bindingImage:   "docker.io/library/nginx:1.19.1",
+			containerImage: "nginx:1.19.1",
+			want:           true,
+		},
+		{
+			name:           "NormalizedLegacyDomain",
+			bindingImage:   "nginx",
+			containerImage: "index.docker.io/library/nginx:1.19.1",
+			want:           true,
+		},
+		{
+			name:           "NormalizedUserImage",
+			bindingImage:   "docker.io/team/app:v1",
+			containerImage: "team/app:v1",
+			want:           true,
+		},
+		{
+			name:           "DifferentTag",
+			bindingImage:   "nginx:1.19.1",
+			containerImage: "nginx:1.20.0",
+		},
+		{
+			name:           "ImplicitLatestTag",
+			bindingImage:   "nginx:latest",
+			containerImage: "nginx",
+			want:           true,
+		},
+		{
+			name:           "RepositoryOnlyIgnoresTag",
+			bindingImage:   "registry.example.com/team/app",
+			containerImage: "registry.example.com/team/app:v2",
+			want:           true,
+		},
+		{
+			name:           "RepositoryOnlyIgnoresDigest",
+			bindingImage:   "registry.example.com/team/app",
+			containerImage: "registry.example.com/team/app@" + digest,
+			want:           true,
+		},
+		{
+			name:           "RepositoryOnlyDifferentRepository",
+			bindingImage:   "registry.example.com/team/app",
+			containerImage: "registry.example.com/team/other:v2",
+		},
+		{
+			name:           "RegistryWithPort",
+			bindingImage:   "localhost:5000/app",
+			containerImage: "localhost:5000/app:v1",
+			want:           true,
+		},
+		{
+			name:           "DigestEqual",
+			bindingImage:   "nginx@" + digest,
+			containerImage: "docker.io/library/nginx:1.19.1@" + digest,
+			want:           true,
+		},
+		{
+			name:           "DigestDifferent",
+			bindingImage:   "nginx@" + digest,
+			containerImage: "nginx@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:           "DigestRequiredButTagged",
+			bindingImage:   "nginx@" + digest,
+			containerImage: "nginx:1.19.1",
+		},
+		{
+			name:           "GlobRepositoryAndTag",
+			bindingImage:   "registry.example.com/team/*:*",
+			containerImage: "registry.example.com/team/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobRepositoryAndTagWithDigest",
+			bindingImage:   "registry.example.com/team/*:*",
+			containerImage: "registry.example.com/team/app@" + digest,
+		},
+		{
+			name:           "GlobDoesNotCrossPathSeparator",
+			bindingImage:   "registry.example.com/team/*:*",
+			containerImage: "registry.example.com/team/sub/app:v1",
+		},
+		{
+			name:           "GlobTag",
+			bindingImage:   "nginx:1.19.*",
+			containerImage: "nginx:1.19.1",
+			want:           true,
+		},
+		{
+			name:           "GlobTagMismatch",
+			bindingImage:   "nginx:1.19.*",
+			containerImage: "nginx:1.20.1",
+		},
+		{
+			name:           "GlobRepositoryOnly",
+			bindingImage:   "registry.example.com/*/app",
+			containerImage: "registry.example.com/team/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobAnyRepository",
+			bindingImage:   "*",
+			containerImage: "registry.example.com/team/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobAnyRepositoryOfficialImage",
+			bindingImage:   "*",
+			containerImage: "nginx",
+			want:           true,
+		},
+		{
+			name:           "GlobAnyRepositoryWithTag",
+			bindingImage:   "*:v1",
+			containerImage: "registry.example.com/team/app:v2",
+		},
+		{
+			name:           "GlobTwoComponents",
+			bindingImage:   "*/*",
+			containerImage: "team/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobTwoComponentsNotRegistry",
+			bindingImage:   "*/*",
+			containerImage: "registry.example.com/app:v1",
+		},
+		{
+			name:           "GlobRegistry",
+			bindingImage:   "*.example.com/app",
+			containerImage: "registry.example.com/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobRegistryWithPort",
+			bindingImage:   "localhost:*/app",
+			containerImage: "localhost:5000/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobWithoutDomainIsDockerHub",
+			bindingImage:   "registry*/app",
+			containerImage: "registry/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobWithoutDomainNotRegistry",
+			bindingImage:   "registry*/app",
+			containerImage: "registry.example.com/app:v1",
+		},
+		{
+			name:           "GlobShortNameNamespace",
+			bindingImage:   "*/app",
+			containerImage: "team/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobShortNameNamespaceNormalized",
+			bindingImage:   "*/app",
+			containerImage: "docker.io/team/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobShortNameNamespaceOtherRegistry",
+			bindingImage:   "*/app",
+			containerImage: "registry.example.com/team/app:v1",
+		},
+		{
+			name:           "GlobShortNameOfficialImages",
+			bindingImage:   "library/*",
+			containerImage: "nginx:1.19.1",
+			want:           true,
+		},
+		{
+			name:           "GlobShortNameOfficialImagesUserImage",
+			bindingImage:   "library/*",
+			containerImage: "team/app:v1",
+		},
+		{
+			name:           "GlobOfficialImage",
+			bindingImage:   "ngin*",
+			containerImage: "docker.io/library/nginx:1.19.1",
+			want:           true,
+		},
+		{
+			name:           "GlobNormalizedUserRepository",
+			bindingImage:   "team/*",
+			containerImage: "docker.io/team/app:v1",
+			want:           true,
+		},
+		{
+			name:           "GlobNormalizedLegacyDomain",
+			bindingImage:   "index.docker.io/team/*",
+			containerImage: "team/app:v1",
+			want:           true,
+		},
+	}
 
-				vl, ok := v.(containerList)
-				require.True(t, ok)
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
 
-				require.Equal(t, tc.want[ks], vl)
-				return true
-			})
+			require.Equal(t, tc.want, imageMatches(tc.bindingImage, tc.containerImage))
 		})
 	}
 }
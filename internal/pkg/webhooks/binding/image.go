@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"path"
+	"strings"
+)
+
+const (
+	defaultDomain    = "docker.io"
+	legacyDomain     = "index.docker.io"
+	officialRepoPath = "library/"
+	defaultTag       = "latest"
+	globChars        = "*?["
+)
+
+// imageRef is a container image reference split into its normalized parts.
+type imageRef struct {
+	repository string
+	tag        string
+	digest     string
+}
+
+// parseImageRef splits the image reference into repository, tag and digest.
+func parseImageRef(image string) imageRef {
+	ref := imageRef{}
+
+	if i := strings.Index(image, "@"); i >= 0 {
+		ref.digest = image[i+1:]
+		image = image[:i]
+	}
+
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		ref.tag = image[i+1:]
+		image = image[:i]
+	}
+
+	ref.repository = image
+	return ref
+}
+
+// normalizeRepository normalizes the repository in the same way as the
+// container runtimes do, for example `nginx` becomes `docker.io/library/nginx`.
+func normalizeRepository(repository string) string {
+	domain, remainder, found := strings.Cut(repository, "/")
+	if !found || (!strings.ContainsAny(domain, ".:") && domain != "localhost") {
+		domain, remainder = defaultDomain, repository
+	}
+
+	if domain == legacyDomain {
+		domain = defaultDomain
+	}
+
+	if domain == defaultDomain && !strings.Contains(remainder, "/") {
+		remainder = officialRepoPath + remainder
+	}
+
+	return domain + "/" + remainder
+}
+
+// imageMatches returns true if the container image matches the image of a
+// ProfileBinding. The binding image can be:
+//
+//   - a repository without tag and digest, which matches every tag and digest
+//     of the repository,
+//   - a reference with tag and/or digest, which has to match the tag and/or
+//     digest of the container image,
+//   - a glob pattern (see path.Match) for any of the above.
+//
+// Both references are normalized before comparing them, so `nginx` and
+// `docker.io/library/nginx` are equal. Glob patterns are normalized with the
+// same rules, which means that `*/app` matches `docker.io/team/app`, while a
+// glob for the registry needs a `.` or `:`, like `*.example.com/app`.
+func imageMatches(bindingImage, containerImage string) bool {
+	if bindingImage == containerImage {
+		return true
+	}
+
+	want := parseImageRef(bindingImage)
+	got := parseImageRef(containerImage)
+	if got.tag == "" && got.digest == "" {
+		got.tag = defaultTag
+	}
+
+	if !repositoryMatches(want.repository, normalizeRepository(got.repository)) {
+		return false
+	}
+
+	if want.tag != "" && (got.tag == "" || !globMatches(want.tag, got.tag)) {
+		return false
+	}
+
+	if want.digest != "" && (got.digest == "" || !globMatches(want.digest, got.digest)) {
+		return false
+	}
+
+	return true
+}
+
+// repositoryMatches returns true if the repository pattern of a binding
+// matches the normalized repository of a container image. The pattern `*`
+// matches all repositories.
+func repositoryMatches(pattern, repository string) bool {
+	if pattern == "*" {
+		return true
+	}
+	return globMatches(normalizeRepository(pattern), repository)
+}
+
+func globMatches(pattern, value string) bool {
+	if !strings.ContainsAny(pattern, globChars) {
+		return pattern == value
+	}
+
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
@@ -46,7 +47,9 @@ type impl interface {
 	SetDecoder(*admission.Decoder)
 	DecodePod(admission.Request) (*corev1.Pod, error)
 	GetSeccompProfile(context.Context, types.NamespacedName) (*seccompprofileapi.SeccompProfile, error)
+	GetClusterSeccompProfile(context.Context, types.NamespacedName) (*seccompprofileapi.ClusterSeccompProfile, error)
 	GetSelinuxProfile(context.Context, types.NamespacedName) (*selinuxprofileapi.SelinuxProfile, error)
+	GetAppArmorProfile(context.Context, types.NamespacedName) (*apparmorprofileapi.AppArmorProfile, error)
 }
 
 func (d *defaultImpl) ListProfileBindings(
@@ -100,6 +103,16 @@ func (d *defaultImpl) GetSeccompProfile(
 	return seccompProfile, nil
 }
 
+func (d *defaultImpl) GetClusterSeccompProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*seccompprofileapi.ClusterSeccompProfile, error) {
+	seccompProfile := &seccompprofileapi.ClusterSeccompProfile{}
+	if err := d.client.Get(ctx, key, seccompProfile); err != nil {
+		return nil, fmt.Errorf("get cluster seccomp profile: %w", err)
+	}
+	return seccompProfile, nil
+}
+
 func (d *defaultImpl) GetSelinuxProfile(
 	ctx context.Context, key types.NamespacedName,
 ) (*selinuxprofileapi.SelinuxProfile, error) {
@@ -110,3 +123,13 @@ func (d *defaultImpl) GetSelinuxProfile(
 	}
 	return selinuxProfile, nil
 }
+
+func (d *defaultImpl) GetAppArmorProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*apparmorprofileapi.AppArmorProfile, error) {
+	appArmorProfile := &apparmorprofileapi.AppArmorProfile{}
+	if err := d.client.Get(ctx, key, appArmorProfile); err != nil {
+		return nil, fmt.Errorf("get apparmor profile: %w", err)
+	}
+	return appArmorProfile, nil
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -111,6 +112,16 @@ func (p *podSeccompRecorder) Handle(
 			continue
 		}
 
+		// Time bounded recordings do not pick up new pods once their window
+		// ended, but still have to track the deletion of recorded ones.
+		if req.Operation != admissionv1.Delete && item.IsStopped(time.Now()) {
+			p.log.Info(fmt.Sprintf(
+				"recording %s already stopped, not recording pod %s",
+				item.Name, podName,
+			))
+			continue
+		}
+
 		selector, err := p.impl.LabelSelectorAsSelector(
 			&item.Spec.PodSelector,
 		)
@@ -205,7 +216,7 @@ func (p *podSeccompRecorder) updatePod(
 
 		p.warnEventIfContainerPrivileged(profileRecording, ctr, pod)
 
-		p.updateSecurityContext(ctr, profileRecording)
+		p.updateSecurityContext(pod, ctr, profileRecording)
 		existingValue, ok := pod.GetAnnotations()[key]
 		if !ok {
 			if pod.Annotations == nil {
@@ -237,7 +248,7 @@ func (p *podSeccompRecorder) updatePod(
 }
 
 func (p *podSeccompRecorder) updateSecurityContext(
-	ctr *corev1.Container, pr *profilerecordingv1alpha1.ProfileRecording,
+	pod *corev1.Pod, ctr *corev1.Container, pr *profilerecordingv1alpha1.ProfileRecording,
 ) {
 	if pr.Spec.Recorder != profilerecordingv1alpha1.ProfileRecorderLogs {
 		// we only need to ensure the special security context if we're tailing
@@ -250,6 +261,9 @@ func (p *podSeccompRecorder) updateSecurityContext(
 		p.updateSeccompSecurityContext(ctr, pr)
 	case profilerecordingv1alpha1.ProfileRecordingKindSelinuxProfile:
 		p.updateSelinuxSecurityContext(ctr, pr)
+	case profilerecordingv1alpha1.ProfileRecordingKindAppArmorProfile:
+		p.updateAppArmorContext(pod, ctr, pr)
+		return
 	}
 
 	p.log.Info(fmt.Sprintf(
@@ -304,6 +318,30 @@ func (p *podSeccompRecorder) updateSelinuxSecurityContext(
 	ctr.SecurityContext.SELinuxOptions.Type = config.SelinuxPermissiveProfile
 }
 
+func (p *podSeccompRecorder) updateAppArmorContext(
+	pod *corev1.Pod,
+	ctr *corev1.Container,
+	pr *profilerecordingv1alpha1.ProfileRecording,
+) {
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+
+	key := corev1.AppArmorBetaContainerAnnotationKeyPrefix + ctr.Name
+	if _, ok := pod.Annotations[key]; ok {
+		p.record.Eventf(pr,
+			corev1.EventTypeWarning,
+			"AppArmorProfileAlreadySet",
+			"Container %s had an AppArmor profile already set, the profile recorder overwrote it", ctr.Name)
+	}
+
+	pod.Annotations[key] = corev1.AppArmorBetaProfileNamePrefix + config.AppArmorComplainProfile
+	p.log.Info(fmt.Sprintf(
+		"set AppArmor profile for container %s: %s",
+		ctr.Name, pod.Annotations[key],
+	))
+}
+
 func (p *podSeccompRecorder) setRecordingReferences(
 	ctx context.Context,
 	op admissionv1.Operation,
@@ -351,6 +389,10 @@ func (p *podSeccompRecorder) setActiveWorkloads(
 		newActiveWorkloads = utils.RemoveIfExists(newActiveWorkloads, podName)
 	} else if selector.Matches(podLabels) {
 		newActiveWorkloads = utils.AppendIfNotExists(newActiveWorkloads, podName)
+		profileRecording.Status.SetPhase(
+			profilerecordingv1alpha1.RecordingConditionRecording,
+			profilerecordingv1alpha1.ReasonWorkloadsRecorded, "",
+		)
 	}
 
 	profileRecording.Status.ActiveWorkloads = newActiveWorkloads
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
@@ -91,6 +93,27 @@ func TestHandle(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, int(resp.Result.Code))
 			},
 		},
+		{ // success pod unchanged because recording already stopped
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{
+						{
+							Spec: v1alpha1.ProfileRecordingSpec{
+								Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+								Recorder: v1alpha1.ProfileRecorderBpf,
+								StopAt:   &metav1.Time{Time: time.Now().Add(-time.Minute)},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, metav1.StatusReason("pod unchanged"), resp.Result.Reason)
+			},
+		},
 		// todo: bad combination, selinux + hook
 		// todo: actually look at the content of the patches
 		{ // success pod changed - tailing logs
@@ -121,6 +144,14 @@ func TestHandle(t *testing.T) {
 				mock.GetOperatorNamespaceReturns("test-ns")
 				mock.DecodePodReturns(testPod.DeepCopy(), nil)
 				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+				mock.UpdateResourceStatusCalls(func(
+					ctx context.Context, logger logr.Logger, obj client.Object, name string,
+				) error {
+					pr, ok := obj.(*v1alpha1.ProfileRecording)
+					require.True(t, ok)
+					require.Equal(t, v1alpha1.RecordingConditionRecording, pr.Status.Phase())
+					return nil
+				})
 			},
 			request: admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{
@@ -138,6 +169,57 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 2) // 2 because security context and the annotation
 			},
 		},
+		{ // success pod changed - tailing logs for apparmor
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{
+						{
+							Spec: v1alpha1.ProfileRecordingSpec{
+								Kind:     v1alpha1.ProfileRecordingKindAppArmorProfile,
+								Recorder: v1alpha1.ProfileRecorderLogs,
+							},
+						},
+					},
+				}, nil)
+				mock.GetProfileRecordingReturns(&v1alpha1.ProfileRecording{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-little-profile-recording",
+						Namespace: "test-ns",
+					},
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:     v1alpha1.ProfileRecordingKindAppArmorProfile,
+						Recorder: v1alpha1.ProfileRecorderLogs,
+					},
+				}, nil)
+				mock.ListRecordedPodsReturns(&corev1.PodList{
+					Items: []corev1.Pod{},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1) // recording and apparmor annotations
+				annotations, ok := resp.Patches[0].Value.(map[string]interface{})
+				require.True(t, ok)
+				require.Equal(t,
+					corev1.AppArmorBetaProfileNamePrefix+"spo-apparmor-recording",
+					annotations[corev1.AppArmorBetaContainerAnnotationKeyPrefix+"container"],
+				)
+				require.Len(t, annotations, 2)
+			},
+		},
 		{ // success pod changed
 			prepare: func(mock *recordingfakes.FakeImpl) {
 				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
+)
+
+var (
+	ErrInvalidAppArmorPolicy = errors.New("invalid AppArmor policy")
+	ErrInvalidAppArmorRule   = translator.ErrInvalidAppArmorRule
+)
+
+func validateAppArmorProfile(ap *apparmorprofileapi.AppArmorProfile) error {
+	if ap.Spec.Policy != "" {
+		return validateAppArmorPolicy(ap.GetProfileName(), ap.Spec.Policy)
+	}
+	if ap.Spec.Abstract != nil {
+		return translator.ValidateAppArmorAbstract(ap.Spec.Abstract)
+	}
+	return nil
+}
+
+// validateAppArmorPolicy verifies that the braces of the policy are balanced
+// and that it declares a top level profile with the provided name, which is
+// required to load it on the nodes.
+func validateAppArmorPolicy(name, policy string) error {
+	depth := 0
+	profiles := []string{}
+
+	for i, line := range strings.Split(policy, "\n") {
+		line = stripAppArmorComment(line)
+		if line == "" {
+			continue
+		}
+
+		if depth == 0 && strings.HasSuffix(line, "{") {
+			profiles = append(profiles, appArmorProfileName(line))
+		}
+
+		depth += strings.Count(line, "{") - strings.Count(line, "}")
+		if depth < 0 {
+			return fmt.Errorf("line %d: unexpected closing brace: %w", i+1, ErrInvalidAppArmorPolicy)
+		}
+	}
+
+	if depth > 0 {
+		return fmt.Errorf("missing %d closing braces: %w", depth, ErrInvalidAppArmorPolicy)
+	}
+
+	for _, profile := range profiles {
+		if profile == name {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"profile %q is not declared in the policy, found [%s]: %w",
+		name, strings.Join(profiles, ", "), ErrInvalidAppArmorPolicy,
+	)
+}
+
+// stripAppArmorComment removes comments and include directives from the line.
+func stripAppArmorComment(line string) string {
+	for i := range line {
+		if line[i] == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			line = line[:i]
+			break
+		}
+	}
+	return strings.TrimSpace(line)
+}
+
+// appArmorProfileName returns the profile name of a profile header like
+// `profile name /attachment flags=(...) {` or `/attachment {`.
+func appArmorProfileName(header string) string {
+	fields := strings.Fields(strings.TrimSuffix(header, "{"))
+	if len(fields) == 0 {
+		return ""
+	}
+	name := fields[0]
+	if name == "profile" && len(fields) > 1 {
+		name = fields[1]
+	}
+	return strings.Trim(name, `"`)
+}
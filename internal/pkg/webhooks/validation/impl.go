@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/selinuxprofile"
+)
+
+type defaultImpl struct {
+	client  client.Client
+	decoder *admission.Decoder
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	SetDecoder(*admission.Decoder)
+	DecodeObject(admission.Request, runtime.Object) error
+	DecodeOldObject(admission.Request, runtime.Object) error
+	GetSPOD(context.Context) (*spodapi.SecurityProfilesOperatorDaemon, error)
+	GetSeccompProfile(context.Context, types.NamespacedName) (*seccompprofileapi.SeccompProfile, error)
+	GetClusterSeccompProfile(context.Context, types.NamespacedName) (*seccompprofileapi.ClusterSeccompProfile, error)
+	ValidateSelinuxProfile(*selxv1alpha2.SelinuxProfile) error
+}
+
+func (d *defaultImpl) SetDecoder(decoder *admission.Decoder) {
+	d.decoder = decoder
+}
+
+//nolint:gocritic
+func (d *defaultImpl) DecodeObject(req admission.Request, obj runtime.Object) error {
+	if err := d.decoder.DecodeRaw(req.Object, obj); err != nil {
+		return fmt.Errorf("decode object: %w", err)
+	}
+	return nil
+}
+
+//nolint:gocritic
+func (d *defaultImpl) DecodeOldObject(req admission.Request, obj runtime.Object) error {
+	if err := d.decoder.DecodeRaw(req.OldObject, obj); err != nil {
+		return fmt.Errorf("decode old object: %w", err)
+	}
+	return nil
+}
+
+func (d *defaultImpl) GetSPOD(ctx context.Context) (*spodapi.SecurityProfilesOperatorDaemon, error) {
+	spod, err := common.GetSPOD(ctx, d.client)
+	if err != nil {
+		return nil, fmt.Errorf("get spod: %w", err)
+	}
+	return spod, nil
+}
+
+func (d *defaultImpl) GetSeccompProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*seccompprofileapi.SeccompProfile, error) {
+	seccompProfile := &seccompprofileapi.SeccompProfile{}
+	if err := d.client.Get(ctx, key, seccompProfile); err != nil {
+		return nil, fmt.Errorf("get seccomp profile: %w", err)
+	}
+	return seccompProfile, nil
+}
+
+func (d *defaultImpl) GetClusterSeccompProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*seccompprofileapi.ClusterSeccompProfile, error) {
+	seccompProfile := &seccompprofileapi.ClusterSeccompProfile{}
+	if err := d.client.Get(ctx, key, seccompProfile); err != nil {
+		return nil, fmt.Errorf("get cluster seccomp profile: %w", err)
+	}
+	return seccompProfile, nil
+}
+
+func (d *defaultImpl) ValidateSelinuxProfile(sp *selxv1alpha2.SelinuxProfile) error {
+	return selinuxprofile.ValidateProfile(d.client, sp)
+}
@@ -0,0 +1,62 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+
+	seccomp "github.com/seccomp/libseccomp-golang"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+const archPrefix = "SCMP_ARCH_"
+
+// isKnownSyscall returns true if libseccomp is able to resolve the syscall
+// name for any of the provided architectures. The native architecture is used
+// if none are provided.
+func isKnownSyscall(name string, architectures []seccompprofileapi.Arch) bool {
+	if len(architectures) == 0 {
+		architectures = []seccompprofileapi.Arch{archPrefix + "NATIVE"}
+	}
+
+	for _, arch := range architectures {
+		scmpArch, err := scmpArchFromProfile(arch)
+		if err != nil {
+			continue
+		}
+		// libseccomp returns negative pseudo syscall numbers for syscalls
+		// which are not available on the architecture.
+		if id, err := seccomp.GetSyscallFromNameByArch(name, scmpArch); err == nil && id >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// scmpArchFromProfile converts an architecture of a seccomp profile, like
+// SCMP_ARCH_X86_64, into the corresponding libseccomp architecture.
+func scmpArchFromProfile(arch seccompprofileapi.Arch) (seccomp.ScmpArch, error) {
+	name := strings.ToLower(strings.TrimPrefix(string(arch), archPrefix))
+	if name == "native" {
+		return seccomp.GetNativeArch()
+	}
+	return seccomp.GetArchFromString(name)
+}
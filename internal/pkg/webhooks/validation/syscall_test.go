@@ -0,0 +1,78 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestIsKnownSyscall(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		syscall       string
+		architectures []seccompprofileapi.Arch
+		want          bool
+	}{
+		{
+			name:    "native architecture",
+			syscall: "read",
+			want:    true,
+		},
+		{
+			name:          "available on the architecture",
+			syscall:       "socketcall",
+			architectures: []seccompprofileapi.Arch{"SCMP_ARCH_X86"},
+			want:          true,
+		},
+		{
+			name:          "not available on the architecture",
+			syscall:       "socketcall",
+			architectures: []seccompprofileapi.Arch{"SCMP_ARCH_X86_64"},
+		},
+		{
+			name:          "available on one of the architectures",
+			syscall:       "socketcall",
+			architectures: []seccompprofileapi.Arch{"SCMP_ARCH_X86_64", "SCMP_ARCH_X86"},
+			want:          true,
+		},
+		{
+			name:          "unknown syscall",
+			syscall:       "unknown_syscall",
+			architectures: []seccompprofileapi.Arch{"SCMP_ARCH_X86_64", "SCMP_ARCH_AARCH64"},
+		},
+		{
+			name:          "unknown architecture",
+			syscall:       "read",
+			architectures: []seccompprofileapi.Arch{"SCMP_ARCH_FOO"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, isKnownSyscall(tc.syscall, tc.architectures))
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/selinuxprofile"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+var (
+	ErrUnknownSyscall      = errors.New("unknown syscall")
+	ErrBaseProfileNotFound = errors.New("base profile not found")
+)
+
+// profileKinds maps the validated kinds to their object constructors.
+var profileKinds = map[string]func() client.Object{
+	"SeccompProfile":        func() client.Object { return &seccompprofileapi.SeccompProfile{} },
+	"ClusterSeccompProfile": func() client.Object { return &seccompprofileapi.ClusterSeccompProfile{} },
+	"SelinuxProfile":        func() client.Object { return &selxv1alpha2.SelinuxProfile{} },
+	"RawSelinuxProfile":     func() client.Object { return &selxv1alpha2.RawSelinuxProfile{} },
+	"AppArmorProfile":       func() client.Object { return &apparmorprofileapi.AppArmorProfile{} },
+}
+
+type profileValidator struct {
+	impl
+	log logr.Logger
+}
+
+func RegisterWebhook(server *webhook.Server, c client.Client) {
+	server.Register(
+		"/validate-v1-security-profile",
+		&webhook.Admission{
+			Handler: &profileValidator{
+				impl: &defaultImpl{client: c},
+				log:  logf.Log.WithName("validation"),
+			},
+		},
+	)
+}
+
+// Security Profiles Operator Webhook RBAC permissions
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+
+//nolint:gocritic
+func (p *profileValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	newProfile, ok := profileKinds[req.Kind.Kind]
+	if !ok {
+		return admission.Allowed("not a security profile")
+	}
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+
+	profile := newProfile()
+	if err := p.DecodeObject(req, profile); err != nil {
+		p.log.Error(err, "failed to decode profile")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if req.Operation == admissionv1.Update {
+		// Do not block finalizer removal of profiles which have been
+		// accepted before, for example prior to the webhook deployment.
+		if profile.GetDeletionTimestamp() != nil {
+			return admission.Allowed("profile is being deleted")
+		}
+
+		oldProfile := newProfile()
+		if err := p.DecodeOldObject(req, oldProfile); err != nil {
+			p.log.Error(err, "failed to decode old profile")
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if equality.Semantic.DeepEqual(profileSpec(oldProfile), profileSpec(profile)) {
+			return admission.Allowed("profile spec unchanged")
+		}
+	}
+
+	if err := p.validate(ctx, profile); err != nil {
+		p.log.Info(
+			"rejecting invalid profile", "kind", req.Kind.Kind,
+			"profile", req.Namespace+"/"+req.Name, "reason", err.Error(),
+		)
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+func profileSpec(profile client.Object) interface{} {
+	switch obj := profile.(type) {
+	case seccompprofileapi.SeccompProfileObject:
+		return obj.GetSpec()
+	case *selxv1alpha2.SelinuxProfile:
+		return &obj.Spec
+	case *selxv1alpha2.RawSelinuxProfile:
+		return &obj.Spec
+	case *apparmorprofileapi.AppArmorProfile:
+		return &obj.Spec
+	}
+	return nil
+}
+
+func (p *profileValidator) validate(ctx context.Context, profile client.Object) error {
+	switch obj := profile.(type) {
+	case seccompprofileapi.SeccompProfileObject:
+		return p.validateSeccompProfile(ctx, obj)
+	case *selxv1alpha2.SelinuxProfile:
+		return p.ValidateSelinuxProfile(obj)
+	case *selxv1alpha2.RawSelinuxProfile:
+		return selinuxprofile.ValidateRawProfile(obj)
+	case *apparmorprofileapi.AppArmorProfile:
+		return validateAppArmorProfile(obj)
+	}
+	return nil
+}
+
+func (p *profileValidator) validateSeccompProfile(
+	ctx context.Context, sp seccompprofileapi.SeccompProfileObject,
+) error {
+	spec := sp.GetSpec()
+	for _, call := range spec.Syscalls {
+		for _, name := range call.Names {
+			if !isKnownSyscall(name, spec.Architectures) {
+				return fmt.Errorf("%w: %s", ErrUnknownSyscall, name)
+			}
+		}
+	}
+
+	spod, err := p.GetSPOD(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving the SPOD configuration: %w", err)
+	}
+	if len(spod.Spec.AllowedSyscalls) > 0 {
+		if err := seccompprofile.AllowProfile(
+			sp, spod.Spec.AllowedSyscalls, spod.Spec.AllowedSeccompActions,
+		); err != nil {
+			return err
+		}
+	}
+
+	return p.validateBaseProfile(ctx, sp)
+}
+
+// validateBaseProfile verifies that the referenced base profile exists. Base
+// profiles from OCI registries are only resolved on the nodes.
+func (p *profileValidator) validateBaseProfile(
+	ctx context.Context, sp seccompprofileapi.SeccompProfileObject,
+) error {
+	baseProfileName := sp.GetSpec().BaseProfileName
+	if baseProfileName == "" || strings.HasPrefix(baseProfileName, config.OCIProfilePrefix) {
+		return nil
+	}
+
+	var err error
+	if _, isCluster := sp.(*seccompprofileapi.ClusterSeccompProfile); isCluster ||
+		strings.HasPrefix(baseProfileName, config.ClusterProfilePrefix) {
+		name := strings.TrimPrefix(baseProfileName, config.ClusterProfilePrefix)
+		_, err = p.GetClusterSeccompProfile(ctx, util.NamespacedName(name, ""))
+	} else {
+		_, err = p.GetSeccompProfile(ctx, util.NamespacedName(baseProfileName, sp.GetNamespace()))
+	}
+
+	if kerrors.IsNotFound(err) {
+		return fmt.Errorf("%w: %s", ErrBaseProfileNotFound, baseProfileName)
+	}
+	if err != nil {
+		return fmt.Errorf("retrieving base profile %s: %w", baseProfileName, err)
+	}
+	return nil
+}
+
+func (p *profileValidator) InjectDecoder(decoder *admission.Decoder) error {
+	p.impl.SetDecoder(decoder)
+	return nil
+}
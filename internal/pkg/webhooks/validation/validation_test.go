@@ -0,0 +1,363 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/validation/validationfakes"
+)
+
+var errTest = errors.New("error")
+
+func seccompProfile(baseProfileName string, names ...string) *seccompprofileapi.SeccompProfile {
+	return &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "ns"},
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			DefaultAction:   seccomp.ActErrno,
+			BaseProfileName: baseProfileName,
+			Syscalls: []*seccompprofileapi.Syscall{{
+				Action: seccomp.ActAllow,
+				Names:  names,
+			}},
+		},
+	}
+}
+
+func request(t *testing.T, op admissionv1.Operation, kind string, obj, oldObj runtime.Object) admission.Request {
+	t.Helper()
+	req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: op,
+		Kind:      metav1.GroupVersionKind{Kind: kind},
+		Name:      "profile",
+		Namespace: "ns",
+	}}
+	raw, err := json.Marshal(obj)
+	require.Nil(t, err)
+	req.Object.Raw = raw
+	if oldObj != nil {
+		raw, err := json.Marshal(oldObj)
+		require.Nil(t, err)
+		req.OldObject.Raw = raw
+	}
+	return req
+}
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	deleted := seccompProfile("", "unknown_syscall")
+	now := metav1.Now()
+	deleted.DeletionTimestamp = &now
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*validationfakes.FakeImpl)
+		request func(*testing.T) admission.Request
+		allowed bool
+		code    int32
+		reason  string
+	}{
+		{
+			name: "allowed seccomp profile",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SeccompProfile",
+					seccompProfile("", "read", "write"), nil)
+			},
+			allowed: true,
+		},
+		{
+			name: "denied unknown syscall",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SeccompProfile",
+					seccompProfile("", "read", "unknown_syscall"), nil)
+			},
+			reason: "unknown syscall: unknown_syscall",
+		},
+		{
+			name: "denied syscall not in allow list",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{AllowedSyscalls: []string{"read"}},
+				}, nil)
+			},
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SeccompProfile",
+					seccompProfile("", "read", "write"), nil)
+			},
+			reason: "syscall not allowed: write",
+		},
+		{
+			name: "denied missing base profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.GetSeccompProfileReturns(nil, kerrors.NewNotFound(schema.GroupResource{}, "base"))
+			},
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SeccompProfile",
+					seccompProfile("base", "read"), nil)
+			},
+			reason: "base profile not found: base",
+		},
+		{
+			name: "denied missing cluster base profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.GetClusterSeccompProfileCalls(func(
+					ctx context.Context, key types.NamespacedName,
+				) (*seccompprofileapi.ClusterSeccompProfile, error) {
+					require.Equal(t, "base", key.Name)
+					return nil, kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+				})
+			},
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SeccompProfile",
+					seccompProfile("cluster://base", "read"), nil)
+			},
+			reason: "base profile not found: cluster://base",
+		},
+		{
+			name: "allowed oci base profile",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SeccompProfile",
+					seccompProfile("oci://registry/profile:latest", "read"), nil)
+			},
+			allowed: true,
+		},
+		{
+			name: "allowed unchanged spec",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Update, "SeccompProfile",
+					seccompProfile("", "unknown_syscall"), seccompProfile("", "unknown_syscall"))
+			},
+			allowed: true,
+			reason:  "profile spec unchanged",
+		},
+		{
+			name: "allowed profile deletion",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Update, "SeccompProfile",
+					deleted, seccompProfile("", "read"))
+			},
+			allowed: true,
+			reason:  "profile is being deleted",
+		},
+		{
+			name: "denied changed spec",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Update, "SeccompProfile",
+					seccompProfile("", "unknown_syscall"), seccompProfile("", "read"))
+			},
+			reason: "unknown syscall: unknown_syscall",
+		},
+		{
+			name: "error spod lookup",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.GetSPODReturns(nil, errTest)
+			},
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SeccompProfile",
+					seccompProfile("", "read"), nil)
+			},
+			reason: "retrieving the SPOD configuration: error",
+		},
+		{
+			name: "error decode",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodeObjectReturns(errTest)
+			},
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SeccompProfile",
+					seccompProfile("", "read"), nil)
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "denied selinux profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.ValidateSelinuxProfileReturns(errTest)
+			},
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "SelinuxProfile",
+					&selxv1alpha2.SelinuxProfile{}, nil)
+			},
+			reason: "error",
+		},
+		{
+			name: "denied raw selinux profile",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "RawSelinuxProfile",
+					&selxv1alpha2.RawSelinuxProfile{
+						Spec: selxv1alpha2.RawSelinuxProfileSpec{Policy: "(blockinherit container"},
+					}, nil)
+			},
+			reason: "missing 1 closing parentheses: invalid CIL policy",
+		},
+		{
+			name: "denied apparmor profile",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "AppArmorProfile",
+					&apparmorprofileapi.AppArmorProfile{
+						ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+						Spec:       apparmorprofileapi.AppArmorProfileSpec{Policy: "profile other {\n}\n"},
+					}, nil)
+			},
+			reason: `profile "profile" is not declared in the policy, found [other]: invalid AppArmor policy`,
+		},
+		{
+			name: "allowed other kind",
+			request: func(t *testing.T) admission.Request {
+				return request(t, admissionv1.Create, "ProfileBinding",
+					&seccompprofileapi.SeccompProfile{}, nil)
+			},
+			allowed: true,
+			reason:  "not a security profile",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &validationfakes.FakeImpl{}
+			mock.DecodeObjectCalls(func(req admission.Request, obj runtime.Object) error {
+				return json.Unmarshal(req.Object.Raw, obj)
+			})
+			mock.DecodeOldObjectCalls(func(req admission.Request, obj runtime.Object) error {
+				return json.Unmarshal(req.OldObject.Raw, obj)
+			})
+			mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{}, nil)
+			if tc.prepare != nil {
+				tc.prepare(mock)
+			}
+
+			sut := &profileValidator{impl: mock, log: logr.Discard()}
+			resp := sut.Handle(context.Background(), tc.request(t))
+
+			require.Equal(t, tc.allowed, resp.Allowed)
+			if tc.code != 0 {
+				require.Equal(t, tc.code, resp.Result.Code)
+				return
+			}
+			require.Equal(t, tc.reason, string(resp.Result.Reason))
+		})
+	}
+}
+
+func TestValidateAppArmorProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		spec    apparmorprofileapi.AppArmorProfileSpec
+		wantErr string
+	}{
+		{
+			name: "valid policy",
+			spec: apparmorprofileapi.AppArmorProfileSpec{Policy: `#include <tunables/global>
+
+profile test-profile flags=(attach_disconnected, mediate_deleted) {
+  #include <abstractions/base>
+  /usr/bin/{a,b}-controller mrix, # comment with }
+  @{PROC}/@{pid}/ r,
+}
+`},
+		},
+		{
+			name:    "unbalanced braces",
+			spec:    apparmorprofileapi.AppArmorProfileSpec{Policy: "profile test-profile {\n  /bin/{a,b mrix,\n}\n"},
+			wantErr: "missing 1 closing braces: invalid AppArmor policy",
+		},
+		{
+			name:    "unexpected closing brace",
+			spec:    apparmorprofileapi.AppArmorProfileSpec{Policy: "profile test-profile {\n}\n}\n"},
+			wantErr: "line 3: unexpected closing brace: invalid AppArmor policy",
+		},
+		{
+			name:    "no profile",
+			spec:    apparmorprofileapi.AppArmorProfileSpec{Policy: "#include <tunables/global>\n"},
+			wantErr: `profile "test-profile" is not declared in the policy, found []: invalid AppArmor policy`,
+		},
+		{
+			name: "valid abstract",
+			spec: apparmorprofileapi.AppArmorProfileSpec{Abstract: &apparmorprofileapi.AppArmorAbstract{
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{ReadOnlyPaths: []string{"/etc/**", "@{PROC}/**"}},
+				Network:    &apparmorprofileapi.AppArmorNetworkRules{Families: []string{"unix"}},
+				Capability: &apparmorprofileapi.AppArmorCapabilityRules{AllowedCapabilities: []string{"net_bind_service"}},
+			}},
+		},
+		{
+			name: "relative path",
+			spec: apparmorprofileapi.AppArmorProfileSpec{Abstract: &apparmorprofileapi.AppArmorAbstract{
+				Executable: &apparmorprofileapi.AppArmorExecutablesRules{AllowedExecutables: []string{"bin/sh"}},
+			}},
+			wantErr: `path "bin/sh" is not absolute: invalid AppArmor rule`,
+		},
+		{
+			name: "control characters in path",
+			spec: apparmorprofileapi.AppArmorProfileSpec{Abstract: &apparmorprofileapi.AppArmorAbstract{
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{ReadWritePaths: []string{"/tmp/x rw,\n  /**"}},
+			}},
+			wantErr: `path "/tmp/x rw,\n  /**" contains control characters: invalid AppArmor rule`,
+		},
+		{
+			name: "unknown network family",
+			spec: apparmorprofileapi.AppArmorProfileSpec{Abstract: &apparmorprofileapi.AppArmorAbstract{
+				Network: &apparmorprofileapi.AppArmorNetworkRules{Families: []string{"foo"}},
+			}},
+			wantErr: `unknown network family "foo": invalid AppArmor rule`,
+		},
+		{
+			name: "unknown capability",
+			spec: apparmorprofileapi.AppArmorProfileSpec{Abstract: &apparmorprofileapi.AppArmorAbstract{
+				Capability: &apparmorprofileapi.AppArmorCapabilityRules{AllowedCapabilities: []string{"CAP_SYS_ADMIN"}},
+			}},
+			wantErr: `unknown capability "CAP_SYS_ADMIN": invalid AppArmor rule`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAppArmorProfile(&apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+				Spec:       tc.spec,
+			})
+			if tc.wantErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
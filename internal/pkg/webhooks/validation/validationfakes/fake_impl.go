@@ -0,0 +1,563 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by counterfeiter. DO NOT EDIT.
+package validationfakes
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+type FakeImpl struct {
+	DecodeObjectStub        func(admission.Request, runtime.Object) error
+	decodeObjectMutex       sync.RWMutex
+	decodeObjectArgsForCall []struct {
+		arg1 admission.Request
+		arg2 runtime.Object
+	}
+	decodeObjectReturns struct {
+		result1 error
+	}
+	decodeObjectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DecodeOldObjectStub        func(admission.Request, runtime.Object) error
+	decodeOldObjectMutex       sync.RWMutex
+	decodeOldObjectArgsForCall []struct {
+		arg1 admission.Request
+		arg2 runtime.Object
+	}
+	decodeOldObjectReturns struct {
+		result1 error
+	}
+	decodeOldObjectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetClusterSeccompProfileStub        func(context.Context, types.NamespacedName) (*v1beta1.ClusterSeccompProfile, error)
+	getClusterSeccompProfileMutex       sync.RWMutex
+	getClusterSeccompProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.NamespacedName
+	}
+	getClusterSeccompProfileReturns struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}
+	getClusterSeccompProfileReturnsOnCall map[int]struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}
+	GetSPODStub        func(context.Context) (*v1alpha1.SecurityProfilesOperatorDaemon, error)
+	getSPODMutex       sync.RWMutex
+	getSPODArgsForCall []struct {
+		arg1 context.Context
+	}
+	getSPODReturns struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	getSPODReturnsOnCall map[int]struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	GetSeccompProfileStub        func(context.Context, types.NamespacedName) (*v1beta1.SeccompProfile, error)
+	getSeccompProfileMutex       sync.RWMutex
+	getSeccompProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.NamespacedName
+	}
+	getSeccompProfileReturns struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}
+	getSeccompProfileReturnsOnCall map[int]struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}
+	SetDecoderStub        func(*admission.Decoder)
+	setDecoderMutex       sync.RWMutex
+	setDecoderArgsForCall []struct {
+		arg1 *admission.Decoder
+	}
+	ValidateSelinuxProfileStub        func(*v1alpha2.SelinuxProfile) error
+	validateSelinuxProfileMutex       sync.RWMutex
+	validateSelinuxProfileArgsForCall []struct {
+		arg1 *v1alpha2.SelinuxProfile
+	}
+	validateSelinuxProfileReturns struct {
+		result1 error
+	}
+	validateSelinuxProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImpl) DecodeObject(arg1 admission.Request, arg2 runtime.Object) error {
+	fake.decodeObjectMutex.Lock()
+	ret, specificReturn := fake.decodeObjectReturnsOnCall[len(fake.decodeObjectArgsForCall)]
+	fake.decodeObjectArgsForCall = append(fake.decodeObjectArgsForCall, struct {
+		arg1 admission.Request
+		arg2 runtime.Object
+	}{arg1, arg2})
+	stub := fake.DecodeObjectStub
+	fakeReturns := fake.decodeObjectReturns
+	fake.recordInvocation("DecodeObject", []interface{}{arg1, arg2})
+	fake.decodeObjectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) DecodeObjectCallCount() int {
+	fake.decodeObjectMutex.RLock()
+	defer fake.decodeObjectMutex.RUnlock()
+	return len(fake.decodeObjectArgsForCall)
+}
+
+func (fake *FakeImpl) DecodeObjectCalls(stub func(admission.Request, runtime.Object) error) {
+	fake.decodeObjectMutex.Lock()
+	defer fake.decodeObjectMutex.Unlock()
+	fake.DecodeObjectStub = stub
+}
+
+func (fake *FakeImpl) DecodeObjectArgsForCall(i int) (admission.Request, runtime.Object) {
+	fake.decodeObjectMutex.RLock()
+	defer fake.decodeObjectMutex.RUnlock()
+	argsForCall := fake.decodeObjectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) DecodeObjectReturns(result1 error) {
+	fake.decodeObjectMutex.Lock()
+	defer fake.decodeObjectMutex.Unlock()
+	fake.DecodeObjectStub = nil
+	fake.decodeObjectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DecodeObjectReturnsOnCall(i int, result1 error) {
+	fake.decodeObjectMutex.Lock()
+	defer fake.decodeObjectMutex.Unlock()
+	fake.DecodeObjectStub = nil
+	if fake.decodeObjectReturnsOnCall == nil {
+		fake.decodeObjectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.decodeObjectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DecodeOldObject(arg1 admission.Request, arg2 runtime.Object) error {
+	fake.decodeOldObjectMutex.Lock()
+	ret, specificReturn := fake.decodeOldObjectReturnsOnCall[len(fake.decodeOldObjectArgsForCall)]
+	fake.decodeOldObjectArgsForCall = append(fake.decodeOldObjectArgsForCall, struct {
+		arg1 admission.Request
+		arg2 runtime.Object
+	}{arg1, arg2})
+	stub := fake.DecodeOldObjectStub
+	fakeReturns := fake.decodeOldObjectReturns
+	fake.recordInvocation("DecodeOldObject", []interface{}{arg1, arg2})
+	fake.decodeOldObjectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) DecodeOldObjectCallCount() int {
+	fake.decodeOldObjectMutex.RLock()
+	defer fake.decodeOldObjectMutex.RUnlock()
+	return len(fake.decodeOldObjectArgsForCall)
+}
+
+func (fake *FakeImpl) DecodeOldObjectCalls(stub func(admission.Request, runtime.Object) error) {
+	fake.decodeOldObjectMutex.Lock()
+	defer fake.decodeOldObjectMutex.Unlock()
+	fake.DecodeOldObjectStub = stub
+}
+
+func (fake *FakeImpl) DecodeOldObjectArgsForCall(i int) (admission.Request, runtime.Object) {
+	fake.decodeOldObjectMutex.RLock()
+	defer fake.decodeOldObjectMutex.RUnlock()
+	argsForCall := fake.decodeOldObjectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) DecodeOldObjectReturns(result1 error) {
+	fake.decodeOldObjectMutex.Lock()
+	defer fake.decodeOldObjectMutex.Unlock()
+	fake.DecodeOldObjectStub = nil
+	fake.decodeOldObjectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DecodeOldObjectReturnsOnCall(i int, result1 error) {
+	fake.decodeOldObjectMutex.Lock()
+	defer fake.decodeOldObjectMutex.Unlock()
+	fake.DecodeOldObjectStub = nil
+	if fake.decodeOldObjectReturnsOnCall == nil {
+		fake.decodeOldObjectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.decodeOldObjectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfile(arg1 context.Context, arg2 types.NamespacedName) (*v1beta1.ClusterSeccompProfile, error) {
+	fake.getClusterSeccompProfileMutex.Lock()
+	ret, specificReturn := fake.getClusterSeccompProfileReturnsOnCall[len(fake.getClusterSeccompProfileArgsForCall)]
+	fake.getClusterSeccompProfileArgsForCall = append(fake.getClusterSeccompProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.NamespacedName
+	}{arg1, arg2})
+	stub := fake.GetClusterSeccompProfileStub
+	fakeReturns := fake.getClusterSeccompProfileReturns
+	fake.recordInvocation("GetClusterSeccompProfile", []interface{}{arg1, arg2})
+	fake.getClusterSeccompProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileCallCount() int {
+	fake.getClusterSeccompProfileMutex.RLock()
+	defer fake.getClusterSeccompProfileMutex.RUnlock()
+	return len(fake.getClusterSeccompProfileArgsForCall)
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileCalls(stub func(context.Context, types.NamespacedName) (*v1beta1.ClusterSeccompProfile, error)) {
+	fake.getClusterSeccompProfileMutex.Lock()
+	defer fake.getClusterSeccompProfileMutex.Unlock()
+	fake.GetClusterSeccompProfileStub = stub
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileArgsForCall(i int) (context.Context, types.NamespacedName) {
+	fake.getClusterSeccompProfileMutex.RLock()
+	defer fake.getClusterSeccompProfileMutex.RUnlock()
+	argsForCall := fake.getClusterSeccompProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileReturns(result1 *v1beta1.ClusterSeccompProfile, result2 error) {
+	fake.getClusterSeccompProfileMutex.Lock()
+	defer fake.getClusterSeccompProfileMutex.Unlock()
+	fake.GetClusterSeccompProfileStub = nil
+	fake.getClusterSeccompProfileReturns = struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileReturnsOnCall(i int, result1 *v1beta1.ClusterSeccompProfile, result2 error) {
+	fake.getClusterSeccompProfileMutex.Lock()
+	defer fake.getClusterSeccompProfileMutex.Unlock()
+	fake.GetClusterSeccompProfileStub = nil
+	if fake.getClusterSeccompProfileReturnsOnCall == nil {
+		fake.getClusterSeccompProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.ClusterSeccompProfile
+			result2 error
+		})
+	}
+	fake.getClusterSeccompProfileReturnsOnCall[i] = struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOD(arg1 context.Context) (*v1alpha1.SecurityProfilesOperatorDaemon, error) {
+	fake.getSPODMutex.Lock()
+	ret, specificReturn := fake.getSPODReturnsOnCall[len(fake.getSPODArgsForCall)]
+	fake.getSPODArgsForCall = append(fake.getSPODArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetSPODStub
+	fakeReturns := fake.getSPODReturns
+	fake.recordInvocation("GetSPOD", []interface{}{arg1})
+	fake.getSPODMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSPODCallCount() int {
+	fake.getSPODMutex.RLock()
+	defer fake.getSPODMutex.RUnlock()
+	return len(fake.getSPODArgsForCall)
+}
+
+func (fake *FakeImpl) GetSPODCalls(stub func(context.Context) (*v1alpha1.SecurityProfilesOperatorDaemon, error)) {
+	fake.getSPODMutex.Lock()
+	defer fake.getSPODMutex.Unlock()
+	fake.GetSPODStub = stub
+}
+
+func (fake *FakeImpl) GetSPODArgsForCall(i int) context.Context {
+	fake.getSPODMutex.RLock()
+	defer fake.getSPODMutex.RUnlock()
+	argsForCall := fake.getSPODArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetSPODReturns(result1 *v1alpha1.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPODMutex.Lock()
+	defer fake.getSPODMutex.Unlock()
+	fake.GetSPODStub = nil
+	fake.getSPODReturns = struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPODReturnsOnCall(i int, result1 *v1alpha1.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPODMutex.Lock()
+	defer fake.getSPODMutex.Unlock()
+	fake.GetSPODStub = nil
+	if fake.getSPODReturnsOnCall == nil {
+		fake.getSPODReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.SecurityProfilesOperatorDaemon
+			result2 error
+		})
+	}
+	fake.getSPODReturnsOnCall[i] = struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSeccompProfile(arg1 context.Context, arg2 types.NamespacedName) (*v1beta1.SeccompProfile, error) {
+	fake.getSeccompProfileMutex.Lock()
+	ret, specificReturn := fake.getSeccompProfileReturnsOnCall[len(fake.getSeccompProfileArgsForCall)]
+	fake.getSeccompProfileArgsForCall = append(fake.getSeccompProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.NamespacedName
+	}{arg1, arg2})
+	stub := fake.GetSeccompProfileStub
+	fakeReturns := fake.getSeccompProfileReturns
+	fake.recordInvocation("GetSeccompProfile", []interface{}{arg1, arg2})
+	fake.getSeccompProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSeccompProfileCallCount() int {
+	fake.getSeccompProfileMutex.RLock()
+	defer fake.getSeccompProfileMutex.RUnlock()
+	return len(fake.getSeccompProfileArgsForCall)
+}
+
+func (fake *FakeImpl) GetSeccompProfileCalls(stub func(context.Context, types.NamespacedName) (*v1beta1.SeccompProfile, error)) {
+	fake.getSeccompProfileMutex.Lock()
+	defer fake.getSeccompProfileMutex.Unlock()
+	fake.GetSeccompProfileStub = stub
+}
+
+func (fake *FakeImpl) GetSeccompProfileArgsForCall(i int) (context.Context, types.NamespacedName) {
+	fake.getSeccompProfileMutex.RLock()
+	defer fake.getSeccompProfileMutex.RUnlock()
+	argsForCall := fake.getSeccompProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetSeccompProfileReturns(result1 *v1beta1.SeccompProfile, result2 error) {
+	fake.getSeccompProfileMutex.Lock()
+	defer fake.getSeccompProfileMutex.Unlock()
+	fake.GetSeccompProfileStub = nil
+	fake.getSeccompProfileReturns = struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSeccompProfileReturnsOnCall(i int, result1 *v1beta1.SeccompProfile, result2 error) {
+	fake.getSeccompProfileMutex.Lock()
+	defer fake.getSeccompProfileMutex.Unlock()
+	fake.GetSeccompProfileStub = nil
+	if fake.getSeccompProfileReturnsOnCall == nil {
+		fake.getSeccompProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.SeccompProfile
+			result2 error
+		})
+	}
+	fake.getSeccompProfileReturnsOnCall[i] = struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) SetDecoder(arg1 *admission.Decoder) {
+	fake.setDecoderMutex.Lock()
+	fake.setDecoderArgsForCall = append(fake.setDecoderArgsForCall, struct {
+		arg1 *admission.Decoder
+	}{arg1})
+	stub := fake.SetDecoderStub
+	fake.recordInvocation("SetDecoder", []interface{}{arg1})
+	fake.setDecoderMutex.Unlock()
+	if stub != nil {
+		fake.SetDecoderStub(arg1)
+	}
+}
+
+func (fake *FakeImpl) SetDecoderCallCount() int {
+	fake.setDecoderMutex.RLock()
+	defer fake.setDecoderMutex.RUnlock()
+	return len(fake.setDecoderArgsForCall)
+}
+
+func (fake *FakeImpl) SetDecoderCalls(stub func(*admission.Decoder)) {
+	fake.setDecoderMutex.Lock()
+	defer fake.setDecoderMutex.Unlock()
+	fake.SetDecoderStub = stub
+}
+
+func (fake *FakeImpl) SetDecoderArgsForCall(i int) *admission.Decoder {
+	fake.setDecoderMutex.RLock()
+	defer fake.setDecoderMutex.RUnlock()
+	argsForCall := fake.setDecoderArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ValidateSelinuxProfile(arg1 *v1alpha2.SelinuxProfile) error {
+	fake.validateSelinuxProfileMutex.Lock()
+	ret, specificReturn := fake.validateSelinuxProfileReturnsOnCall[len(fake.validateSelinuxProfileArgsForCall)]
+	fake.validateSelinuxProfileArgsForCall = append(fake.validateSelinuxProfileArgsForCall, struct {
+		arg1 *v1alpha2.SelinuxProfile
+	}{arg1})
+	stub := fake.ValidateSelinuxProfileStub
+	fakeReturns := fake.validateSelinuxProfileReturns
+	fake.recordInvocation("ValidateSelinuxProfile", []interface{}{arg1})
+	fake.validateSelinuxProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) ValidateSelinuxProfileCallCount() int {
+	fake.validateSelinuxProfileMutex.RLock()
+	defer fake.validateSelinuxProfileMutex.RUnlock()
+	return len(fake.validateSelinuxProfileArgsForCall)
+}
+
+func (fake *FakeImpl) ValidateSelinuxProfileCalls(stub func(*v1alpha2.SelinuxProfile) error) {
+	fake.validateSelinuxProfileMutex.Lock()
+	defer fake.validateSelinuxProfileMutex.Unlock()
+	fake.ValidateSelinuxProfileStub = stub
+}
+
+func (fake *FakeImpl) ValidateSelinuxProfileArgsForCall(i int) *v1alpha2.SelinuxProfile {
+	fake.validateSelinuxProfileMutex.RLock()
+	defer fake.validateSelinuxProfileMutex.RUnlock()
+	argsForCall := fake.validateSelinuxProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ValidateSelinuxProfileReturns(result1 error) {
+	fake.validateSelinuxProfileMutex.Lock()
+	defer fake.validateSelinuxProfileMutex.Unlock()
+	fake.ValidateSelinuxProfileStub = nil
+	fake.validateSelinuxProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ValidateSelinuxProfileReturnsOnCall(i int, result1 error) {
+	fake.validateSelinuxProfileMutex.Lock()
+	defer fake.validateSelinuxProfileMutex.Unlock()
+	fake.ValidateSelinuxProfileStub = nil
+	if fake.validateSelinuxProfileReturnsOnCall == nil {
+		fake.validateSelinuxProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateSelinuxProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.decodeObjectMutex.RLock()
+	defer fake.decodeObjectMutex.RUnlock()
+	fake.decodeOldObjectMutex.RLock()
+	defer fake.decodeOldObjectMutex.RUnlock()
+	fake.getClusterSeccompProfileMutex.RLock()
+	defer fake.getClusterSeccompProfileMutex.RUnlock()
+	fake.getSPODMutex.RLock()
+	defer fake.getSPODMutex.RUnlock()
+	fake.getSeccompProfileMutex.RLock()
+	defer fake.getSeccompProfileMutex.RUnlock()
+	fake.setDecoderMutex.RLock()
+	defer fake.setDecoderMutex.RUnlock()
+	fake.validateSelinuxProfileMutex.RLock()
+	defer fake.validateSelinuxProfileMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImpl) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	"sigs.k8s.io/release-utils/command"
 )
 
 func (e *e2e) testCaseSeccompProfileBinding([]string) {
@@ -36,6 +38,16 @@ spec:
     kind: SeccompProfile
     name: profile-allow-unsafe
   image: quay.io/security-profiles-operator/test-hello-world:latest
+`
+	const emptyBinding = `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: ProfileBinding
+metadata:
+  name: empty-binding
+spec:
+  profileRef:
+    kind: SeccompProfile
+    name: profile-allow-unsafe
 `
 	const testPod = `
 apiVersion: v1
@@ -65,6 +77,19 @@ spec:
 	defer e.kubectl("delete", "-f", exampleProfilePath)
 	e.waitFor("condition=ready", "seccompprofile", "profile-allow-unsafe")
 
+	e.logf("Testing that a profile binding without selection criteria is rejected")
+	emptyBindingFile, err := os.CreateTemp("", "empty-binding*.yaml")
+	e.Nil(err)
+	defer os.Remove(emptyBindingFile.Name())
+	_, err = emptyBindingFile.WriteString(emptyBinding)
+	e.Nil(err)
+	err = emptyBindingFile.Close()
+	e.Nil(err)
+	status, err := command.New(e.kubectlPath, "create", "-f", emptyBindingFile.Name()).Run()
+	e.Nil(err)
+	e.False(status.Success())
+	e.Contains(status.Error(), "at least one of image, podSelector or containerNames is required")
+
 	e.logf("Creating test profile binding")
 	testBindingFile, err := os.CreateTemp("", "hello-binding*.yaml")
 	e.Nil(err)